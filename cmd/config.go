@@ -2,10 +2,14 @@ package cmd
 
 import (
 	"errors"
-	"log"
+	"fmt"
+	"log/slog"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"BRSBackend/pkg/config"
 )
 
 var configCmd = &cobra.Command{
@@ -14,24 +18,45 @@ var configCmd = &cobra.Command{
 	Run:   generateConfig,
 }
 
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate the configuration file and BRS_ environment overrides",
+	Run:   validateConfig,
+}
+
 func init() {
+	configCmd.AddCommand(configValidateCmd)
 	rootCmd.AddCommand(configCmd)
 }
 
 func generateConfig(cmd *cobra.Command, args []string) {
 	v := viper.New()
-	v.SetDefault("server.port", "8080")
+	config.SetDefaults(v)
 	v.SetDefault("database.dsn", "./brs.sqlite")
 	v.SetDefault("librarian.user", "admin")
 	v.SetDefault("librarian.pass", "securePasswd")
-	v.SetDefault("rent.overdue_period", 7)
+	v.SetDefault("cookie.secure", false)
+	v.SetDefault("cookie.domain", "")
 
 	if err := v.SafeWriteConfigAs("config.yaml"); err != nil {
 		var configFileAlreadyExistsError viper.ConfigFileAlreadyExistsError
 		if errors.As(err, &configFileAlreadyExistsError) {
-			log.Println("Config file already exists.")
+			slog.Warn("Config file already exists", "path", "config.yaml")
 		}
 	} else {
-		log.Println("Config file generated successfully: config.yaml")
+		slog.Info("Config file generated successfully", "path", "config.yaml")
+	}
+}
+
+func validateConfig(cmd *cobra.Command, args []string) {
+	path := configPath()
+	if _, err := config.LoadConfig(path); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if path == "" {
+		path = "environment only"
 	}
+	fmt.Printf("Configuration is valid (%s)\n", path)
 }
@@ -1,9 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
-	"log"
-	"net"
+	"log/slog"
 	"net/http"
 	"os"
 	"time"
@@ -14,13 +14,24 @@ import (
 	"github.com/spf13/cobra"
 
 	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/clock"
 	"BRSBackend/pkg/config"
+	"BRSBackend/pkg/delivery"
 	"BRSBackend/pkg/handlers"
+	"BRSBackend/pkg/logging"
+	"BRSBackend/pkg/metrics"
 	"BRSBackend/pkg/middleware"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pdf"
+	"BRSBackend/pkg/printing"
+	"BRSBackend/pkg/problem"
 	"BRSBackend/pkg/repository/sqlite"
 	"BRSBackend/pkg/services"
+	"BRSBackend/pkg/tracing"
 )
 
+const defaultConfigFile = "config.dev.yaml"
+
 var cfgFile string
 
 var rootCmd = &cobra.Command{
@@ -29,7 +40,10 @@ var rootCmd = &cobra.Command{
 	Run:   runCommand,
 }
 
-func Execute() {
+func Execute(build, date string) {
+	buildRef = build
+	buildDate = date
+
 	err := rootCmd.Execute()
 	if err != nil {
 		os.Exit(1)
@@ -37,47 +51,192 @@ func Execute() {
 }
 
 func init() {
-	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is config.dev.yaml)")
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is config.dev.yaml if present, BRS_* environment variables override it)")
+}
+
+func configPath() string {
+	if cfgFile != "" {
+		return cfgFile
+	}
+	if _, err := os.Stat(defaultConfigFile); err == nil {
+		return defaultConfigFile
+	}
+	return ""
 }
 
 func runCommand(cmd *cobra.Command, args []string) {
-	if cfgFile == "" {
-		cfgFile = "config.dev.yaml"
+	cfg, err := config.LoadConfig(configPath())
+	if err != nil {
+		fatal("Failed to load config", err)
 	}
-	cfg, err := config.LoadConfig(cfgFile)
+
+	logger, err := logging.New(os.Stdout, cfg.Log.Level, cfg.Log.Format)
 	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
+		fatal("Failed to initialize logger", err)
+	}
+	slog.SetDefault(logger)
+
+	if cfg.Tracing.Enabled {
+		shutdown, err := initializeTracing(cfg.Tracing)
+		if err != nil {
+			fatal("Failed to initialize tracing", err)
+		}
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+			defer cancel()
+			if err := shutdown(ctx); err != nil {
+				slog.Error("Failed to flush traces", "error", err)
+			}
+		}()
 	}
 
-	db, err := initializeDatabase(cfg.Database.DSN)
+	clk := clock.New(cfg.Server.ClockOffset)
+	if cfg.Server.ClockOffset != 0 {
+		slog.Warn("Server time is shifted", "offset", cfg.Server.ClockOffset, "now", clk.Now())
+	}
+
+	db, err := initializeDatabase(cfg.Database, logger, clk)
 	if err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+		fatal("Failed to initialize database", err)
 	}
 	defer db.Close()
 
-	repo := sqlite.NewRepository(db.DB)
-	svc := services.NewService(repo, cfg.Rent.RentalDays)
+	if cfg.Tracing.Enabled {
+		if err := db.DB.Use(tracing.GormPlugin()); err != nil {
+			fatal("Failed to register tracing plugin", err)
+		}
+	}
+
+	var m *metrics.Metrics
+	if cfg.Metrics.Enabled {
+		m, err = initializeMetrics(db)
+		if err != nil {
+			fatal("Failed to initialize metrics", err)
+		}
+	}
+
+	repo := sqlite.NewRepository(db.DB, clk)
+	svc := services.NewService(repo, cfg.Rent.RentalDays, clk)
+	mailer := reportMailer(cfg.Reports.SMTP)
+	svc.Schedule = services.NewReportScheduleService(repo.Schedule, svc.Report, services.ReportDeliveryOptions{
+		OutputDir: cfg.Reports.OutputDir,
+		Retention: cfg.Reports.Retention,
+		Mailer:    mailer,
+	}, clk)
+	svc.Portal = services.NewPortalService(repo.StudentAuth, repo.Student, repo.Rent, cfg.Rent.RentalDays, services.PortalOptions{
+		SessionTTL:     cfg.Portal.SessionTTL,
+		MaxPinAttempts: cfg.Portal.MaxPinAttempts,
+		Lockout:        cfg.Portal.Lockout,
+		Mailer:         mailer,
+		LinkURL:        cfg.Portal.LinkURL,
+		LinkTTL:        cfg.Portal.LinkTTL,
+	}, clk)
 
 	seedData(svc, cfg)
 
-	r := setupRouter(svc)
+	if cfg.OIDC.Enabled {
+		svc.OIDC, err = initializeOIDC(svc.Auth, cfg.OIDC)
+		if err != nil {
+			fatal("Failed to initialize single sign-on", err)
+		}
+	}
 
-	go startCleanupRoutine(svc.Auth)
+	r, err := setupRouter(svc, cfg, logger, m)
+	if err != nil {
+		fatal("Failed to set up router", err)
+	}
 
-	server := config.NewServer(net.JoinHostPort("0.0.0.0", cfg.Server.Port), r)
+	go startCleanupRoutine(svc.Auth, svc.Portal, svc.Health)
+	go startRollupRoutine(svc.Report, svc.Health)
+	go startReportRoutine(svc.Schedule, svc.Health)
+	if m != nil {
+		go startMetricsRoutine(m, svc.Report, svc.Health, cfg.Metrics.RefreshInterval)
+	}
+
+	server := config.NewServer(cfg.Server, r)
 	server.Start()
 }
 
-func initializeDatabase(dsn string) (*config.Database, error) {
-	db, err := config.NewDatabase(dsn)
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+func initializeDatabase(cfg config.DatabaseConfig, logger *slog.Logger, clock clock.Clock) (*config.Database, error) {
+	db, err := config.NewDatabase(cfg, logger, clock)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	db.AutoMigrate()
+	if err := db.AutoMigrate(); err != nil {
+		return nil, err
+	}
 	return db, nil
 }
 
+func initializeTracing(cfg config.TracingConfig) (func(context.Context) error, error) {
+	return tracing.Setup(context.Background(), tracing.Options{
+		ServiceName:    cfg.ServiceName,
+		ServiceVersion: buildInfo().Ref,
+		Exporter:       cfg.Exporter,
+		Endpoint:       cfg.Endpoint,
+		Insecure:       cfg.Insecure,
+		File:           cfg.File,
+		SampleRatio:    cfg.SampleRatio,
+	})
+}
+
+func initializeMetrics(db *config.Database) (*metrics.Metrics, error) {
+	m := metrics.New()
+	if err := db.DB.Use(m.GormPlugin()); err != nil {
+		return nil, fmt.Errorf("failed to register metrics plugin: %w", err)
+	}
+
+	sqlDB, err := db.DB.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+	if err := m.RegisterDB(sqlDB); err != nil {
+		return nil, fmt.Errorf("failed to register database metrics: %w", err)
+	}
+
+	return m, nil
+}
+
+func initializeOIDC(authService services.AuthService, cfg config.OIDCConfig) (services.OIDCService, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	return services.NewOIDCService(ctx, authService, services.OIDCOptions{
+		Issuer:            cfg.Issuer,
+		ClientID:          cfg.ClientID,
+		ClientSecret:      cfg.ClientSecret,
+		RedirectURL:       cfg.RedirectURL,
+		PostLoginRedirect: cfg.PostLoginRedirect,
+		Scopes:            cfg.Scopes,
+		UsernameClaim:     cfg.UsernameClaim,
+		GroupsClaim:       cfg.GroupsClaim,
+		RoleMapping:       cfg.RoleMapping,
+		DefaultRole:       cfg.DefaultRole,
+	})
+}
+
+// reportMailer returns the mailer used for scheduled reports and portal
+// sign-in links, or nil when no SMTP server is configured.
+func reportMailer(cfg config.SMTPConfig) delivery.Mailer {
+	if cfg.Host == "" {
+		return nil
+	}
+	return delivery.NewSMTPMailer(delivery.SMTPOptions{
+		Host:     cfg.Host,
+		Port:     cfg.Port,
+		Username: cfg.Username,
+		Password: cfg.Password,
+		From:     cfg.From,
+		StartTLS: cfg.StartTLS,
+	})
+}
+
 func seedData(svc *services.Service, cfg *config.AppConfig) {
 	env := cfg.Server.Env
 	if env != "prod" {
@@ -91,26 +250,89 @@ func seedData(svc *services.Service, cfg *config.AppConfig) {
 	}
 }
 
-func setupRouter(svc *services.Service) *chi.Mux {
-	h := handlers.NewHandler(svc)
+func setupRouter(svc *services.Service, cfg *config.AppConfig, logger *slog.Logger, m *metrics.Metrics) (*chi.Mux, error) {
+	sameSite, err := cfg.Cookie.SameSiteMode()
+	if err != nil {
+		return nil, err
+	}
+
+	receiptTemplate := printing.ReceiptTemplate{
+		LibraryName: cfg.Receipts.LibraryName,
+		Paper:       pdf.Papers[cfg.Receipts.Paper],
+		TextWidth:   cfg.Receipts.TextWidth,
+	}
+	if cfg.Receipts.Logo != "" {
+		if receiptTemplate.Logo, err = printing.LoadImage(cfg.Receipts.Logo); err != nil {
+			return nil, fmt.Errorf("failed to load receipts.logo: %w", err)
+		}
+	}
+
+	h := handlers.NewHandler(svc,
+		handlers.WithCookieOptions(handlers.CookieOptions{
+			Name:     cfg.Cookie.Name,
+			Domain:   cfg.Cookie.Domain,
+			Secure:   cfg.Cookie.Secure,
+			SameSite: sameSite,
+		}),
+		handlers.WithPortalCookieOptions(handlers.PortalCookieOptions{
+			Name:   cfg.Portal.CookieName,
+			MaxAge: cfg.Portal.SessionTTL,
+		}),
+		handlers.WithReceiptTemplate(receiptTemplate),
+		handlers.WithBuildInfo(buildInfo()),
+	)
 
 	swagger, err := api.GetSwagger()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading swagger spec\n: %s", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("failed to load swagger spec: %w", err)
 	}
 	swagger.Servers = nil
 
-	authFun := middleware.NewOApiAuthenticationFunc(svc.Auth)
+	authFun := middleware.NewOApiAuthenticationFunc(svc.Auth, svc.Portal, svc.Kiosk, middleware.AuthOptions{
+		SessionCookie: cfg.Cookie.Name,
+		StudentCookie: cfg.Portal.CookieName,
+	})
 
 	r := chi.NewRouter()
-	r.Use(middleware.Cors())
+	r.Use(middleware.RequestID)
+	if cfg.Tracing.Enabled {
+		r.Use(tracing.Middleware)
+	}
+	r.Use(middleware.AccessLog(logger))
+	if m != nil {
+		r.Use(m.Middleware(metrics.Operations(swagger)))
+	}
+	r.Use(middleware.Cors(cfg.Server.AllowedOrigins, cfg.CSRF.HeaderName))
+
+	if cfg.CSRF.Enabled {
+		r.Use(middleware.CSRF(middleware.CSRFOptions{
+			SessionCookies: []string{cfg.Cookie.Name, cfg.Portal.CookieName},
+			CookieName:     cfg.CSRF.CookieName,
+			HeaderName:     cfg.CSRF.HeaderName,
+			Domain:         cfg.Cookie.Domain,
+			Secure:         cfg.Cookie.Secure,
+			SameSite:       sameSite,
+			ExemptPaths:    []string{"/login", "/portal/login", "/portal/login/token"},
+		}))
+	}
+
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		problem.Write(w, r, problem.FromStatus(http.StatusNotFound, "route not found"))
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		problem.Write(w, r, problem.FromStatus(http.StatusMethodNotAllowed, "method not allowed"))
+	})
+
+	if m != nil {
+		r.Handle(cfg.Metrics.Path, m.Handler())
+	}
 
 	r.Get("/swagger/*", func(w http.ResponseWriter, r *http.Request) {
 		http.StripPrefix("/swagger/", http.FileServer(http.FS(api.SwaggerUI))).ServeHTTP(w, r)
 	})
 
 	r.Group(func(r chi.Router) {
+		r.Use(middleware.Authenticated)
 		r.Use(middlewareoapi.OapiRequestValidatorWithOptions(swagger, &middlewareoapi.Options{
 			Options: openapi3filter.Options{
 				ExcludeRequestBody:    false,
@@ -118,20 +340,106 @@ func setupRouter(svc *services.Service) *chi.Mux {
 				IncludeResponseStatus: true,
 				AuthenticationFunc:    authFun,
 			},
+			ErrorHandlerWithOpts: middleware.OApiErrorHandler,
 		}))
 		api.HandlerFromMux(h, r)
 	})
 
-	return r
+	return r, nil
+}
+
+func startMetricsRoutine(m *metrics.Metrics, reportService services.ReportService, healthService services.HealthService, interval time.Duration) {
+	healthService.WatchJob("metrics_refresh", interval)
+	refresh := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		defer cancel()
+		if err := m.RefreshKPIs(ctx, reportService); err != nil {
+			slog.Error("Failed to refresh circulation metrics", "error", err)
+			return
+		}
+		healthService.Heartbeat("metrics_refresh")
+	}
+
+	refresh()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		refresh()
+	}
+}
+
+// startRollupRoutine rolls up the circulation of closed days at startup and
+// then hourly, so that a restart or a missed day is caught up.
+func startRollupRoutine(reportService services.ReportService, healthService services.HealthService) {
+	healthService.WatchJob("circulation_rollup", time.Hour)
+	rollup := func() {
+		if err := reportService.RollupCirculation(context.Background()); err != nil {
+			slog.Error("Failed to roll up circulation", "error", err)
+			return
+		}
+		healthService.Heartbeat("circulation_rollup")
+	}
+
+	rollup()
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for range ticker.C {
+		rollup()
+	}
+}
+
+// startReportRoutine delivers the saved reports that are due every minute,
+// and deletes the runs past their retention. The job is watched at the
+// timeout of a round rather than the tick, since a slow mail server holds
+// up the ticks.
+func startReportRoutine(scheduleService services.ReportScheduleService, healthService services.HealthService) {
+	const timeout = 5 * time.Minute
+	healthService.WatchJob("report_delivery", timeout)
+	deliver := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		runs, err := scheduleService.RunDueSchedules(ctx)
+		for _, run := range runs {
+			if run.Status == models.RunFailed {
+				slog.Warn("Scheduled report failed", "schedule_id", run.ScheduleId, "run_id", run.Id, "error", run.Error)
+			}
+		}
+		if err != nil {
+			slog.Error("Failed to run scheduled reports", "error", err)
+			return
+		}
+		if _, err := scheduleService.PurgeRuns(ctx); err != nil {
+			slog.Error("Failed to purge report runs", "error", err)
+			return
+		}
+		healthService.Heartbeat("report_delivery")
+	}
+
+	deliver()
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for range ticker.C {
+		deliver()
+	}
 }
 
-func startCleanupRoutine(authService services.AuthService) {
+func startCleanupRoutine(authService services.AuthService, portalService services.PortalService, healthService services.HealthService) {
+	healthService.WatchJob("session_cleanup", time.Hour)
 	ticker := time.NewTicker(1 * time.Hour)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ticker.C:
-			authService.CleanupExpiredSessions()
+			if err := authService.CleanupExpiredSessions(); err != nil {
+				slog.Error("Failed to clean up expired sessions", "error", err)
+				continue
+			}
+			if err := portalService.CleanupExpired(context.Background()); err != nil {
+				slog.Error("Failed to clean up expired portal sessions", "error", err)
+				continue
+			}
+			healthService.Heartbeat("session_cleanup")
 		}
 	}
 }
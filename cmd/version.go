@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"BRSBackend/pkg/config"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+)
+
+var (
+	buildRef  string
+	buildDate string
+)
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print build information",
+	Run: func(cmd *cobra.Command, args []string) {
+		info := buildInfo()
+		fmt.Printf("Build ref:      %s\n", info.Ref)
+		if info.Date != "" {
+			fmt.Printf("Build date:     %s\n", info.Date)
+		}
+		fmt.Printf("Go version:     %s\n", info.GoVersion)
+		fmt.Printf("Schema version: %d\n", info.SchemaVersion)
+	},
+}
+
+var healthcheckCmd = &cobra.Command{
+	Use:   "healthcheck",
+	Short: "Probe the liveness endpoint of a running server on this host",
+	Run:   healthcheck,
+}
+
+func init() {
+	rootCmd.AddCommand(versionCmd)
+	rootCmd.AddCommand(healthcheckCmd)
+}
+
+func buildInfo() dto.BuildInfo {
+	ref := buildRef
+	if ref == "" {
+		ref = "dev"
+		if info, ok := debug.ReadBuildInfo(); ok {
+			for _, setting := range info.Settings {
+				if setting.Key == "vcs.revision" {
+					ref = setting.Value
+				}
+			}
+		}
+	}
+
+	return dto.BuildInfo{
+		Ref:           ref,
+		Date:          buildDate,
+		GoVersion:     runtime.Version(),
+		SchemaVersion: models.SchemaVersion,
+	}
+}
+
+func healthcheck(cmd *cobra.Command, args []string) {
+	cfg, err := config.LoadConfig(configPath())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	scheme := "http"
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.Server.TLS.Enabled {
+		scheme = "https"
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second, Transport: transport}
+	resp, err := client.Get(scheme + "://" + net.JoinHostPort("127.0.0.1", cfg.Server.Port) + "/healthz")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		fmt.Fprintf(os.Stderr, "unhealthy: %s\n", resp.Status)
+		os.Exit(1)
+	}
+}
@@ -7,6 +7,7 @@ login() {
     -d '{"user": "admin", "pass": "securePasswd"}')
   
   if [[ $(echo "$response" | grep -c "Login successful") -gt 0 ]]; then
+    CSRF_TOKEN=$(awk '$6 == "csrf_token" {print $7}' cookie.txt)
     echo "Login successful."
   else
     echo "Login failed. Exiting."
@@ -18,6 +19,7 @@ add_book() {
   echo "Adding book: $1"
   curl -s -b cookie.txt -X POST http://localhost:8080/books \
     -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $CSRF_TOKEN" \
     -d "$1"
 }
 
@@ -25,6 +27,7 @@ add_student() {
   echo "Adding student: $1"
   curl -s -b cookie.txt -X POST http://localhost:8080/students \
     -H "Content-Type: application/json" \
+    -H "X-CSRF-Token: $CSRF_TOKEN" \
     -d "$1"
 }
 
@@ -2,6 +2,11 @@ package main
 
 import "BRSBackend/cmd"
 
+var (
+	build     string
+	buildDate string
+)
+
 func main() {
-	cmd.Execute()
+	cmd.Execute(build, buildDate)
 }
@@ -11,6 +11,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -25,9 +26,479 @@ import (
 )
 
 const (
-	CookieAuthScopes = "cookieAuth.Scopes"
+	CookieAuthScopes  = "cookieAuth.Scopes"
+	KioskAuthScopes   = "kioskAuth.Scopes"
+	StudentAuthScopes = "studentAuth.Scopes"
 )
 
+// Defines values for BookLabelRequestFields.
+const (
+	BookLabelRequestFieldsBarcode BookLabelRequestFields = "barcode"
+	BookLabelRequestFieldsId      BookLabelRequestFields = "id"
+	BookLabelRequestFieldsIsbn    BookLabelRequestFields = "isbn"
+	BookLabelRequestFieldsTitle   BookLabelRequestFields = "title"
+)
+
+// Defines values for BookLabelRequestSymbology.
+const (
+	BookLabelRequestSymbologyCode128 BookLabelRequestSymbology = "code128"
+	BookLabelRequestSymbologyQr      BookLabelRequestSymbology = "qr"
+)
+
+// Defines values for BookLabelRequestTemplate.
+const (
+	BookLabelRequestTemplateL7160 BookLabelRequestTemplate = "L7160"
+	BookLabelRequestTemplateL7163 BookLabelRequestTemplate = "L7163"
+	BookLabelRequestTemplateL7651 BookLabelRequestTemplate = "L7651"
+	BookLabelRequestTemplateN5160 BookLabelRequestTemplate = "5160"
+	BookLabelRequestTemplateN5163 BookLabelRequestTemplate = "5163"
+	BookLabelRequestTemplateN5167 BookLabelRequestTemplate = "5167"
+)
+
+// Defines values for BookLabelRequestValue.
+const (
+	BookLabelRequestValueBarcode BookLabelRequestValue = "barcode"
+	BookLabelRequestValueId      BookLabelRequestValue = "id"
+)
+
+// Defines values for HealthCheckStatus.
+const (
+	HealthCheckStatusFail HealthCheckStatus = "fail"
+	HealthCheckStatusOk   HealthCheckStatus = "ok"
+)
+
+// Defines values for HealthStatusStatus.
+const (
+	HealthStatusStatusFail HealthStatusStatus = "fail"
+	HealthStatusStatusOk   HealthStatusStatus = "ok"
+)
+
+// Defines values for ReceiptKind.
+const (
+	Checkout ReceiptKind = "checkout"
+	Return   ReceiptKind = "return"
+)
+
+// Defines values for ReportFileFormat.
+const (
+	ReportFileFormatCsv  ReportFileFormat = "csv"
+	ReportFileFormatHtml ReportFileFormat = "html"
+)
+
+// Defines values for ReportScheduleDelivery.
+const (
+	ReportScheduleDeliveryDirectory ReportScheduleDelivery = "directory"
+	ReportScheduleDeliveryEmail     ReportScheduleDelivery = "email"
+)
+
+// Defines values for ReportScheduleFormats.
+const (
+	ReportScheduleFormatsCsv  ReportScheduleFormats = "csv"
+	ReportScheduleFormatsHtml ReportScheduleFormats = "html"
+)
+
+// Defines values for ReportScheduleFrequency.
+const (
+	ReportScheduleFrequencyDaily   ReportScheduleFrequency = "daily"
+	ReportScheduleFrequencyMonthly ReportScheduleFrequency = "monthly"
+	ReportScheduleFrequencyWeekly  ReportScheduleFrequency = "weekly"
+)
+
+// Defines values for ReportScheduleReport.
+const (
+	ReportScheduleReportCollection ReportScheduleReport = "collection"
+	ReportScheduleReportOverdue    ReportScheduleReport = "overdue"
+	ReportScheduleReportRental     ReportScheduleReport = "rental"
+)
+
+// Defines values for ReportScheduleRequestDelivery.
+const (
+	ReportScheduleRequestDeliveryDirectory ReportScheduleRequestDelivery = "directory"
+	ReportScheduleRequestDeliveryEmail     ReportScheduleRequestDelivery = "email"
+)
+
+// Defines values for ReportScheduleRequestFormats.
+const (
+	ReportScheduleRequestFormatsCsv  ReportScheduleRequestFormats = "csv"
+	ReportScheduleRequestFormatsHtml ReportScheduleRequestFormats = "html"
+)
+
+// Defines values for ReportScheduleRequestFrequency.
+const (
+	ReportScheduleRequestFrequencyDaily   ReportScheduleRequestFrequency = "daily"
+	ReportScheduleRequestFrequencyMonthly ReportScheduleRequestFrequency = "monthly"
+	ReportScheduleRequestFrequencyWeekly  ReportScheduleRequestFrequency = "weekly"
+)
+
+// Defines values for ReportScheduleRequestReport.
+const (
+	ReportScheduleRequestReportCollection ReportScheduleRequestReport = "collection"
+	ReportScheduleRequestReportOverdue    ReportScheduleRequestReport = "overdue"
+	ReportScheduleRequestReportRental     ReportScheduleRequestReport = "rental"
+)
+
+// Defines values for ReturnCondition.
+const (
+	Damaged ReturnCondition = "damaged"
+	Good    ReturnCondition = "good"
+	Lost    ReturnCondition = "lost"
+)
+
+// Defines values for StudentLabelRequestFields.
+const (
+	StudentLabelRequestFieldsCardId StudentLabelRequestFields = "card_id"
+	StudentLabelRequestFieldsMajor  StudentLabelRequestFields = "major"
+	StudentLabelRequestFieldsName   StudentLabelRequestFields = "name"
+)
+
+// Defines values for StudentLabelRequestSymbology.
+const (
+	StudentLabelRequestSymbologyCode128 StudentLabelRequestSymbology = "code128"
+	StudentLabelRequestSymbologyQr      StudentLabelRequestSymbology = "qr"
+)
+
+// Defines values for StudentLabelRequestTemplate.
+const (
+	StudentLabelRequestTemplateL7160 StudentLabelRequestTemplate = "L7160"
+	StudentLabelRequestTemplateL7163 StudentLabelRequestTemplate = "L7163"
+	StudentLabelRequestTemplateL7651 StudentLabelRequestTemplate = "L7651"
+	StudentLabelRequestTemplateN5160 StudentLabelRequestTemplate = "5160"
+	StudentLabelRequestTemplateN5163 StudentLabelRequestTemplate = "5163"
+	StudentLabelRequestTemplateN5167 StudentLabelRequestTemplate = "5167"
+)
+
+// Defines values for StudentRentalStatus.
+const (
+	RENTED   StudentRentalStatus = "RENTED"
+	RETURNED StudentRentalStatus = "RETURNED"
+)
+
+// Defines values for BookSortParam.
+const (
+	BookSortParamCallNumber      BookSortParam = "call_number"
+	BookSortParamCount           BookSortParam = "count"
+	BookSortParamCreatedAt       BookSortParam = "created_at"
+	BookSortParamMinusCallNumber BookSortParam = "-call_number"
+	BookSortParamMinusCount      BookSortParam = "-count"
+	BookSortParamMinusCreatedAt  BookSortParam = "-created_at"
+	BookSortParamMinusTitle      BookSortParam = "-title"
+	BookSortParamTitle           BookSortParam = "title"
+)
+
+// Defines values for CollectionSortParam.
+const (
+	CollectionSortParamCopies           CollectionSortParam = "copies"
+	CollectionSortParamMinusCopies      CollectionSortParam = "-copies"
+	CollectionSortParamMinusRentals     CollectionSortParam = "-rentals"
+	CollectionSortParamMinusTitle       CollectionSortParam = "-title"
+	CollectionSortParamMinusUtilization CollectionSortParam = "-utilization"
+	CollectionSortParamRentals          CollectionSortParam = "rentals"
+	CollectionSortParamTitle            CollectionSortParam = "title"
+	CollectionSortParamUtilization      CollectionSortParam = "utilization"
+)
+
+// Defines values for HistorySortParam.
+const (
+	HistorySortParamCreatedAt      HistorySortParam = "created_at"
+	HistorySortParamMinusCreatedAt HistorySortParam = "-created_at"
+	HistorySortParamMinusTitle     HistorySortParam = "-title"
+	HistorySortParamTitle          HistorySortParam = "title"
+)
+
+// Defines values for OverdueSortParam.
+const (
+	OverdueSortParamCount          OverdueSortParam = "count"
+	OverdueSortParamCreatedAt      OverdueSortParam = "created_at"
+	OverdueSortParamMinusCount     OverdueSortParam = "-count"
+	OverdueSortParamMinusCreatedAt OverdueSortParam = "-created_at"
+	OverdueSortParamMinusName      OverdueSortParam = "-name"
+	OverdueSortParamName           OverdueSortParam = "name"
+)
+
+// Defines values for RentSortParam.
+const (
+	RentSortParamCreatedAt      RentSortParam = "created_at"
+	RentSortParamMinusCreatedAt RentSortParam = "-created_at"
+	RentSortParamMinusName      RentSortParam = "-name"
+	RentSortParamMinusTitle     RentSortParam = "-title"
+	RentSortParamName           RentSortParam = "name"
+	RentSortParamTitle          RentSortParam = "title"
+)
+
+// Defines values for ReturnSortParam.
+const (
+	ReturnSortParamMinusName       ReturnSortParam = "-name"
+	ReturnSortParamMinusReturnedAt ReturnSortParam = "-returned_at"
+	ReturnSortParamMinusTitle      ReturnSortParam = "-title"
+	ReturnSortParamName            ReturnSortParam = "name"
+	ReturnSortParamReturnedAt      ReturnSortParam = "returned_at"
+	ReturnSortParamTitle           ReturnSortParam = "title"
+)
+
+// Defines values for StudentSortParam.
+const (
+	StudentSortParamCreatedAt      StudentSortParam = "created_at"
+	StudentSortParamMajor          StudentSortParam = "major"
+	StudentSortParamMinusCreatedAt StudentSortParam = "-created_at"
+	StudentSortParamMinusMajor     StudentSortParam = "-major"
+	StudentSortParamMinusName      StudentSortParam = "-name"
+	StudentSortParamName           StudentSortParam = "name"
+)
+
+// Defines values for ListOrSearchBooksParamsAvailability.
+const (
+	Available  ListOrSearchBooksParamsAvailability = "available"
+	OutOfStock ListOrSearchBooksParamsAvailability = "out_of_stock"
+)
+
+// Defines values for ListOrSearchBooksParamsSort.
+const (
+	ListOrSearchBooksParamsSortCallNumber      ListOrSearchBooksParamsSort = "call_number"
+	ListOrSearchBooksParamsSortCount           ListOrSearchBooksParamsSort = "count"
+	ListOrSearchBooksParamsSortCreatedAt       ListOrSearchBooksParamsSort = "created_at"
+	ListOrSearchBooksParamsSortMinusCallNumber ListOrSearchBooksParamsSort = "-call_number"
+	ListOrSearchBooksParamsSortMinusCount      ListOrSearchBooksParamsSort = "-count"
+	ListOrSearchBooksParamsSortMinusCreatedAt  ListOrSearchBooksParamsSort = "-created_at"
+	ListOrSearchBooksParamsSortMinusTitle      ListOrSearchBooksParamsSort = "-title"
+	ListOrSearchBooksParamsSortTitle           ListOrSearchBooksParamsSort = "title"
+)
+
+// Defines values for GetBookBarcodeParamsType.
+const (
+	GetBookBarcodeParamsTypeCode128 GetBookBarcodeParamsType = "code128"
+	GetBookBarcodeParamsTypeQr      GetBookBarcodeParamsType = "qr"
+)
+
+// Defines values for GetBookBarcodeParamsValue.
+const (
+	Barcode GetBookBarcodeParamsValue = "barcode"
+	Id      GetBookBarcodeParamsValue = "id"
+)
+
+// Defines values for ListOverdueRentalsParamsSort.
+const (
+	ListOverdueRentalsParamsSortCount          ListOverdueRentalsParamsSort = "count"
+	ListOverdueRentalsParamsSortCreatedAt      ListOverdueRentalsParamsSort = "created_at"
+	ListOverdueRentalsParamsSortMinusCount     ListOverdueRentalsParamsSort = "-count"
+	ListOverdueRentalsParamsSortMinusCreatedAt ListOverdueRentalsParamsSort = "-created_at"
+	ListOverdueRentalsParamsSortMinusName      ListOverdueRentalsParamsSort = "-name"
+	ListOverdueRentalsParamsSortName           ListOverdueRentalsParamsSort = "name"
+)
+
+// Defines values for GetPortalHistoryParamsSort.
+const (
+	GetPortalHistoryParamsSortCreatedAt      GetPortalHistoryParamsSort = "created_at"
+	GetPortalHistoryParamsSortMinusCreatedAt GetPortalHistoryParamsSort = "-created_at"
+	GetPortalHistoryParamsSortMinusTitle     GetPortalHistoryParamsSort = "-title"
+	GetPortalHistoryParamsSortTitle          GetPortalHistoryParamsSort = "title"
+)
+
+// Defines values for ListRentsParamsSort.
+const (
+	ListRentsParamsSortCreatedAt      ListRentsParamsSort = "created_at"
+	ListRentsParamsSortMinusCreatedAt ListRentsParamsSort = "-created_at"
+	ListRentsParamsSortMinusName      ListRentsParamsSort = "-name"
+	ListRentsParamsSortMinusTitle     ListRentsParamsSort = "-title"
+	ListRentsParamsSortName           ListRentsParamsSort = "name"
+	ListRentsParamsSortTitle          ListRentsParamsSort = "title"
+)
+
+// Defines values for GetRentReceiptParamsFormat.
+const (
+	Pdf GetRentReceiptParamsFormat = "pdf"
+	Txt GetRentReceiptParamsFormat = "txt"
+)
+
+// Defines values for GetCirculationAnalyticsParamsInterval.
+const (
+	Day   GetCirculationAnalyticsParamsInterval = "day"
+	Month GetCirculationAnalyticsParamsInterval = "month"
+	Week  GetCirculationAnalyticsParamsInterval = "week"
+)
+
+// Defines values for GetCirculationAnalyticsParamsGroupBy.
+const (
+	GetCirculationAnalyticsParamsGroupByBook  GetCirculationAnalyticsParamsGroupBy = "book"
+	GetCirculationAnalyticsParamsGroupByMajor GetCirculationAnalyticsParamsGroupBy = "major"
+)
+
+// Defines values for GetCollectionReportParamsFormat.
+const (
+	GetCollectionReportParamsFormatCsv  GetCollectionReportParamsFormat = "csv"
+	GetCollectionReportParamsFormatJson GetCollectionReportParamsFormat = "json"
+)
+
+// Defines values for GetCollectionReportParamsSort.
+const (
+	GetCollectionReportParamsSortCopies           GetCollectionReportParamsSort = "copies"
+	GetCollectionReportParamsSortMinusCopies      GetCollectionReportParamsSort = "-copies"
+	GetCollectionReportParamsSortMinusRentals     GetCollectionReportParamsSort = "-rentals"
+	GetCollectionReportParamsSortMinusTitle       GetCollectionReportParamsSort = "-title"
+	GetCollectionReportParamsSortMinusUtilization GetCollectionReportParamsSort = "-utilization"
+	GetCollectionReportParamsSortRentals          GetCollectionReportParamsSort = "rentals"
+	GetCollectionReportParamsSortTitle            GetCollectionReportParamsSort = "title"
+	GetCollectionReportParamsSortUtilization      GetCollectionReportParamsSort = "utilization"
+)
+
+// Defines values for DownloadReportFileParamsFormat.
+const (
+	Csv  DownloadReportFileParamsFormat = "csv"
+	Html DownloadReportFileParamsFormat = "html"
+)
+
+// Defines values for ListReturnHistoryParamsSort.
+const (
+	ListReturnHistoryParamsSortMinusName       ListReturnHistoryParamsSort = "-name"
+	ListReturnHistoryParamsSortMinusReturnedAt ListReturnHistoryParamsSort = "-returned_at"
+	ListReturnHistoryParamsSortMinusTitle      ListReturnHistoryParamsSort = "-title"
+	ListReturnHistoryParamsSortName            ListReturnHistoryParamsSort = "name"
+	ListReturnHistoryParamsSortReturnedAt      ListReturnHistoryParamsSort = "returned_at"
+	ListReturnHistoryParamsSortTitle           ListReturnHistoryParamsSort = "title"
+)
+
+// Defines values for ListAllStudentsParamsSort.
+const (
+	ListAllStudentsParamsSortCreatedAt      ListAllStudentsParamsSort = "created_at"
+	ListAllStudentsParamsSortMajor          ListAllStudentsParamsSort = "major"
+	ListAllStudentsParamsSortMinusCreatedAt ListAllStudentsParamsSort = "-created_at"
+	ListAllStudentsParamsSortMinusMajor     ListAllStudentsParamsSort = "-major"
+	ListAllStudentsParamsSortMinusName      ListAllStudentsParamsSort = "-name"
+	ListAllStudentsParamsSortName           ListAllStudentsParamsSort = "name"
+)
+
+// Defines values for GetStudentBarcodeParamsType.
+const (
+	GetStudentBarcodeParamsTypeCode128 GetStudentBarcodeParamsType = "code128"
+	GetStudentBarcodeParamsTypeQr      GetStudentBarcodeParamsType = "qr"
+)
+
+// Defines values for GetStudentHistoryParamsSort.
+const (
+	CreatedAt      GetStudentHistoryParamsSort = "created_at"
+	MinusCreatedAt GetStudentHistoryParamsSort = "-created_at"
+	MinusTitle     GetStudentHistoryParamsSort = "-title"
+	Title          GetStudentHistoryParamsSort = "title"
+)
+
+// AgingBucket defines model for AgingBucket.
+type AgingBucket struct {
+	// Items Overdue books in the bucket
+	Items *int    `json:"items,omitempty"`
+	Label *string `json:"label,omitempty"`
+
+	// MaxDays Omitted for the open-ended last bucket
+	MaxDays *int `json:"max_days,omitempty"`
+	MinDays *int `json:"min_days,omitempty"`
+
+	// Students Students holding them
+	Students *int `json:"students,omitempty"`
+}
+
+// AnalyticsBucket defines model for AnalyticsBucket.
+type AnalyticsBucket struct {
+	// Checkouts Books checked out
+	Checkouts *int `json:"checkouts,omitempty"`
+
+	// NewStudents Students registered
+	NewStudents *int `json:"new_students,omitempty"`
+
+	// Overdue Books whose due date fell in the bucket and that were not back by then
+	Overdue *int `json:"overdue,omitempty"`
+
+	// Returns Books returned
+	Returns *int                `json:"returns,omitempty"`
+	Start   *openapi_types.Date `json:"start,omitempty"`
+}
+
+// AnalyticsCounts defines model for AnalyticsCounts.
+type AnalyticsCounts struct {
+	// Checkouts Books checked out
+	Checkouts *int `json:"checkouts,omitempty"`
+
+	// NewStudents Students registered
+	NewStudents *int `json:"new_students,omitempty"`
+
+	// Overdue Books whose due date fell in the bucket and that were not back by then
+	Overdue *int `json:"overdue,omitempty"`
+
+	// Returns Books returned
+	Returns *int `json:"returns,omitempty"`
+}
+
+// AnalyticsGroup defines model for AnalyticsGroup.
+type AnalyticsGroup struct {
+	Buckets *[]AnalyticsBucket `json:"buckets,omitempty"`
+
+	// Key The major, or the book id
+	Key *string `json:"key,omitempty"`
+
+	// Label The major, or the book title
+	Label  *string          `json:"label,omitempty"`
+	Totals *AnalyticsCounts `json:"totals,omitempty"`
+}
+
+// AnalyticsResponse defines model for AnalyticsResponse.
+type AnalyticsResponse struct {
+	Buckets  *[]AnalyticsBucket  `json:"buckets,omitempty"`
+	From     *openapi_types.Date `json:"from,omitempty"`
+	GroupBy  *string             `json:"group_by,omitempty"`
+	Groups   *[]AnalyticsGroup   `json:"groups,omitempty"`
+	Interval *string             `json:"interval,omitempty"`
+	To       *openapi_types.Date `json:"to,omitempty"`
+	Totals   *AnalyticsCounts    `json:"totals,omitempty"`
+}
+
+// BookClassification defines model for BookClassification.
+type BookClassification struct {
+	CallNumber *string `json:"call_number,omitempty"`
+
+	// CategoryId Category to file the book under; omit to leave it unfiled
+	CategoryId *openapi_types.UUID `json:"category_id,omitempty"`
+	Tags       *[]string           `json:"tags,omitempty"`
+}
+
+// BookFacets Number of books matching the search and every filter except `availability`,
+// split by availability.
+type BookFacets struct {
+	// Available Matching books with at least one copy on the shelf
+	Available *int64 `json:"available,omitempty"`
+
+	// OutOfStock Matching books with no copies left
+	OutOfStock *int64 `json:"out_of_stock,omitempty"`
+}
+
+// BookLabelRequest defines model for BookLabelRequest.
+type BookLabelRequest struct {
+	// Copies Number of labels printed for each entry
+	Copies *int `json:"copies,omitempty"`
+
+	// Fields Lines of text on each label, by default the title and the encoded value
+	Fields *[]BookLabelRequestFields `json:"fields,omitempty"`
+	Ids    []openapi_types.UUID      `json:"ids"`
+
+	// Start Position of the first label on the first sheet, counted across the rows
+	Start     *int                       `json:"start,omitempty"`
+	Symbology *BookLabelRequestSymbology `json:"symbology,omitempty"`
+
+	// Template Avery product code of the label sheet
+	Template *BookLabelRequestTemplate `json:"template,omitempty"`
+
+	// Value What the barcodes encode
+	Value *BookLabelRequestValue `json:"value,omitempty"`
+}
+
+// BookLabelRequestFields defines model for BookLabelRequest.Fields.
+type BookLabelRequestFields string
+
+// BookLabelRequestSymbology defines model for BookLabelRequest.Symbology.
+type BookLabelRequestSymbology string
+
+// BookLabelRequestTemplate Avery product code of the label sheet
+type BookLabelRequestTemplate string
+
+// BookLabelRequestValue What the barcodes encode
+type BookLabelRequestValue string
+
 // BookRentStats defines model for BookRentStats.
 type BookRentStats struct {
 	BookTitle   *string `json:"book_title,omitempty"`
@@ -37,27 +508,175 @@ type BookRentStats struct {
 // Books defines model for Books.
 type Books = models.Book
 
-// Error defines model for Error.
-type Error struct {
-	// Code Error code
-	Code int32 `json:"code"`
+// BorrowedTitle defines model for BorrowedTitle.
+type BorrowedTitle struct {
+	BookId    *openapi_types.UUID `json:"book_id,omitempty"`
+	BookTitle *string             `json:"book_title,omitempty"`
+	Count     *int                `json:"count,omitempty"`
+}
+
+// Category defines model for Category.
+type Category = models.Category
+
+// CategoryNode defines model for CategoryNode.
+type CategoryNode struct {
+	// Available Copies of those books on the shelf
+	Available *int64 `json:"available,omitempty"`
+
+	// Books Books filed under the category and its subcategories
+	Books    *int64          `json:"books,omitempty"`
+	Children *[]CategoryNode `json:"children,omitempty"`
+
+	// Copies Copies of those books, on the shelf or on loan
+	Copies   *int64              `json:"copies,omitempty"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+	Name     *string             `json:"name,omitempty"`
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+}
+
+// CategoryRequest defines model for CategoryRequest.
+type CategoryRequest struct {
+	Name string `json:"name"`
+
+	// ParentId Parent category; omit for a root category
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+}
+
+// CollectionItem defines model for CollectionItem.
+type CollectionItem struct {
+	Available *int `json:"available,omitempty"`
+
+	// AvgLoanDays Average duration of the loans that ended in the period
+	AvgLoanDays *float32            `json:"avg_loan_days"`
+	Barcode     *string             `json:"barcode,omitempty"`
+	BookId      *openapi_types.UUID `json:"book_id,omitempty"`
+
+	// Copies Copies on the shelf and on loan now
+	Copies              *int    `json:"copies,omitempty"`
+	DemandExceedsSupply *bool   `json:"demand_exceeds_supply,omitempty"`
+	Isbn                *string `json:"isbn,omitempty"`
+
+	// Rentals Checkouts in the period
+	Rentals *int    `json:"rentals,omitempty"`
+	Title   *string `json:"title,omitempty"`
 
-	// Message Error message
+	// UtilizationPct Share of the period the copies were on loan, in percent
+	UtilizationPct  *float32 `json:"utilization_pct,omitempty"`
+	ZeroCirculation *bool    `json:"zero_circulation,omitempty"`
+}
+
+// CollectionReport defines model for CollectionReport.
+type CollectionReport struct {
+	From       *openapi_types.Date `json:"from,omitempty"`
+	Pagination *PaginationInfo     `json:"pagination,omitempty"`
+	Results    *[]CollectionItem   `json:"results,omitempty"`
+	Summary    *CollectionSummary  `json:"summary,omitempty"`
+	To         *openapi_types.Date `json:"to,omitempty"`
+}
+
+// CollectionSummary defines model for CollectionSummary.
+type CollectionSummary struct {
+	Copies              *int `json:"copies,omitempty"`
+	DemandExceedsSupply *int `json:"demand_exceeds_supply,omitempty"`
+	Rentals             *int `json:"rentals,omitempty"`
+	Titles              *int `json:"titles,omitempty"`
+	ZeroCirculation     *int `json:"zero_circulation,omitempty"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Code    string `json:"code"`
+	Field   string `json:"field"`
 	Message string `json:"message"`
 }
 
+// HealthCheck defines model for HealthCheck.
+type HealthCheck struct {
+	Message *string           `json:"message,omitempty"`
+	Status  HealthCheckStatus `json:"status"`
+}
+
+// HealthCheckStatus defines model for HealthCheck.Status.
+type HealthCheckStatus string
+
+// HealthStatus defines model for HealthStatus.
+type HealthStatus struct {
+	Checks *map[string]HealthCheck `json:"checks,omitempty"`
+	Status HealthStatusStatus      `json:"status"`
+}
+
+// HealthStatusStatus defines model for HealthStatus.Status.
+type HealthStatusStatus string
+
+// KioskDevice defines model for KioskDevice.
+type KioskDevice = models.KioskDevice
+
+// KioskIdentifyRequest defines model for KioskIdentifyRequest.
+type KioskIdentifyRequest struct {
+	CardId string `json:"card_id"`
+}
+
+// KioskRegistration defines model for KioskRegistration.
+type KioskRegistration = models.KioskDevice
+
+// KioskRegistrationRequest defines model for KioskRegistrationRequest.
+type KioskRegistrationRequest struct {
+	Name string `json:"name"`
+}
+
+// KioskRequest The card of the student and at least one entry in book_ids or book_codes
+type KioskRequest struct {
+	// BookCodes Book barcodes or ISBNs (with or without hyphens)
+	BookCodes *[]string             `json:"book_codes,omitempty"`
+	BookIds   *[]openapi_types.UUID `json:"book_ids,omitempty"`
+	CardId    string                `json:"card_id"`
+}
+
+// KioskStudent defines model for KioskStudent.
+type KioskStudent struct {
+	FirstName *string `json:"first_name,omitempty"`
+	LastName  *string `json:"last_name,omitempty"`
+
+	// Loans Books the student has out, oldest first
+	Loans     *[]StudentRental    `json:"loans,omitempty"`
+	StudentId *openapi_types.UUID `json:"student_id,omitempty"`
+}
+
+// KioskTransaction defines model for KioskTransaction.
+type KioskTransaction = models.KioskTransaction
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest = models.Librarian
 
+// OverdueItem defines model for OverdueItem.
+type OverdueItem struct {
+	BookId *openapi_types.UUID `json:"book_id,omitempty"`
+	CartId *openapi_types.UUID `json:"cart_id,omitempty"`
+
+	// DaysOverdue Started days past due, at least 1
+	DaysOverdue *int                `json:"days_overdue,omitempty"`
+	DueDate     *time.Time          `json:"due_date,omitempty"`
+	RentId      *openapi_types.UUID `json:"rent_id,omitempty"`
+	RentedAt    *time.Time          `json:"rented_at,omitempty"`
+	Title       *string             `json:"title,omitempty"`
+}
+
 // OverdueUser defines model for OverdueUser.
 type OverdueUser struct {
-	CardId      *string             `json:"card_id,omitempty"`
-	CartId      *openapi_types.UUID `json:"cart_id,omitempty"`
-	DateRented  *time.Time          `json:"date_rented,omitempty"`
+	CardId *string `json:"card_id,omitempty"`
+
+	// DateRented Checkout of the oldest overdue book
+	DateRented *time.Time `json:"date_rented,omitempty"`
+
+	// DaysOverdue Days the oldest overdue book is past due
 	DaysOverdue *int                `json:"days_overdue,omitempty"`
+	Items       *[]OverdueItem      `json:"items,omitempty"`
 	Phone       *string             `json:"phone,omitempty"`
+	StudentId   *openapi_types.UUID `json:"student_id,omitempty"`
 	StudentName *string             `json:"student_name,omitempty"`
-	TotalBooks  *int                `json:"total_books,omitempty"`
+
+	// TotalBooks Number of overdue books
+	TotalBooks *int `json:"total_books,omitempty"`
 }
 
 // PaginationInfo defines model for PaginationInfo.
@@ -71,13 +690,108 @@ type PaginationInfo struct {
 	// Limit Maximum number of items returned
 	Limit *int `json:"limit,omitempty"`
 
+	// NextCursor Cursor for the next page; omitted on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+
 	// Offset Number of items skipped
 	Offset *int `json:"offset,omitempty"`
 
+	// PrevCursor Cursor for the previous page; omitted on the first page
+	PrevCursor *string `json:"prev_cursor,omitempty"`
+
 	// Total Total number of items available
 	Total *int `json:"total,omitempty"`
 }
 
+// PortalLinkRequest defines model for PortalLinkRequest.
+type PortalLinkRequest struct {
+	CardId string `json:"card_id"`
+}
+
+// PortalLoginRequest defines model for PortalLoginRequest.
+type PortalLoginRequest struct {
+	CardId string `json:"card_id"`
+	Pin    string `json:"pin"`
+}
+
+// PortalLoginResponse defines model for PortalLoginResponse.
+type PortalLoginResponse struct {
+	Message   *string             `json:"message,omitempty"`
+	StudentId *openapi_types.UUID `json:"student_id,omitempty"`
+}
+
+// PortalTokenRequest defines model for PortalTokenRequest.
+type PortalTokenRequest struct {
+	Token string `json:"token"`
+}
+
+// Problem RFC 7807 problem details. `code` is a stable, machine-readable identifier;
+// clients should branch on it rather than on `detail`. Known codes:
+// `bad_request`, `validation_failed`, `invalid_id`, `book_ids_required`,
+// `unauthorized`, `invalid_credentials`, `forbidden`, `csrf_token_invalid`,
+// `not_found`, `book_not_found`, `student_not_found`, `cart_not_found`,
+// `rent_not_found`, `report_schedule_not_found`, `report_file_not_found`,
+// `login_link_unavailable`, `kiosk_not_found`,
+// `method_not_allowed`, `conflict`, `book_exists`, `student_card_exists`,
+// `librarian_exists`, `cart_not_rented`, `cart_ambiguous`, `return_incomplete`, `insufficient_stock`,
+// `rental_limit_exceeded`, `invalid_login_token`, `invalid_kiosk_key`, `pin_locked`, `internal_error` and `service_unavailable`.
+type Problem struct {
+	// Code Stable error code
+	Code string `json:"code"`
+
+	// Detail Human-readable explanation of this occurrence
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Per-field validation failures, or the request identifiers that matched nothing
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance Request path that produced the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// RequestId Identifier of the request, also returned in the X-Request-ID header
+	RequestId *string `json:"request_id,omitempty"`
+
+	// Status HTTP status code
+	Status int32 `json:"status"`
+
+	// Title Short summary of the HTTP status
+	Title string `json:"title"`
+
+	// Type URI reference identifying the problem type
+	Type string `json:"type"`
+}
+
+// Receipt defines model for Receipt.
+type Receipt struct {
+	CartId   *openapi_types.UUID `json:"cart_id,omitempty"`
+	IssuedAt *time.Time          `json:"issued_at,omitempty"`
+	Items    *[]ReceiptItem      `json:"items,omitempty"`
+	Kind     *ReceiptKind        `json:"kind,omitempty"`
+
+	// Kiosk Name of the kiosk that issued the receipt
+	Kiosk   *string `json:"kiosk,omitempty"`
+	Student *struct {
+		CardId *string             `json:"card_id,omitempty"`
+		Id     *openapi_types.UUID `json:"id,omitempty"`
+		Name   *string             `json:"name,omitempty"`
+	} `json:"student,omitempty"`
+}
+
+// ReceiptKind defines model for Receipt.Kind.
+type ReceiptKind string
+
+// ReceiptItem defines model for ReceiptItem.
+type ReceiptItem struct {
+	BookId    *openapi_types.UUID `json:"book_id,omitempty"`
+	Condition *ReturnCondition    `json:"condition,omitempty"`
+	DueDate   *time.Time          `json:"due_date,omitempty"`
+
+	// OverdueDays Whole days the book was kept past its due date
+	OverdueDays  *int       `json:"overdue_days,omitempty"`
+	ReturnedDate *time.Time `json:"returned_date,omitempty"`
+	Title        *string    `json:"title,omitempty"`
+}
+
 // RentReport defines model for RentReport.
 type RentReport struct {
 	TopBooks      *[]BookRentStats `json:"top_books,omitempty"`
@@ -86,10 +800,13 @@ type RentReport struct {
 	TotalStudents *int             `json:"total_students,omitempty"`
 }
 
-// RentRequest defines model for RentRequest.
+// RentRequest Either student_id or card_id, and at least one entry in book_ids or book_codes
 type RentRequest struct {
-	BookIds   []openapi_types.UUID `json:"book_ids"`
-	StudentId openapi_types.UUID   `json:"student_id"`
+	// BookCodes Book barcodes or ISBNs (with or without hyphens)
+	BookCodes *[]string             `json:"book_codes,omitempty"`
+	BookIds   *[]openapi_types.UUID `json:"book_ids,omitempty"`
+	CardId    *string               `json:"card_id,omitempty"`
+	StudentId *openapi_types.UUID   `json:"student_id,omitempty"`
 }
 
 // RentSummary defines model for RentSummary.
@@ -101,37 +818,446 @@ type RentSummary struct {
 	StudentName *string             `json:"student_name,omitempty"`
 }
 
-// Students defines model for Students.
-type Students = models.Student
+// ReportFile defines model for ReportFile.
+type ReportFile struct {
+	ContentType *string           `json:"content_type,omitempty"`
+	Format      *ReportFileFormat `json:"format,omitempty"`
+	Name        *string           `json:"name,omitempty"`
+	Size        *int              `json:"size,omitempty"`
 
-// LimitParam defines model for limitParam.
-type LimitParam = int32
+	// Url Path to download the file
+	Url *string `json:"url,omitempty"`
+}
 
-// OffsetParam defines model for offsetParam.
-type OffsetParam = int32
+// ReportFileFormat defines model for ReportFile.Format.
+type ReportFileFormat string
 
-// InternalServerError defines model for InternalServerError.
-type InternalServerError = Error
+// ReportParams Parameters of a saved report. `student_card_id` applies to the overdue list, `limit` to
+// the rental report and the others to the collection report.
+type ReportParams struct {
+	DemandExceedsSupply *bool `json:"demand_exceeds_supply,omitempty"`
 
-// InvalidRequestBody defines model for InvalidRequestBody.
-type InvalidRequestBody = Error
+	// Limit Number of most rented titles. Defaults to 10
+	Limit *int `json:"limit,omitempty"`
 
-// InvalidRequestParameters defines model for InvalidRequestParameters.
-type InvalidRequestParameters = Error
+	// PeriodDays Number of full days before the run the report covers. Defaults to 90
+	PeriodDays *int `json:"period_days,omitempty"`
 
-// UnauthorizedError defines model for UnauthorizedError.
-type UnauthorizedError = Error
+	// StudentCardId Only the overdue books of this student
+	StudentCardId   *string `json:"student_card_id,omitempty"`
+	ZeroCirculation *bool   `json:"zero_circulation,omitempty"`
+}
 
-// ListOrSearchBooksParams defines parameters for ListOrSearchBooks.
-type ListOrSearchBooksParams struct {
-	// Query Optional search term (partial title match or exact ID match)
-	Query *string `form:"query,omitempty" json:"query,omitempty"`
+// ReportRun defines model for ReportRun.
+type ReportRun = models.ReportRun
 
+// ReportSchedule defines model for ReportSchedule.
+type ReportSchedule struct {
+	// DayOfMonth Day of a monthly schedule
+	DayOfMonth *int                    `json:"day_of_month,omitempty"`
+	Delivery   ReportScheduleDelivery  `json:"delivery"`
+	Enabled    *bool                   `json:"enabled,omitempty"`
+	Formats    []ReportScheduleFormats `json:"formats"`
+	Frequency  ReportScheduleFrequency `json:"frequency"`
+
+	// Hour Hour of the day, in UTC
+	Hour int                 `json:"hour"`
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+
+	// LastRunAt Time of the last scheduled run, not counting runs started by hand
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	Name      string     `json:"name"`
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+
+	// Params Parameters of a saved report. `student_card_id` applies to the overdue list, `limit` to
+	// the rental report and the others to the collection report.
+	Params *ReportParams `json:"params,omitempty"`
+
+	// Recipients Email addresses, required for email delivery
+	Recipients *[]openapi_types.Email `json:"recipients,omitempty"`
+	Report     ReportScheduleReport   `json:"report"`
+
+	// Weekday Day of a weekly schedule, 0 for Sunday
+	Weekday *int `json:"weekday,omitempty"`
+}
+
+// ReportScheduleDelivery defines model for ReportSchedule.Delivery.
+type ReportScheduleDelivery string
+
+// ReportScheduleFormats defines model for ReportSchedule.Formats.
+type ReportScheduleFormats string
+
+// ReportScheduleFrequency defines model for ReportSchedule.Frequency.
+type ReportScheduleFrequency string
+
+// ReportScheduleReport defines model for ReportSchedule.Report.
+type ReportScheduleReport string
+
+// ReportScheduleRequest defines model for ReportScheduleRequest.
+type ReportScheduleRequest struct {
+	// DayOfMonth Day of a monthly schedule
+	DayOfMonth *int                           `json:"day_of_month,omitempty"`
+	Delivery   ReportScheduleRequestDelivery  `json:"delivery"`
+	Enabled    *bool                          `json:"enabled,omitempty"`
+	Formats    []ReportScheduleRequestFormats `json:"formats"`
+	Frequency  ReportScheduleRequestFrequency `json:"frequency"`
+
+	// Hour Hour of the day, in UTC
+	Hour int    `json:"hour"`
+	Name string `json:"name"`
+
+	// Params Parameters of a saved report. `student_card_id` applies to the overdue list, `limit` to
+	// the rental report and the others to the collection report.
+	Params *ReportParams `json:"params,omitempty"`
+
+	// Recipients Email addresses, required for email delivery
+	Recipients *[]openapi_types.Email      `json:"recipients,omitempty"`
+	Report     ReportScheduleRequestReport `json:"report"`
+
+	// Weekday Day of a weekly schedule, 0 for Sunday
+	Weekday *int `json:"weekday,omitempty"`
+}
+
+// ReportScheduleRequestDelivery defines model for ReportScheduleRequest.Delivery.
+type ReportScheduleRequestDelivery string
+
+// ReportScheduleRequestFormats defines model for ReportScheduleRequest.Formats.
+type ReportScheduleRequestFormats string
+
+// ReportScheduleRequestFrequency defines model for ReportScheduleRequest.Frequency.
+type ReportScheduleRequestFrequency string
+
+// ReportScheduleRequestReport defines model for ReportScheduleRequest.Report.
+type ReportScheduleRequestReport string
+
+// ReturnCondition defines model for ReturnCondition.
+type ReturnCondition string
+
+// ReturnItem A returned book, identified by book_id or book_code
+type ReturnItem struct {
+	// BookCode Barcode or ISBN of the book, used when book_id is not given
+	BookCode  *string             `json:"book_code,omitempty"`
+	BookId    *openapi_types.UUID `json:"book_id,omitempty"`
+	Condition *ReturnCondition    `json:"condition,omitempty"`
+	Notes     *string             `json:"notes,omitempty"`
+}
+
+// ReturnRecord defines model for ReturnRecord.
+type ReturnRecord struct {
+	BookId    *openapi_types.UUID `json:"book_id,omitempty"`
+	BookTitle *string             `json:"book_title,omitempty"`
+	CardId    *string             `json:"card_id,omitempty"`
+	CartId    *openapi_types.UUID `json:"cart_id,omitempty"`
+	Condition *ReturnCondition    `json:"condition,omitempty"`
+
+	// Librarian Username of the librarian who processed the return
+	Librarian   *string             `json:"librarian,omitempty"`
+	LibrarianId *openapi_types.UUID `json:"librarian_id,omitempty"`
+	Notes       *string             `json:"notes,omitempty"`
+	RentId      *openapi_types.UUID `json:"rent_id,omitempty"`
+	ReturnId    *openapi_types.UUID `json:"return_id,omitempty"`
+	ReturnedAt  *time.Time          `json:"returned_at,omitempty"`
+	StudentId   *openapi_types.UUID `json:"student_id,omitempty"`
+	StudentName *string             `json:"student_name,omitempty"`
+}
+
+// StudentContactRequest defines model for StudentContactRequest.
+type StudentContactRequest struct {
+	// Email Email address for sign-in links; empty to remove it
+	Email *string `json:"email,omitempty"`
+	Phone string  `json:"phone"`
+}
+
+// StudentLabelRequest defines model for StudentLabelRequest.
+type StudentLabelRequest struct {
+	// Copies Number of labels printed for each entry
+	Copies *int `json:"copies,omitempty"`
+
+	// Fields Lines of text on each label, by default the name and the card id
+	Fields *[]StudentLabelRequestFields `json:"fields,omitempty"`
+	Ids    []openapi_types.UUID         `json:"ids"`
+
+	// Start Position of the first label on the first sheet, counted across the rows
+	Start     *int                          `json:"start,omitempty"`
+	Symbology *StudentLabelRequestSymbology `json:"symbology,omitempty"`
+
+	// Template Avery product code of the label sheet
+	Template *StudentLabelRequestTemplate `json:"template,omitempty"`
+}
+
+// StudentLabelRequestFields defines model for StudentLabelRequest.Fields.
+type StudentLabelRequestFields string
+
+// StudentLabelRequestSymbology defines model for StudentLabelRequest.Symbology.
+type StudentLabelRequestSymbology string
+
+// StudentLabelRequestTemplate Avery product code of the label sheet
+type StudentLabelRequestTemplate string
+
+// StudentPinRequest defines model for StudentPinRequest.
+type StudentPinRequest struct {
+	// Pin 4 to 12 digits
+	Pin string `json:"pin"`
+}
+
+// StudentRental defines model for StudentRental.
+type StudentRental struct {
+	BookId    *openapi_types.UUID `json:"book_id,omitempty"`
+	BookTitle *string             `json:"book_title,omitempty"`
+	CartId    *openapi_types.UUID `json:"cart_id,omitempty"`
+	Condition *ReturnCondition    `json:"condition,omitempty"`
+	DueDate   *time.Time          `json:"due_date,omitempty"`
+
+	// OverdueDays Whole days the book was kept (or has been kept so far) past its due date
+	OverdueDays *int                `json:"overdue_days,omitempty"`
+	RentId      *openapi_types.UUID `json:"rent_id,omitempty"`
+	RentedDate  *time.Time          `json:"rented_date,omitempty"`
+
+	// ReturnedDate When the book was returned; absent while it is still out
+	ReturnedDate *time.Time           `json:"returned_date,omitempty"`
+	Status       *StudentRentalStatus `json:"status,omitempty"`
+}
+
+// StudentRentalStatus defines model for StudentRental.Status.
+type StudentRentalStatus string
+
+// StudentSummary defines model for StudentSummary.
+type StudentSummary struct {
+	CurrentlyBorrowed *int64     `json:"currently_borrowed,omitempty"`
+	LastRentedAt      *time.Time `json:"last_rented_at,omitempty"`
+
+	// MostBorrowed Up to five titles the student borrowed most often
+	MostBorrowed *[]BorrowedTitle `json:"most_borrowed,omitempty"`
+
+	// OverdueCount Books currently out past their due date
+	OverdueCount *int64    `json:"overdue_count,omitempty"`
+	Student      *Students `json:"student,omitempty"`
+
+	// TotalRentals Books borrowed in total
+	TotalRentals *int64 `json:"total_rentals,omitempty"`
+}
+
+// Students defines model for Students.
+type Students = models.Student
+
+// TagCount defines model for TagCount.
+type TagCount struct {
+	Books *int64  `json:"books,omitempty"`
+	Tag   *string `json:"tag,omitempty"`
+}
+
+// VersionInfo defines model for VersionInfo.
+type VersionInfo struct {
+	// BuildDate Build timestamp, if provided at build time
+	BuildDate *string `json:"build_date,omitempty"`
+
+	// BuildRef Git ref the binary was built from
+	BuildRef  string `json:"build_ref"`
+	GoVersion string `json:"go_version"`
+
+	// SchemaVersion Database schema version expected by this build
+	SchemaVersion int `json:"schema_version"`
+}
+
+// BookSortParam defines model for bookSortParam.
+type BookSortParam string
+
+// CollectionSortParam defines model for collectionSortParam.
+type CollectionSortParam string
+
+// CursorParam defines model for cursorParam.
+type CursorParam = string
+
+// HistorySortParam defines model for historySortParam.
+type HistorySortParam string
+
+// LimitParam defines model for limitParam.
+type LimitParam = int32
+
+// OffsetParam defines model for offsetParam.
+type OffsetParam = int32
+
+// OverdueSortParam defines model for overdueSortParam.
+type OverdueSortParam string
+
+// RentSortParam defines model for rentSortParam.
+type RentSortParam string
+
+// ReturnSortParam defines model for returnSortParam.
+type ReturnSortParam string
+
+// StudentSortParam defines model for studentSortParam.
+type StudentSortParam string
+
+// ConflictError RFC 7807 problem details. `code` is a stable, machine-readable identifier;
+// clients should branch on it rather than on `detail`. Known codes:
+// `bad_request`, `validation_failed`, `invalid_id`, `book_ids_required`,
+// `unauthorized`, `invalid_credentials`, `forbidden`, `csrf_token_invalid`,
+// `not_found`, `book_not_found`, `student_not_found`, `cart_not_found`,
+// `rent_not_found`, `report_schedule_not_found`, `report_file_not_found`,
+// `login_link_unavailable`, `kiosk_not_found`,
+// `method_not_allowed`, `conflict`, `book_exists`, `student_card_exists`,
+// `librarian_exists`, `cart_not_rented`, `cart_ambiguous`, `return_incomplete`, `insufficient_stock`,
+// `rental_limit_exceeded`, `invalid_login_token`, `invalid_kiosk_key`, `pin_locked`, `internal_error` and `service_unavailable`.
+type ConflictError = Problem
+
+// InternalServerError RFC 7807 problem details. `code` is a stable, machine-readable identifier;
+// clients should branch on it rather than on `detail`. Known codes:
+// `bad_request`, `validation_failed`, `invalid_id`, `book_ids_required`,
+// `unauthorized`, `invalid_credentials`, `forbidden`, `csrf_token_invalid`,
+// `not_found`, `book_not_found`, `student_not_found`, `cart_not_found`,
+// `rent_not_found`, `report_schedule_not_found`, `report_file_not_found`,
+// `login_link_unavailable`, `kiosk_not_found`,
+// `method_not_allowed`, `conflict`, `book_exists`, `student_card_exists`,
+// `librarian_exists`, `cart_not_rented`, `cart_ambiguous`, `return_incomplete`, `insufficient_stock`,
+// `rental_limit_exceeded`, `invalid_login_token`, `invalid_kiosk_key`, `pin_locked`, `internal_error` and `service_unavailable`.
+type InternalServerError = Problem
+
+// InvalidRequestBody RFC 7807 problem details. `code` is a stable, machine-readable identifier;
+// clients should branch on it rather than on `detail`. Known codes:
+// `bad_request`, `validation_failed`, `invalid_id`, `book_ids_required`,
+// `unauthorized`, `invalid_credentials`, `forbidden`, `csrf_token_invalid`,
+// `not_found`, `book_not_found`, `student_not_found`, `cart_not_found`,
+// `rent_not_found`, `report_schedule_not_found`, `report_file_not_found`,
+// `login_link_unavailable`, `kiosk_not_found`,
+// `method_not_allowed`, `conflict`, `book_exists`, `student_card_exists`,
+// `librarian_exists`, `cart_not_rented`, `cart_ambiguous`, `return_incomplete`, `insufficient_stock`,
+// `rental_limit_exceeded`, `invalid_login_token`, `invalid_kiosk_key`, `pin_locked`, `internal_error` and `service_unavailable`.
+type InvalidRequestBody = Problem
+
+// InvalidRequestParameters RFC 7807 problem details. `code` is a stable, machine-readable identifier;
+// clients should branch on it rather than on `detail`. Known codes:
+// `bad_request`, `validation_failed`, `invalid_id`, `book_ids_required`,
+// `unauthorized`, `invalid_credentials`, `forbidden`, `csrf_token_invalid`,
+// `not_found`, `book_not_found`, `student_not_found`, `cart_not_found`,
+// `rent_not_found`, `report_schedule_not_found`, `report_file_not_found`,
+// `login_link_unavailable`, `kiosk_not_found`,
+// `method_not_allowed`, `conflict`, `book_exists`, `student_card_exists`,
+// `librarian_exists`, `cart_not_rented`, `cart_ambiguous`, `return_incomplete`, `insufficient_stock`,
+// `rental_limit_exceeded`, `invalid_login_token`, `invalid_kiosk_key`, `pin_locked`, `internal_error` and `service_unavailable`.
+type InvalidRequestParameters = Problem
+
+// NotFoundError RFC 7807 problem details. `code` is a stable, machine-readable identifier;
+// clients should branch on it rather than on `detail`. Known codes:
+// `bad_request`, `validation_failed`, `invalid_id`, `book_ids_required`,
+// `unauthorized`, `invalid_credentials`, `forbidden`, `csrf_token_invalid`,
+// `not_found`, `book_not_found`, `student_not_found`, `cart_not_found`,
+// `rent_not_found`, `report_schedule_not_found`, `report_file_not_found`,
+// `login_link_unavailable`, `kiosk_not_found`,
+// `method_not_allowed`, `conflict`, `book_exists`, `student_card_exists`,
+// `librarian_exists`, `cart_not_rented`, `cart_ambiguous`, `return_incomplete`, `insufficient_stock`,
+// `rental_limit_exceeded`, `invalid_login_token`, `invalid_kiosk_key`, `pin_locked`, `internal_error` and `service_unavailable`.
+type NotFoundError = Problem
+
+// UnauthorizedError RFC 7807 problem details. `code` is a stable, machine-readable identifier;
+// clients should branch on it rather than on `detail`. Known codes:
+// `bad_request`, `validation_failed`, `invalid_id`, `book_ids_required`,
+// `unauthorized`, `invalid_credentials`, `forbidden`, `csrf_token_invalid`,
+// `not_found`, `book_not_found`, `student_not_found`, `cart_not_found`,
+// `rent_not_found`, `report_schedule_not_found`, `report_file_not_found`,
+// `login_link_unavailable`, `kiosk_not_found`,
+// `method_not_allowed`, `conflict`, `book_exists`, `student_card_exists`,
+// `librarian_exists`, `cart_not_rented`, `cart_ambiguous`, `return_incomplete`, `insufficient_stock`,
+// `rental_limit_exceeded`, `invalid_login_token`, `invalid_kiosk_key`, `pin_locked`, `internal_error` and `service_unavailable`.
+type UnauthorizedError = Problem
+
+// UnprocessableError RFC 7807 problem details. `code` is a stable, machine-readable identifier;
+// clients should branch on it rather than on `detail`. Known codes:
+// `bad_request`, `validation_failed`, `invalid_id`, `book_ids_required`,
+// `unauthorized`, `invalid_credentials`, `forbidden`, `csrf_token_invalid`,
+// `not_found`, `book_not_found`, `student_not_found`, `cart_not_found`,
+// `rent_not_found`, `report_schedule_not_found`, `report_file_not_found`,
+// `login_link_unavailable`, `kiosk_not_found`,
+// `method_not_allowed`, `conflict`, `book_exists`, `student_card_exists`,
+// `librarian_exists`, `cart_not_rented`, `cart_ambiguous`, `return_incomplete`, `insufficient_stock`,
+// `rental_limit_exceeded`, `invalid_login_token`, `invalid_kiosk_key`, `pin_locked`, `internal_error` and `service_unavailable`.
+type UnprocessableError = Problem
+
+// ListOrSearchBooksParams defines parameters for ListOrSearchBooks.
+type ListOrSearchBooksParams struct {
+	// Query Optional search term (partial title match or exact ID match)
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Availability Only books with copies on the shelf (`available`) or none left (`out_of_stock`)
+	Availability *ListOrSearchBooksParamsAvailability `form:"availability,omitempty" json:"availability,omitempty"`
+
+	// MinCount Minimum number of copies on the shelf (inclusive)
+	MinCount *int32 `form:"min_count,omitempty" json:"min_count,omitempty"`
+
+	// MaxCount Maximum number of copies on the shelf (inclusive)
+	MaxCount *int32 `form:"max_count,omitempty" json:"max_count,omitempty"`
+
+	// AddedFrom Only books added on or after this day (YYYY-MM-DD)
+	AddedFrom *openapi_types.Date `form:"added_from,omitempty" json:"added_from,omitempty"`
+
+	// AddedTo Only books added on or before this day (YYYY-MM-DD)
+	AddedTo *openapi_types.Date `form:"added_to,omitempty" json:"added_to,omitempty"`
+
+	// CategoryId Only books filed under this category or any of its subcategories
+	CategoryId *openapi_types.UUID `form:"category_id,omitempty" json:"category_id,omitempty"`
+
+	// Tag Only books with the tag; repeat to require several tags
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Limit Maximum number of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip before returning the results.
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort Sort field; prefix with "-" for descending order.
+	Sort *ListOrSearchBooksParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor taken from `next_cursor` or `prev_cursor` of a previous response.
+	// When present, `offset` is ignored and the page continues from the cursor using
+	// the same `sort`.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListOrSearchBooksParamsAvailability defines parameters for ListOrSearchBooks.
+type ListOrSearchBooksParamsAvailability string
+
+// ListOrSearchBooksParamsSort defines parameters for ListOrSearchBooks.
+type ListOrSearchBooksParamsSort string
+
+// GetBookBarcodeParams defines parameters for GetBookBarcode.
+type GetBookBarcodeParams struct {
+	// Type The symbology of the barcode
+	Type *GetBookBarcodeParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Value What the barcode encodes
+	Value *GetBookBarcodeParamsValue `form:"value,omitempty" json:"value,omitempty"`
+
+	// Scale Width of a module in pixels
+	Scale *int `form:"scale,omitempty" json:"scale,omitempty"`
+}
+
+// GetBookBarcodeParamsType defines parameters for GetBookBarcode.
+type GetBookBarcodeParamsType string
+
+// GetBookBarcodeParamsValue defines parameters for GetBookBarcode.
+type GetBookBarcodeParamsValue string
+
+// ListKioskTransactionsParams defines parameters for ListKioskTransactions.
+type ListKioskTransactionsParams struct {
 	// Limit Maximum number of items to return.
 	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
 
 	// Offset Number of items to skip before returning the results.
 	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque cursor taken from `next_cursor` or `prev_cursor` of a previous response.
+	// When present, `offset` is ignored and the page continues from the cursor using
+	// the same `sort`.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// OIDCCallbackParams defines parameters for OIDCCallback.
+type OIDCCallbackParams struct {
+	Code             *string `form:"code,omitempty" json:"code,omitempty"`
+	State            *string `form:"state,omitempty" json:"state,omitempty"`
+	Error            *string `form:"error,omitempty" json:"error,omitempty"`
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty"`
 }
 
 // ListOverdueRentalsParams defines parameters for ListOverdueRentals.
@@ -143,8 +1269,39 @@ type ListOverdueRentalsParams struct {
 
 	// Offset Number of items to skip before returning the results.
 	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort Sort field (student name, number of books or rent date); prefix with "-" for descending order. Defaults to the longest overdue first.
+	Sort *ListOverdueRentalsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor taken from `next_cursor` or `prev_cursor` of a previous response.
+	// When present, `offset` is ignored and the page continues from the cursor using
+	// the same `sort`.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListOverdueRentalsParamsSort defines parameters for ListOverdueRentals.
+type ListOverdueRentalsParamsSort string
+
+// GetPortalHistoryParams defines parameters for GetPortalHistory.
+type GetPortalHistoryParams struct {
+	// Limit Maximum number of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip before returning the results.
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort Sort field (book title or rent date); prefix with "-" for descending order.
+	Sort *GetPortalHistoryParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor taken from `next_cursor` or `prev_cursor` of a previous response.
+	// When present, `offset` is ignored and the page continues from the cursor using
+	// the same `sort`.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// GetPortalHistoryParamsSort defines parameters for GetPortalHistory.
+type GetPortalHistoryParamsSort string
+
 // ListRentsParams defines parameters for ListRents.
 type ListRentsParams struct {
 	// BookName Filter by book title (partial match)
@@ -161,8 +1318,27 @@ type ListRentsParams struct {
 
 	// Offset Number of items to skip before returning the results.
 	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort Sort field (book title, student name or rent date); prefix with "-" for descending order.
+	Sort *ListRentsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor taken from `next_cursor` or `prev_cursor` of a previous response.
+	// When present, `offset` is ignored and the page continues from the cursor using
+	// the same `sort`.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListRentsParamsSort defines parameters for ListRents.
+type ListRentsParamsSort string
+
+// GetRentReceiptParams defines parameters for GetRentReceipt.
+type GetRentReceiptParams struct {
+	Format *GetRentReceiptParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
+// GetRentReceiptParamsFormat defines parameters for GetRentReceipt.
+type GetRentReceiptParamsFormat string
+
 // GetRentalReportsParams defines parameters for GetRentalReports.
 type GetRentalReportsParams struct {
 	// Limit Maximum number of items to return.
@@ -172,6 +1348,75 @@ type GetRentalReportsParams struct {
 	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// GetCirculationAnalyticsParams defines parameters for GetCirculationAnalytics.
+type GetCirculationAnalyticsParams struct {
+	// From First day of the range, moved back to the start of its bucket. Defaults to 30 days, 12 weeks or 12 months before `to`
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day of the range. Defaults to today
+	To       *openapi_types.Date                    `form:"to,omitempty" json:"to,omitempty"`
+	Interval *GetCirculationAnalyticsParamsInterval `form:"interval,omitempty" json:"interval,omitempty"`
+	GroupBy  *GetCirculationAnalyticsParamsGroupBy  `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// Limit Maximum number of groups to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetCirculationAnalyticsParamsInterval defines parameters for GetCirculationAnalytics.
+type GetCirculationAnalyticsParamsInterval string
+
+// GetCirculationAnalyticsParamsGroupBy defines parameters for GetCirculationAnalytics.
+type GetCirculationAnalyticsParamsGroupBy string
+
+// GetCollectionReportParams defines parameters for GetCollectionReport.
+type GetCollectionReportParams struct {
+	// From First day of the period. Defaults to 89 days before `to`
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day of the period. Defaults to today
+	To                  *openapi_types.Date              `form:"to,omitempty" json:"to,omitempty"`
+	ZeroCirculation     *bool                            `form:"zero_circulation,omitempty" json:"zero_circulation,omitempty"`
+	DemandExceedsSupply *bool                            `form:"demand_exceeds_supply,omitempty" json:"demand_exceeds_supply,omitempty"`
+	Format              *GetCollectionReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Limit Maximum number of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip before returning the results.
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort Sort field; prefix with "-" for descending order.
+	Sort *GetCollectionReportParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor taken from `next_cursor` or `prev_cursor` of a previous response.
+	// When present, `offset` is ignored and the page continues from the cursor using
+	// the same `sort`.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetCollectionReportParamsFormat defines parameters for GetCollectionReport.
+type GetCollectionReportParamsFormat string
+
+// GetCollectionReportParamsSort defines parameters for GetCollectionReport.
+type GetCollectionReportParamsSort string
+
+// DownloadReportFileParamsFormat defines parameters for DownloadReportFile.
+type DownloadReportFileParamsFormat string
+
+// ListReportRunsParams defines parameters for ListReportRuns.
+type ListReportRunsParams struct {
+	// Limit Maximum number of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip before returning the results.
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque cursor taken from `next_cursor` or `prev_cursor` of a previous response.
+	// When present, `offset` is ignored and the page continues from the cursor using
+	// the same `sort`.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
 // GetRentedBooksByStudentParams defines parameters for GetRentedBooksByStudent.
 type GetRentedBooksByStudentParams struct {
 	// StudentCardId Student card id
@@ -180,9 +1425,44 @@ type GetRentedBooksByStudentParams struct {
 
 // ReturnBooksJSONBody defines parameters for ReturnBooks.
 type ReturnBooksJSONBody struct {
-	CartId openapi_types.UUID `json:"cart_id"`
+	// CardId Card id of the student returning the books, used when cart_id is not given
+	CardId *string             `json:"card_id,omitempty"`
+	CartId *openapi_types.UUID `json:"cart_id,omitempty"`
+	Items  *[]ReturnItem       `json:"items,omitempty"`
+}
+
+// ListReturnHistoryParams defines parameters for ListReturnHistory.
+type ListReturnHistoryParams struct {
+	// Limit Maximum number of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip before returning the results.
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort Sort field (return time, book title or student name); prefix with "-" for descending order.
+	Sort *ListReturnHistoryParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor taken from `next_cursor` or `prev_cursor` of a previous response.
+	// When present, `offset` is ignored and the page continues from the cursor using
+	// the same `sort`.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// From Only returns on or after this day
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only returns on or before this day
+	To        *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+	StudentId *openapi_types.UUID `form:"student_id,omitempty" json:"student_id,omitempty"`
+	BookId    *openapi_types.UUID `form:"book_id,omitempty" json:"book_id,omitempty"`
+
+	// LibrarianId Only returns processed by this librarian
+	LibrarianId *openapi_types.UUID `form:"librarian_id,omitempty" json:"librarian_id,omitempty"`
+	Condition   *ReturnCondition    `form:"condition,omitempty" json:"condition,omitempty"`
 }
 
+// ListReturnHistoryParamsSort defines parameters for ListReturnHistory.
+type ListReturnHistoryParamsSort string
+
 // ListAllStudentsParams defines parameters for ListAllStudents.
 type ListAllStudentsParams struct {
 	// Limit Maximum number of items to return.
@@ -191,42 +1471,205 @@ type ListAllStudentsParams struct {
 	// Offset Number of items to skip before returning the results.
 	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
 
-	// CardId Student card id
+	// Sort Sort field; prefix with "-" for descending order.
+	Sort *ListAllStudentsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor taken from `next_cursor` or `prev_cursor` of a previous response.
+	// When present, `offset` is ignored and the page continues from the cursor using
+	// the same `sort`.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// CardId Student card id (exact match, returns a single student)
 	CardId *string `form:"card_id,omitempty" json:"card_id,omitempty"`
+
+	// Query Case- and accent-insensitive search. A phone number matches the start or end of phone numbers and card id prefixes, a single word matches first name, last name or card id prefixes, and several words must each match a first or last name prefix.
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// Major Only students in this major (case- and accent-insensitive exact match)
+	Major *string `form:"major,omitempty" json:"major,omitempty"`
+
+	// HasActiveRentals Only students with (`true`) or without (`false`) books currently rented
+	HasActiveRentals *bool `form:"has_active_rentals,omitempty" json:"has_active_rentals,omitempty"`
+
+	// HasOverdue Only students with (`true`) or without (`false`) overdue rentals
+	HasOverdue *bool `form:"has_overdue,omitempty" json:"has_overdue,omitempty"`
+}
+
+// ListAllStudentsParamsSort defines parameters for ListAllStudents.
+type ListAllStudentsParamsSort string
+
+// GetStudentBarcodeParams defines parameters for GetStudentBarcode.
+type GetStudentBarcodeParams struct {
+	// Type The symbology of the barcode
+	Type *GetStudentBarcodeParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Scale Width of a module in pixels
+	Scale *int `form:"scale,omitempty" json:"scale,omitempty"`
+}
+
+// GetStudentBarcodeParamsType defines parameters for GetStudentBarcode.
+type GetStudentBarcodeParamsType string
+
+// GetStudentHistoryParams defines parameters for GetStudentHistory.
+type GetStudentHistoryParams struct {
+	// Limit Maximum number of items to return.
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip before returning the results.
+	Offset *OffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort Sort field (book title or rent date); prefix with "-" for descending order.
+	Sort *GetStudentHistoryParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor taken from `next_cursor` or `prev_cursor` of a previous response.
+	// When present, `offset` is ignored and the page continues from the cursor using
+	// the same `sort`.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// GetStudentHistoryParamsSort defines parameters for GetStudentHistory.
+type GetStudentHistoryParamsSort string
+
 // AddBookJSONRequestBody defines body for AddBook for application/json ContentType.
 type AddBookJSONRequestBody = Books
 
+// PrintBookLabelsJSONRequestBody defines body for PrintBookLabels for application/json ContentType.
+type PrintBookLabelsJSONRequestBody = BookLabelRequest
+
+// ClassifyBookJSONRequestBody defines body for ClassifyBook for application/json ContentType.
+type ClassifyBookJSONRequestBody = BookClassification
+
+// CreateCategoryJSONRequestBody defines body for CreateCategory for application/json ContentType.
+type CreateCategoryJSONRequestBody = CategoryRequest
+
+// UpdateCategoryJSONRequestBody defines body for UpdateCategory for application/json ContentType.
+type UpdateCategoryJSONRequestBody = CategoryRequest
+
+// KioskCheckoutJSONRequestBody defines body for KioskCheckout for application/json ContentType.
+type KioskCheckoutJSONRequestBody = KioskRequest
+
+// KioskIdentifyJSONRequestBody defines body for KioskIdentify for application/json ContentType.
+type KioskIdentifyJSONRequestBody = KioskIdentifyRequest
+
+// KioskReturnJSONRequestBody defines body for KioskReturn for application/json ContentType.
+type KioskReturnJSONRequestBody = KioskRequest
+
+// RegisterKioskJSONRequestBody defines body for RegisterKiosk for application/json ContentType.
+type RegisterKioskJSONRequestBody = KioskRegistrationRequest
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequest
 
+// PortalLoginJSONRequestBody defines body for PortalLogin for application/json ContentType.
+type PortalLoginJSONRequestBody = PortalLoginRequest
+
+// SendPortalLoginLinkJSONRequestBody defines body for SendPortalLoginLink for application/json ContentType.
+type SendPortalLoginLinkJSONRequestBody = PortalLinkRequest
+
+// PortalLoginWithTokenJSONRequestBody defines body for PortalLoginWithToken for application/json ContentType.
+type PortalLoginWithTokenJSONRequestBody = PortalTokenRequest
+
+// UpdatePortalContactJSONRequestBody defines body for UpdatePortalContact for application/json ContentType.
+type UpdatePortalContactJSONRequestBody = StudentContactRequest
+
 // CreateRentTransactionJSONRequestBody defines body for CreateRentTransaction for application/json ContentType.
 type CreateRentTransactionJSONRequestBody = RentRequest
 
+// CreateReportScheduleJSONRequestBody defines body for CreateReportSchedule for application/json ContentType.
+type CreateReportScheduleJSONRequestBody = ReportScheduleRequest
+
+// UpdateReportScheduleJSONRequestBody defines body for UpdateReportSchedule for application/json ContentType.
+type UpdateReportScheduleJSONRequestBody = ReportScheduleRequest
+
 // ReturnBooksJSONRequestBody defines body for ReturnBooks for application/json ContentType.
 type ReturnBooksJSONRequestBody ReturnBooksJSONBody
 
 // AddStudentJSONRequestBody defines body for AddStudent for application/json ContentType.
 type AddStudentJSONRequestBody = Students
 
+// PrintStudentLabelsJSONRequestBody defines body for PrintStudentLabels for application/json ContentType.
+type PrintStudentLabelsJSONRequestBody = StudentLabelRequest
+
+// SetStudentPinJSONRequestBody defines body for SetStudentPin for application/json ContentType.
+type SetStudentPinJSONRequestBody = StudentPinRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// List or search books (order by newly created books)
+	// List or search books (newest first unless sorted)
 	// (GET /books)
 	ListOrSearchBooks(w http.ResponseWriter, r *http.Request, params ListOrSearchBooksParams)
 	// Add a new book
 	// (POST /books)
 	AddBook(w http.ResponseWriter, r *http.Request)
+	// Print book labels
+	// (POST /books/labels)
+	PrintBookLabels(w http.ResponseWriter, r *http.Request)
+	// List book tags
+	// (GET /books/tags)
+	ListBookTags(w http.ResponseWriter, r *http.Request)
 	// Delete a Book by ID
 	// (DELETE /books/{id})
 	DeleteBookById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get the barcode of a book
+	// (GET /books/{id}/barcode)
+	GetBookBarcode(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBookBarcodeParams)
+	// Classify a book
+	// (PUT /books/{id}/classification)
+	ClassifyBook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Browse categories
+	// (GET /categories)
+	ListCategories(w http.ResponseWriter, r *http.Request)
+	// Add a category
+	// (POST /categories)
+	CreateCategory(w http.ResponseWriter, r *http.Request)
+	// Delete a category
+	// (DELETE /categories/{id})
+	DeleteCategory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Browse a category
+	// (GET /categories/{id})
+	GetCategory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Rename or move a category
+	// (PUT /categories/{id})
+	UpdateCategory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get CSRF token
+	// (GET /csrf)
+	GetCSRFToken(w http.ResponseWriter, r *http.Request)
+	// Liveness probe
+	// (GET /healthz)
+	GetHealthz(w http.ResponseWriter, r *http.Request)
+	// Check out books at a kiosk
+	// (POST /kiosk/checkout)
+	KioskCheckout(w http.ResponseWriter, r *http.Request)
+	// Identify a student at a kiosk
+	// (POST /kiosk/identify)
+	KioskIdentify(w http.ResponseWriter, r *http.Request)
+	// Return books at a kiosk
+	// (POST /kiosk/return)
+	KioskReturn(w http.ResponseWriter, r *http.Request)
+	// List kiosks
+	// (GET /kiosks)
+	ListKiosks(w http.ResponseWriter, r *http.Request)
+	// Register a kiosk
+	// (POST /kiosks)
+	RegisterKiosk(w http.ResponseWriter, r *http.Request)
+	// Revoke a kiosk
+	// (DELETE /kiosks/{id})
+	RevokeKiosk(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List the transactions of a kiosk
+	// (GET /kiosks/{id}/transactions)
+	ListKioskTransactions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListKioskTransactionsParams)
 	// Librarian profile
 	// (GET /librarian)
 	Librarian(w http.ResponseWriter, r *http.Request)
 	// Librarian login
 	// (POST /login)
 	Login(w http.ResponseWriter, r *http.Request)
+	// Librarian single sign-on
+	// (GET /login/oidc)
+	OIDCLogin(w http.ResponseWriter, r *http.Request)
+	// Librarian single sign-on callback
+	// (GET /login/oidc/callback)
+	OIDCCallback(w http.ResponseWriter, r *http.Request, params OIDCCallbackParams)
 	// Logout librarian
 	// (POST /logout)
 	Logout(w http.ResponseWriter, r *http.Request)
@@ -236,40 +1679,121 @@ type ServerInterface interface {
 	// Get overdue rentals
 	// (GET /overdues)
 	ListOverdueRentals(w http.ResponseWriter, r *http.Request, params ListOverdueRentalsParams)
+	// Get rental history
+	// (GET /portal/history)
+	GetPortalHistory(w http.ResponseWriter, r *http.Request, params GetPortalHistoryParams)
+	// List current loans
+	// (GET /portal/loans)
+	ListPortalLoans(w http.ResponseWriter, r *http.Request)
+	// Student login
+	// (POST /portal/login)
+	PortalLogin(w http.ResponseWriter, r *http.Request)
+	// Email a sign-in link
+	// (POST /portal/login/link)
+	SendPortalLoginLink(w http.ResponseWriter, r *http.Request)
+	// Student login with a sign-in link
+	// (POST /portal/login/token)
+	PortalLoginWithToken(w http.ResponseWriter, r *http.Request)
+	// Student logout
+	// (POST /portal/logout)
+	PortalLogout(w http.ResponseWriter, r *http.Request)
+	// Get the signed-in student
+	// (GET /portal/me)
+	GetPortalStudent(w http.ResponseWriter, r *http.Request)
+	// Update contact details
+	// (PUT /portal/me/contact)
+	UpdatePortalContact(w http.ResponseWriter, r *http.Request)
+	// Readiness probe
+	// (GET /readyz)
+	GetReadyz(w http.ResponseWriter, r *http.Request)
 	// Get list of all rents with optional filters
 	// (GET /rents)
 	ListRents(w http.ResponseWriter, r *http.Request, params ListRentsParams)
 	// Create rental transaction
 	// (POST /rents)
 	CreateRentTransaction(w http.ResponseWriter, r *http.Request)
+	// Print a receipt
+	// (GET /rents/{cart_id}/receipt)
+	GetRentReceipt(w http.ResponseWriter, r *http.Request, cartId openapi_types.UUID, params GetRentReceiptParams)
 	// Get rental report
 	// (GET /reports)
 	GetRentalReports(w http.ResponseWriter, r *http.Request, params GetRentalReportsParams)
+	// Get circulation analytics
+	// (GET /reports/analytics)
+	GetCirculationAnalytics(w http.ResponseWriter, r *http.Request, params GetCirculationAnalyticsParams)
+	// Get collection report
+	// (GET /reports/collection)
+	GetCollectionReport(w http.ResponseWriter, r *http.Request, params GetCollectionReportParams)
+	// Download a report file
+	// (GET /reports/runs/{id}/files/{format})
+	DownloadReportFile(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, format DownloadReportFileParamsFormat)
+	// List saved reports
+	// (GET /reports/schedules)
+	ListReportSchedules(w http.ResponseWriter, r *http.Request)
+	// Save a report
+	// (POST /reports/schedules)
+	CreateReportSchedule(w http.ResponseWriter, r *http.Request)
+	// Delete a saved report
+	// (DELETE /reports/schedules/{id})
+	DeleteReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a saved report
+	// (GET /reports/schedules/{id})
+	GetReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Replace a saved report
+	// (PUT /reports/schedules/{id})
+	UpdateReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Run a saved report now
+	// (POST /reports/schedules/{id}/run)
+	RunReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List the runs of a saved report
+	// (GET /reports/schedules/{id}/runs)
+	ListReportRuns(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListReportRunsParams)
 	// List books currently rented by a student
 	// (GET /returns)
 	GetRentedBooksByStudent(w http.ResponseWriter, r *http.Request, params GetRentedBooksByStudentParams)
 	// Mark a cart as returned
 	// (PUT /returns)
 	ReturnBooks(w http.ResponseWriter, r *http.Request)
+	// List completed returns
+	// (GET /returns/history)
+	ListReturnHistory(w http.ResponseWriter, r *http.Request, params ListReturnHistoryParams)
 	// List all students
 	// (GET /students)
 	ListAllStudents(w http.ResponseWriter, r *http.Request, params ListAllStudentsParams)
 	// Register a new student
 	// (POST /students)
 	AddStudent(w http.ResponseWriter, r *http.Request)
+	// Print student card labels
+	// (POST /students/labels)
+	PrintStudentLabels(w http.ResponseWriter, r *http.Request)
 	// Delete a Student by ID
 	// (DELETE /students/{id})
 	DeleteStudentById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Get a Student by ID
 	// (GET /students/{id})
 	GetStudentById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get the barcode of a student card
+	// (GET /students/{id}/barcode)
+	GetStudentBarcode(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetStudentBarcodeParams)
+	// Get a student's borrowing history
+	// (GET /students/{id}/history)
+	GetStudentHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetStudentHistoryParams)
+	// Set a student's portal PIN
+	// (PUT /students/{id}/pin)
+	SetStudentPin(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a student's profile summary
+	// (GET /students/{id}/summary)
+	GetStudentSummary(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get build information
+	// (GET /version)
+	GetVersion(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
-// List or search books (order by newly created books)
+// List or search books (newest first unless sorted)
 // (GET /books)
 func (_ Unimplemented) ListOrSearchBooks(w http.ResponseWriter, r *http.Request, params ListOrSearchBooksParams) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -281,99 +1805,381 @@ func (_ Unimplemented) AddBook(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Print book labels
+// (POST /books/labels)
+func (_ Unimplemented) PrintBookLabels(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List book tags
+// (GET /books/tags)
+func (_ Unimplemented) ListBookTags(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete a Book by ID
 // (DELETE /books/{id})
 func (_ Unimplemented) DeleteBookById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Librarian profile
-// (GET /librarian)
-func (_ Unimplemented) Librarian(w http.ResponseWriter, r *http.Request) {
+// Get the barcode of a book
+// (GET /books/{id}/barcode)
+func (_ Unimplemented) GetBookBarcode(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBookBarcodeParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Librarian login
-// (POST /login)
-func (_ Unimplemented) Login(w http.ResponseWriter, r *http.Request) {
+// Classify a book
+// (PUT /books/{id}/classification)
+func (_ Unimplemented) ClassifyBook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Logout librarian
-// (POST /logout)
-func (_ Unimplemented) Logout(w http.ResponseWriter, r *http.Request) {
+// Browse categories
+// (GET /categories)
+func (_ Unimplemented) ListCategories(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get this OpenAPI spec
-// (GET /openapi.json)
-func (_ Unimplemented) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+// Add a category
+// (POST /categories)
+func (_ Unimplemented) CreateCategory(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get overdue rentals
-// (GET /overdues)
-func (_ Unimplemented) ListOverdueRentals(w http.ResponseWriter, r *http.Request, params ListOverdueRentalsParams) {
+// Delete a category
+// (DELETE /categories/{id})
+func (_ Unimplemented) DeleteCategory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get list of all rents with optional filters
-// (GET /rents)
-func (_ Unimplemented) ListRents(w http.ResponseWriter, r *http.Request, params ListRentsParams) {
+// Browse a category
+// (GET /categories/{id})
+func (_ Unimplemented) GetCategory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create rental transaction
-// (POST /rents)
-func (_ Unimplemented) CreateRentTransaction(w http.ResponseWriter, r *http.Request) {
+// Rename or move a category
+// (PUT /categories/{id})
+func (_ Unimplemented) UpdateCategory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get rental report
-// (GET /reports)
-func (_ Unimplemented) GetRentalReports(w http.ResponseWriter, r *http.Request, params GetRentalReportsParams) {
+// Get CSRF token
+// (GET /csrf)
+func (_ Unimplemented) GetCSRFToken(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List books currently rented by a student
-// (GET /returns)
-func (_ Unimplemented) GetRentedBooksByStudent(w http.ResponseWriter, r *http.Request, params GetRentedBooksByStudentParams) {
+// Liveness probe
+// (GET /healthz)
+func (_ Unimplemented) GetHealthz(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Mark a cart as returned
-// (PUT /returns)
-func (_ Unimplemented) ReturnBooks(w http.ResponseWriter, r *http.Request) {
+// Check out books at a kiosk
+// (POST /kiosk/checkout)
+func (_ Unimplemented) KioskCheckout(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all students
-// (GET /students)
-func (_ Unimplemented) ListAllStudents(w http.ResponseWriter, r *http.Request, params ListAllStudentsParams) {
+// Identify a student at a kiosk
+// (POST /kiosk/identify)
+func (_ Unimplemented) KioskIdentify(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Register a new student
-// (POST /students)
-func (_ Unimplemented) AddStudent(w http.ResponseWriter, r *http.Request) {
+// Return books at a kiosk
+// (POST /kiosk/return)
+func (_ Unimplemented) KioskReturn(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete a Student by ID
-// (DELETE /students/{id})
-func (_ Unimplemented) DeleteStudentById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// List kiosks
+// (GET /kiosks)
+func (_ Unimplemented) ListKiosks(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get a Student by ID
-// (GET /students/{id})
-func (_ Unimplemented) GetStudentById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Register a kiosk
+// (POST /kiosks)
+func (_ Unimplemented) RegisterKiosk(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
+// Revoke a kiosk
+// (DELETE /kiosks/{id})
+func (_ Unimplemented) RevokeKiosk(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the transactions of a kiosk
+// (GET /kiosks/{id}/transactions)
+func (_ Unimplemented) ListKioskTransactions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListKioskTransactionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Librarian profile
+// (GET /librarian)
+func (_ Unimplemented) Librarian(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Librarian login
+// (POST /login)
+func (_ Unimplemented) Login(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Librarian single sign-on
+// (GET /login/oidc)
+func (_ Unimplemented) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Librarian single sign-on callback
+// (GET /login/oidc/callback)
+func (_ Unimplemented) OIDCCallback(w http.ResponseWriter, r *http.Request, params OIDCCallbackParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Logout librarian
+// (POST /logout)
+func (_ Unimplemented) Logout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get this OpenAPI spec
+// (GET /openapi.json)
+func (_ Unimplemented) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get overdue rentals
+// (GET /overdues)
+func (_ Unimplemented) ListOverdueRentals(w http.ResponseWriter, r *http.Request, params ListOverdueRentalsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get rental history
+// (GET /portal/history)
+func (_ Unimplemented) GetPortalHistory(w http.ResponseWriter, r *http.Request, params GetPortalHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List current loans
+// (GET /portal/loans)
+func (_ Unimplemented) ListPortalLoans(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Student login
+// (POST /portal/login)
+func (_ Unimplemented) PortalLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Email a sign-in link
+// (POST /portal/login/link)
+func (_ Unimplemented) SendPortalLoginLink(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Student login with a sign-in link
+// (POST /portal/login/token)
+func (_ Unimplemented) PortalLoginWithToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Student logout
+// (POST /portal/logout)
+func (_ Unimplemented) PortalLogout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the signed-in student
+// (GET /portal/me)
+func (_ Unimplemented) GetPortalStudent(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update contact details
+// (PUT /portal/me/contact)
+func (_ Unimplemented) UpdatePortalContact(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Readiness probe
+// (GET /readyz)
+func (_ Unimplemented) GetReadyz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get list of all rents with optional filters
+// (GET /rents)
+func (_ Unimplemented) ListRents(w http.ResponseWriter, r *http.Request, params ListRentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create rental transaction
+// (POST /rents)
+func (_ Unimplemented) CreateRentTransaction(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Print a receipt
+// (GET /rents/{cart_id}/receipt)
+func (_ Unimplemented) GetRentReceipt(w http.ResponseWriter, r *http.Request, cartId openapi_types.UUID, params GetRentReceiptParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get rental report
+// (GET /reports)
+func (_ Unimplemented) GetRentalReports(w http.ResponseWriter, r *http.Request, params GetRentalReportsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get circulation analytics
+// (GET /reports/analytics)
+func (_ Unimplemented) GetCirculationAnalytics(w http.ResponseWriter, r *http.Request, params GetCirculationAnalyticsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get collection report
+// (GET /reports/collection)
+func (_ Unimplemented) GetCollectionReport(w http.ResponseWriter, r *http.Request, params GetCollectionReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a report file
+// (GET /reports/runs/{id}/files/{format})
+func (_ Unimplemented) DownloadReportFile(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, format DownloadReportFileParamsFormat) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List saved reports
+// (GET /reports/schedules)
+func (_ Unimplemented) ListReportSchedules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Save a report
+// (POST /reports/schedules)
+func (_ Unimplemented) CreateReportSchedule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a saved report
+// (DELETE /reports/schedules/{id})
+func (_ Unimplemented) DeleteReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a saved report
+// (GET /reports/schedules/{id})
+func (_ Unimplemented) GetReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace a saved report
+// (PUT /reports/schedules/{id})
+func (_ Unimplemented) UpdateReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Run a saved report now
+// (POST /reports/schedules/{id}/run)
+func (_ Unimplemented) RunReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the runs of a saved report
+// (GET /reports/schedules/{id}/runs)
+func (_ Unimplemented) ListReportRuns(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListReportRunsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List books currently rented by a student
+// (GET /returns)
+func (_ Unimplemented) GetRentedBooksByStudent(w http.ResponseWriter, r *http.Request, params GetRentedBooksByStudentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark a cart as returned
+// (PUT /returns)
+func (_ Unimplemented) ReturnBooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List completed returns
+// (GET /returns/history)
+func (_ Unimplemented) ListReturnHistory(w http.ResponseWriter, r *http.Request, params ListReturnHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all students
+// (GET /students)
+func (_ Unimplemented) ListAllStudents(w http.ResponseWriter, r *http.Request, params ListAllStudentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a new student
+// (POST /students)
+func (_ Unimplemented) AddStudent(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Print student card labels
+// (POST /students/labels)
+func (_ Unimplemented) PrintStudentLabels(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a Student by ID
+// (DELETE /students/{id})
+func (_ Unimplemented) DeleteStudentById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a Student by ID
+// (GET /students/{id})
+func (_ Unimplemented) GetStudentById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the barcode of a student card
+// (GET /students/{id}/barcode)
+func (_ Unimplemented) GetStudentBarcode(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetStudentBarcodeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a student's borrowing history
+// (GET /students/{id}/history)
+func (_ Unimplemented) GetStudentHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetStudentHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set a student's portal PIN
+// (PUT /students/{id}/pin)
+func (_ Unimplemented) SetStudentPin(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a student's profile summary
+// (GET /students/{id}/summary)
+func (_ Unimplemented) GetStudentSummary(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get build information
+// (GET /version)
+func (_ Unimplemented) GetVersion(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
 	HandlerMiddlewares []MiddlewareFunc
 	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
 }
@@ -402,6 +2208,62 @@ func (siw *ServerInterfaceWrapper) ListOrSearchBooks(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Optional query parameter "availability" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "availability", r.URL.Query(), &params.Availability)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "availability", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_count", r.URL.Query(), &params.MinCount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_count", r.URL.Query(), &params.MaxCount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "added_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "added_from", r.URL.Query(), &params.AddedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "added_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "added_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "added_to", r.URL.Query(), &params.AddedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "added_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_id", r.URL.Query(), &params.CategoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
@@ -418,6 +2280,22 @@ func (siw *ServerInterfaceWrapper) ListOrSearchBooks(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListOrSearchBooks(w, r, params)
 	}))
@@ -449,19 +2327,8 @@ func (siw *ServerInterfaceWrapper) AddBook(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteBookById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteBookById(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// PrintBookLabels operation middleware
+func (siw *ServerInterfaceWrapper) PrintBookLabels(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -470,7 +2337,7 @@ func (siw *ServerInterfaceWrapper) DeleteBookById(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteBookById(w, r, id)
+		siw.Handler.PrintBookLabels(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -480,8 +2347,8 @@ func (siw *ServerInterfaceWrapper) DeleteBookById(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// Librarian operation middleware
-func (siw *ServerInterfaceWrapper) Librarian(w http.ResponseWriter, r *http.Request) {
+// ListBookTags operation middleware
+func (siw *ServerInterfaceWrapper) ListBookTags(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -490,7 +2357,7 @@ func (siw *ServerInterfaceWrapper) Librarian(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Librarian(w, r)
+		siw.Handler.ListBookTags(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -500,39 +2367,28 @@ func (siw *ServerInterfaceWrapper) Librarian(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+// DeleteBookById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBookById(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Login(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	handler.ServeHTTP(w, r)
-}
-
-// Logout operation middleware
-func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Logout(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	ctx := r.Context()
 
-// GetOpenAPISpec operation middleware
-func (siw *ServerInterfaceWrapper) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetOpenAPISpec(w, r)
+		siw.Handler.DeleteBookById(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -542,11 +2398,20 @@ func (siw *ServerInterfaceWrapper) GetOpenAPISpec(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// ListOverdueRentals operation middleware
-func (siw *ServerInterfaceWrapper) ListOverdueRentals(w http.ResponseWriter, r *http.Request) {
+// GetBookBarcode operation middleware
+func (siw *ServerInterfaceWrapper) GetBookBarcode(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
@@ -554,34 +2419,34 @@ func (siw *ServerInterfaceWrapper) ListOverdueRentals(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListOverdueRentalsParams
+	var params GetBookBarcodeParams
 
-	// ------------- Optional query parameter "student_card_id" -------------
+	// ------------- Optional query parameter "type" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "student_card_id", r.URL.Query(), &params.StudentCardId)
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "student_card_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "value" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "value", r.URL.Query(), &params.Value)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "value", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "scale" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "scale", r.URL.Query(), &params.Scale)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scale", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListOverdueRentals(w, r, params)
+		siw.Handler.GetBookBarcode(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -591,62 +2456,48 @@ func (siw *ServerInterfaceWrapper) ListOverdueRentals(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// ListRents operation middleware
-func (siw *ServerInterfaceWrapper) ListRents(w http.ResponseWriter, r *http.Request) {
+// ClassifyBook operation middleware
+func (siw *ServerInterfaceWrapper) ClassifyBook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRentsParams
-
-	// ------------- Optional query parameter "book_name" -------------
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "book_name", r.URL.Query(), &params.BookName)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "book_name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "student_name" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "student_name", r.URL.Query(), &params.StudentName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "student_name", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "date" -------------
+	r = r.WithContext(ctx)
 
-	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
-		return
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClassifyBook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+// ListCategories operation middleware
+func (siw *ServerInterfaceWrapper) ListCategories(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "offset" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRents(w, r, params)
+		siw.Handler.ListCategories(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -656,8 +2507,8 @@ func (siw *ServerInterfaceWrapper) ListRents(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateRentTransaction operation middleware
-func (siw *ServerInterfaceWrapper) CreateRentTransaction(w http.ResponseWriter, r *http.Request) {
+// CreateCategory operation middleware
+func (siw *ServerInterfaceWrapper) CreateCategory(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -666,7 +2517,7 @@ func (siw *ServerInterfaceWrapper) CreateRentTransaction(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRentTransaction(w, r)
+		siw.Handler.CreateCategory(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -676,38 +2527,59 @@ func (siw *ServerInterfaceWrapper) CreateRentTransaction(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetRentalReports operation middleware
-func (siw *ServerInterfaceWrapper) GetRentalReports(w http.ResponseWriter, r *http.Request) {
+// DeleteCategory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCategory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetRentalReportsParams
-
-	// ------------- Optional query parameter "limit" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCategory(w, r, id)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+// GetCategory operation middleware
+func (siw *ServerInterfaceWrapper) GetCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRentalReports(w, r, params)
+		siw.Handler.GetCategory(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -717,30 +2589,56 @@ func (siw *ServerInterfaceWrapper) GetRentalReports(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetRentedBooksByStudent operation middleware
-func (siw *ServerInterfaceWrapper) GetRentedBooksByStudent(w http.ResponseWriter, r *http.Request) {
+// UpdateCategory operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetRentedBooksByStudentParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCategory(w, r, id)
+	}))
 
-	// ------------- Optional query parameter "student_card_id" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "student_card_id", r.URL.Query(), &params.StudentCardId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "student_card_id", Err: err})
-		return
+	handler.ServeHTTP(w, r)
+}
+
+// GetCSRFToken operation middleware
+func (siw *ServerInterfaceWrapper) GetCSRFToken(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCSRFToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealthz operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthz(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRentedBooksByStudent(w, r, params)
+		siw.Handler.GetHealthz(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -750,17 +2648,17 @@ func (siw *ServerInterfaceWrapper) GetRentedBooksByStudent(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// ReturnBooks operation middleware
-func (siw *ServerInterfaceWrapper) ReturnBooks(w http.ResponseWriter, r *http.Request) {
+// KioskCheckout operation middleware
+func (siw *ServerInterfaceWrapper) KioskCheckout(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+	ctx = context.WithValue(ctx, KioskAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReturnBooks(w, r)
+		siw.Handler.KioskCheckout(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -770,46 +2668,57 @@ func (siw *ServerInterfaceWrapper) ReturnBooks(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ListAllStudents operation middleware
-func (siw *ServerInterfaceWrapper) ListAllStudents(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// KioskIdentify operation middleware
+func (siw *ServerInterfaceWrapper) KioskIdentify(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+	ctx = context.WithValue(ctx, KioskAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListAllStudentsParams
-
-	// ------------- Optional query parameter "limit" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.KioskIdentify(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+// KioskReturn operation middleware
+func (siw *ServerInterfaceWrapper) KioskReturn(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "card_id" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "card_id", r.URL.Query(), &params.CardId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "card_id", Err: err})
-		return
+	ctx = context.WithValue(ctx, KioskAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.KioskReturn(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// ListKiosks operation middleware
+func (siw *ServerInterfaceWrapper) ListKiosks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListAllStudents(w, r, params)
+		siw.Handler.ListKiosks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -819,8 +2728,8 @@ func (siw *ServerInterfaceWrapper) ListAllStudents(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// AddStudent operation middleware
-func (siw *ServerInterfaceWrapper) AddStudent(w http.ResponseWriter, r *http.Request) {
+// RegisterKiosk operation middleware
+func (siw *ServerInterfaceWrapper) RegisterKiosk(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -829,7 +2738,7 @@ func (siw *ServerInterfaceWrapper) AddStudent(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddStudent(w, r)
+		siw.Handler.RegisterKiosk(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -839,8 +2748,8 @@ func (siw *ServerInterfaceWrapper) AddStudent(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteStudentById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStudentById(w http.ResponseWriter, r *http.Request) {
+// RevokeKiosk operation middleware
+func (siw *ServerInterfaceWrapper) RevokeKiosk(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -860,7 +2769,7 @@ func (siw *ServerInterfaceWrapper) DeleteStudentById(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteStudentById(w, r, id)
+		siw.Handler.RevokeKiosk(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -870,8 +2779,8 @@ func (siw *ServerInterfaceWrapper) DeleteStudentById(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetStudentById operation middleware
-func (siw *ServerInterfaceWrapper) GetStudentById(w http.ResponseWriter, r *http.Request) {
+// ListKioskTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ListKioskTransactions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -890,1095 +2799,6699 @@ func (siw *ServerInterfaceWrapper) GetStudentById(w http.ResponseWriter, r *http
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStudentById(w, r, id)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListKioskTransactionsParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListKioskTransactions(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Librarian operation middleware
+func (siw *ServerInterfaceWrapper) Librarian(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Librarian(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OIDCLogin operation middleware
+func (siw *ServerInterfaceWrapper) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OIDCLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OIDCCallback operation middleware
+func (siw *ServerInterfaceWrapper) OIDCCallback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OIDCCallbackParams
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", r.URL.Query(), &params.Error)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "error_description" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error_description", r.URL.Query(), &params.ErrorDescription)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error_description", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OIDCCallback(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Logout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOpenAPISpec operation middleware
+func (siw *ServerInterfaceWrapper) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOpenAPISpec(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOverdueRentals operation middleware
+func (siw *ServerInterfaceWrapper) ListOverdueRentals(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOverdueRentalsParams
+
+	// ------------- Optional query parameter "student_card_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "student_card_id", r.URL.Query(), &params.StudentCardId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "student_card_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOverdueRentals(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPortalHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPortalHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, StudentAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPortalHistoryParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPortalHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPortalLoans operation middleware
+func (siw *ServerInterfaceWrapper) ListPortalLoans(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, StudentAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPortalLoans(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// PortalLogin operation middleware
+func (siw *ServerInterfaceWrapper) PortalLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PortalLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SendPortalLoginLink operation middleware
+func (siw *ServerInterfaceWrapper) SendPortalLoginLink(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendPortalLoginLink(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PortalLoginWithToken operation middleware
+func (siw *ServerInterfaceWrapper) PortalLoginWithToken(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PortalLoginWithToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PortalLogout operation middleware
+func (siw *ServerInterfaceWrapper) PortalLogout(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PortalLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPortalStudent operation middleware
+func (siw *ServerInterfaceWrapper) GetPortalStudent(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, StudentAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPortalStudent(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePortalContact operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePortalContact(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, StudentAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePortalContact(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReadyz operation middleware
+func (siw *ServerInterfaceWrapper) GetReadyz(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReadyz(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRents operation middleware
+func (siw *ServerInterfaceWrapper) ListRents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRentsParams
+
+	// ------------- Optional query parameter "book_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "book_name", r.URL.Query(), &params.BookName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "book_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "student_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "student_name", r.URL.Query(), &params.StudentName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "student_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRentTransaction operation middleware
+func (siw *ServerInterfaceWrapper) CreateRentTransaction(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRentTransaction(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRentReceipt operation middleware
+func (siw *ServerInterfaceWrapper) GetRentReceipt(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "cart_id" -------------
+	var cartId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cart_id", chi.URLParam(r, "cart_id"), &cartId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cart_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRentReceiptParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRentReceipt(w, r, cartId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRentalReports operation middleware
+func (siw *ServerInterfaceWrapper) GetRentalReports(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRentalReportsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRentalReports(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCirculationAnalytics operation middleware
+func (siw *ServerInterfaceWrapper) GetCirculationAnalytics(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCirculationAnalyticsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", r.URL.Query(), &params.Interval)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "interval", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCirculationAnalytics(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCollectionReport operation middleware
+func (siw *ServerInterfaceWrapper) GetCollectionReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCollectionReportParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "zero_circulation" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "zero_circulation", r.URL.Query(), &params.ZeroCirculation)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "zero_circulation", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "demand_exceeds_supply" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "demand_exceeds_supply", r.URL.Query(), &params.DemandExceedsSupply)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "demand_exceeds_supply", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCollectionReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DownloadReportFile operation middleware
+func (siw *ServerInterfaceWrapper) DownloadReportFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "format" -------------
+	var format DownloadReportFileParamsFormat
+
+	err = runtime.BindStyledParameterWithOptions("simple", "format", chi.URLParam(r, "format"), &format, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadReportFile(w, r, id, format)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListReportSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListReportSchedules(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListReportSchedules(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateReportSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateReportSchedule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateReportSchedule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteReportSchedule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteReportSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteReportSchedule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReportSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetReportSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReportSchedule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateReportSchedule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateReportSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateReportSchedule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RunReportSchedule operation middleware
+func (siw *ServerInterfaceWrapper) RunReportSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunReportSchedule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListReportRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListReportRuns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReportRunsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListReportRuns(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRentedBooksByStudent operation middleware
+func (siw *ServerInterfaceWrapper) GetRentedBooksByStudent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRentedBooksByStudentParams
+
+	// ------------- Optional query parameter "student_card_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "student_card_id", r.URL.Query(), &params.StudentCardId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "student_card_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRentedBooksByStudent(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReturnBooks operation middleware
+func (siw *ServerInterfaceWrapper) ReturnBooks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReturnBooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListReturnHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListReturnHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReturnHistoryParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "student_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "student_id", r.URL.Query(), &params.StudentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "student_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "book_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "book_id", r.URL.Query(), &params.BookId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "book_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "librarian_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "librarian_id", r.URL.Query(), &params.LibrarianId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "librarian_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "condition" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "condition", r.URL.Query(), &params.Condition)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "condition", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListReturnHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAllStudents operation middleware
+func (siw *ServerInterfaceWrapper) ListAllStudents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAllStudentsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "card_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "card_id", r.URL.Query(), &params.CardId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "card_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "major" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "major", r.URL.Query(), &params.Major)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "major", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "has_active_rentals" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "has_active_rentals", r.URL.Query(), &params.HasActiveRentals)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "has_active_rentals", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "has_overdue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "has_overdue", r.URL.Query(), &params.HasOverdue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "has_overdue", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAllStudents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddStudent operation middleware
+func (siw *ServerInterfaceWrapper) AddStudent(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddStudent(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PrintStudentLabels operation middleware
+func (siw *ServerInterfaceWrapper) PrintStudentLabels(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PrintStudentLabels(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteStudentById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStudentById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteStudentById(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStudentById operation middleware
+func (siw *ServerInterfaceWrapper) GetStudentById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStudentById(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStudentBarcode operation middleware
+func (siw *ServerInterfaceWrapper) GetStudentBarcode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStudentBarcodeParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "scale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scale", r.URL.Query(), &params.Scale)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scale", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStudentBarcode(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStudentHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetStudentHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStudentHistoryParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStudentHistory(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStudentPin operation middleware
+func (siw *ServerInterfaceWrapper) SetStudentPin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStudentPin(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStudentSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetStudentSummary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStudentSummary(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVersion(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/books", wrapper.ListOrSearchBooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/books", wrapper.AddBook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/books/labels", wrapper.PrintBookLabels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/books/tags", wrapper.ListBookTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/books/{id}", wrapper.DeleteBookById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/books/{id}/barcode", wrapper.GetBookBarcode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/books/{id}/classification", wrapper.ClassifyBook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/categories", wrapper.ListCategories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/categories", wrapper.CreateCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/categories/{id}", wrapper.DeleteCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/categories/{id}", wrapper.GetCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/categories/{id}", wrapper.UpdateCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/csrf", wrapper.GetCSRFToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/healthz", wrapper.GetHealthz)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/kiosk/checkout", wrapper.KioskCheckout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/kiosk/identify", wrapper.KioskIdentify)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/kiosk/return", wrapper.KioskReturn)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/kiosks", wrapper.ListKiosks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/kiosks", wrapper.RegisterKiosk)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/kiosks/{id}", wrapper.RevokeKiosk)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/kiosks/{id}/transactions", wrapper.ListKioskTransactions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/librarian", wrapper.Librarian)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/login", wrapper.Login)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/login/oidc", wrapper.OIDCLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/login/oidc/callback", wrapper.OIDCCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/logout", wrapper.Logout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/openapi.json", wrapper.GetOpenAPISpec)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/overdues", wrapper.ListOverdueRentals)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/portal/history", wrapper.GetPortalHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/portal/loans", wrapper.ListPortalLoans)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/portal/login", wrapper.PortalLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/portal/login/link", wrapper.SendPortalLoginLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/portal/login/token", wrapper.PortalLoginWithToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/portal/logout", wrapper.PortalLogout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/portal/me", wrapper.GetPortalStudent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/portal/me/contact", wrapper.UpdatePortalContact)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/readyz", wrapper.GetReadyz)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/rents", wrapper.ListRents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/rents", wrapper.CreateRentTransaction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/rents/{cart_id}/receipt", wrapper.GetRentReceipt)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports", wrapper.GetRentalReports)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/analytics", wrapper.GetCirculationAnalytics)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/collection", wrapper.GetCollectionReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/runs/{id}/files/{format}", wrapper.DownloadReportFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/schedules", wrapper.ListReportSchedules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reports/schedules", wrapper.CreateReportSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/reports/schedules/{id}", wrapper.DeleteReportSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/schedules/{id}", wrapper.GetReportSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/reports/schedules/{id}", wrapper.UpdateReportSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reports/schedules/{id}/run", wrapper.RunReportSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/schedules/{id}/runs", wrapper.ListReportRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/returns", wrapper.GetRentedBooksByStudent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/returns", wrapper.ReturnBooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/returns/history", wrapper.ListReturnHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/students", wrapper.ListAllStudents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/students", wrapper.AddStudent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/students/labels", wrapper.PrintStudentLabels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/students/{id}", wrapper.DeleteStudentById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/students/{id}", wrapper.GetStudentById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/students/{id}/barcode", wrapper.GetStudentBarcode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/students/{id}/history", wrapper.GetStudentHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/students/{id}/pin", wrapper.SetStudentPin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/students/{id}/summary", wrapper.GetStudentSummary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/version", wrapper.GetVersion)
+	})
+
+	return r
+}
+
+type ConflictErrorApplicationProblemPlusJSONResponse Problem
+
+type InternalServerErrorApplicationProblemPlusJSONResponse Problem
+
+type InvalidRequestBodyApplicationProblemPlusJSONResponse Problem
+
+type InvalidRequestParametersApplicationProblemPlusJSONResponse Problem
+
+type NotFoundErrorApplicationProblemPlusJSONResponse Problem
+
+type UnauthorizedErrorApplicationProblemPlusJSONResponse Problem
+
+type UnprocessableErrorApplicationProblemPlusJSONResponse Problem
+
+type ListOrSearchBooksRequestObject struct {
+	Params ListOrSearchBooksParams
+}
+
+type ListOrSearchBooksResponseObject interface {
+	VisitListOrSearchBooksResponse(w http.ResponseWriter) error
+}
+
+type ListOrSearchBooks200JSONResponse struct {
+	// Facets Number of books matching the search and every filter except `availability`,
+	// split by availability.
+	Facets     *BookFacets     `json:"facets,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Results    *[]Books        `json:"results,omitempty"`
+}
+
+func (response ListOrSearchBooks200JSONResponse) VisitListOrSearchBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrSearchBooks400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response ListOrSearchBooks400ApplicationProblemPlusJSONResponse) VisitListOrSearchBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrSearchBooks401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListOrSearchBooks401ApplicationProblemPlusJSONResponse) VisitListOrSearchBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrSearchBooks500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListOrSearchBooks500ApplicationProblemPlusJSONResponse) VisitListOrSearchBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddBookRequestObject struct {
+	Body *AddBookJSONRequestBody
+}
+
+type AddBookResponseObject interface {
+	VisitAddBookResponse(w http.ResponseWriter) error
+}
+
+type AddBook201ResponseHeaders struct {
+	Location string
+}
+
+type AddBook201JSONResponse struct {
+	Body struct {
+		Message *string `json:"message,omitempty"`
+	}
+	Headers AddBook201ResponseHeaders
+}
+
+func (response AddBook201JSONResponse) VisitAddBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddBook400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response AddBook400ApplicationProblemPlusJSONResponse) VisitAddBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddBook401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AddBook401ApplicationProblemPlusJSONResponse) VisitAddBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddBook500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AddBook500ApplicationProblemPlusJSONResponse) VisitAddBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrintBookLabelsRequestObject struct {
+	Body *PrintBookLabelsJSONRequestBody
+}
+
+type PrintBookLabelsResponseObject interface {
+	VisitPrintBookLabelsResponse(w http.ResponseWriter) error
+}
+
+type PrintBookLabels200ApplicationpdfResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response PrintBookLabels200ApplicationpdfResponse) VisitPrintBookLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PrintBookLabels400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response PrintBookLabels400ApplicationProblemPlusJSONResponse) VisitPrintBookLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrintBookLabels401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PrintBookLabels401ApplicationProblemPlusJSONResponse) VisitPrintBookLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrintBookLabels404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PrintBookLabels404ApplicationProblemPlusJSONResponse) VisitPrintBookLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrintBookLabels500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PrintBookLabels500ApplicationProblemPlusJSONResponse) VisitPrintBookLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBookTagsRequestObject struct {
+}
+
+type ListBookTagsResponseObject interface {
+	VisitListBookTagsResponse(w http.ResponseWriter) error
+}
+
+type ListBookTags200JSONResponse struct {
+	Results *[]TagCount `json:"results,omitempty"`
+}
+
+func (response ListBookTags200JSONResponse) VisitListBookTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBookTags401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListBookTags401ApplicationProblemPlusJSONResponse) VisitListBookTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBookTags500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListBookTags500ApplicationProblemPlusJSONResponse) VisitListBookTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBookByIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteBookByIdResponseObject interface {
+	VisitDeleteBookByIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteBookById201Response struct {
+}
+
+func (response DeleteBookById201Response) VisitDeleteBookByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type DeleteBookById401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteBookById401ApplicationProblemPlusJSONResponse) VisitDeleteBookByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBookById404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteBookById404ApplicationProblemPlusJSONResponse) VisitDeleteBookByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBookById500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteBookById500ApplicationProblemPlusJSONResponse) VisitDeleteBookByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookBarcodeRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetBookBarcodeParams
+}
+
+type GetBookBarcodeResponseObject interface {
+	VisitGetBookBarcodeResponse(w http.ResponseWriter) error
+}
+
+type GetBookBarcode200ImagepngResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetBookBarcode200ImagepngResponse) VisitGetBookBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetBookBarcode400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response GetBookBarcode400ApplicationProblemPlusJSONResponse) VisitGetBookBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookBarcode401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetBookBarcode401ApplicationProblemPlusJSONResponse) VisitGetBookBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookBarcode404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetBookBarcode404ApplicationProblemPlusJSONResponse) VisitGetBookBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookBarcode500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetBookBarcode500ApplicationProblemPlusJSONResponse) VisitGetBookBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClassifyBookRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *ClassifyBookJSONRequestBody
+}
+
+type ClassifyBookResponseObject interface {
+	VisitClassifyBookResponse(w http.ResponseWriter) error
+}
+
+type ClassifyBook200JSONResponse Books
+
+func (response ClassifyBook200JSONResponse) VisitClassifyBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClassifyBook400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response ClassifyBook400ApplicationProblemPlusJSONResponse) VisitClassifyBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClassifyBook401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ClassifyBook401ApplicationProblemPlusJSONResponse) VisitClassifyBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClassifyBook404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ClassifyBook404ApplicationProblemPlusJSONResponse) VisitClassifyBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClassifyBook500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ClassifyBook500ApplicationProblemPlusJSONResponse) VisitClassifyBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCategoriesRequestObject struct {
+}
+
+type ListCategoriesResponseObject interface {
+	VisitListCategoriesResponse(w http.ResponseWriter) error
+}
+
+type ListCategories200JSONResponse struct {
+	Results *[]CategoryNode `json:"results,omitempty"`
+}
+
+func (response ListCategories200JSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCategories401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListCategories401ApplicationProblemPlusJSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCategories500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListCategories500ApplicationProblemPlusJSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategoryRequestObject struct {
+	Body *CreateCategoryJSONRequestBody
+}
+
+type CreateCategoryResponseObject interface {
+	VisitCreateCategoryResponse(w http.ResponseWriter) error
+}
+
+type CreateCategory201ResponseHeaders struct {
+	Location string
+}
+
+type CreateCategory201JSONResponse struct {
+	Body    Category
+	Headers CreateCategory201ResponseHeaders
+}
+
+func (response CreateCategory201JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCategory400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response CreateCategory400ApplicationProblemPlusJSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateCategory401ApplicationProblemPlusJSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategory409ApplicationProblemPlusJSONResponse struct {
+	ConflictErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateCategory409ApplicationProblemPlusJSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategory500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateCategory500ApplicationProblemPlusJSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategoryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteCategoryResponseObject interface {
+	VisitDeleteCategoryResponse(w http.ResponseWriter) error
+}
+
+type DeleteCategory204Response struct {
+}
+
+func (response DeleteCategory204Response) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCategory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteCategory401ApplicationProblemPlusJSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategory404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteCategory404ApplicationProblemPlusJSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategory409ApplicationProblemPlusJSONResponse struct {
+	ConflictErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteCategory409ApplicationProblemPlusJSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategory500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteCategory500ApplicationProblemPlusJSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategoryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetCategoryResponseObject interface {
+	VisitGetCategoryResponse(w http.ResponseWriter) error
+}
+
+type GetCategory200JSONResponse CategoryNode
+
+func (response GetCategory200JSONResponse) VisitGetCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetCategory401ApplicationProblemPlusJSONResponse) VisitGetCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategory404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetCategory404ApplicationProblemPlusJSONResponse) VisitGetCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategory500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetCategory500ApplicationProblemPlusJSONResponse) VisitGetCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategoryRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateCategoryJSONRequestBody
+}
+
+type UpdateCategoryResponseObject interface {
+	VisitUpdateCategoryResponse(w http.ResponseWriter) error
+}
+
+type UpdateCategory200JSONResponse Category
+
+func (response UpdateCategory200JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategory400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateCategory400ApplicationProblemPlusJSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateCategory401ApplicationProblemPlusJSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategory404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateCategory404ApplicationProblemPlusJSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategory409ApplicationProblemPlusJSONResponse struct {
+	ConflictErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateCategory409ApplicationProblemPlusJSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategory500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateCategory500ApplicationProblemPlusJSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCSRFTokenRequestObject struct {
+}
+
+type GetCSRFTokenResponseObject interface {
+	VisitGetCSRFTokenResponse(w http.ResponseWriter) error
+}
+
+type GetCSRFToken200ResponseHeaders struct {
+	XCSRFToken string
+}
+
+type GetCSRFToken200JSONResponse struct {
+	Body struct {
+		CsrfToken *string `json:"csrf_token,omitempty"`
+	}
+	Headers GetCSRFToken200ResponseHeaders
+}
+
+func (response GetCSRFToken200JSONResponse) VisitGetCSRFTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-CSRF-Token", fmt.Sprint(response.Headers.XCSRFToken))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCSRFToken404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetCSRFToken404ApplicationProblemPlusJSONResponse) VisitGetCSRFTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthzRequestObject struct {
+}
+
+type GetHealthzResponseObject interface {
+	VisitGetHealthzResponse(w http.ResponseWriter) error
+}
+
+type GetHealthz200JSONResponse HealthStatus
+
+func (response GetHealthz200JSONResponse) VisitGetHealthzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskCheckoutRequestObject struct {
+	Body *KioskCheckoutJSONRequestBody
+}
+
+type KioskCheckoutResponseObject interface {
+	VisitKioskCheckoutResponse(w http.ResponseWriter) error
+}
+
+type KioskCheckout201JSONResponse Receipt
+
+func (response KioskCheckout201JSONResponse) VisitKioskCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskCheckout400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response KioskCheckout400ApplicationProblemPlusJSONResponse) VisitKioskCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskCheckout401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskCheckout401ApplicationProblemPlusJSONResponse) VisitKioskCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskCheckout404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskCheckout404ApplicationProblemPlusJSONResponse) VisitKioskCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskCheckout409ApplicationProblemPlusJSONResponse struct {
+	ConflictErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskCheckout409ApplicationProblemPlusJSONResponse) VisitKioskCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskCheckout422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskCheckout422ApplicationProblemPlusJSONResponse) VisitKioskCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskCheckout500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskCheckout500ApplicationProblemPlusJSONResponse) VisitKioskCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskIdentifyRequestObject struct {
+	Body *KioskIdentifyJSONRequestBody
+}
+
+type KioskIdentifyResponseObject interface {
+	VisitKioskIdentifyResponse(w http.ResponseWriter) error
+}
+
+type KioskIdentify200JSONResponse KioskStudent
+
+func (response KioskIdentify200JSONResponse) VisitKioskIdentifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskIdentify400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response KioskIdentify400ApplicationProblemPlusJSONResponse) VisitKioskIdentifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskIdentify401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskIdentify401ApplicationProblemPlusJSONResponse) VisitKioskIdentifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskIdentify404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskIdentify404ApplicationProblemPlusJSONResponse) VisitKioskIdentifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskIdentify500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskIdentify500ApplicationProblemPlusJSONResponse) VisitKioskIdentifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskReturnRequestObject struct {
+	Body *KioskReturnJSONRequestBody
+}
+
+type KioskReturnResponseObject interface {
+	VisitKioskReturnResponse(w http.ResponseWriter) error
+}
+
+type KioskReturn200JSONResponse Receipt
+
+func (response KioskReturn200JSONResponse) VisitKioskReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskReturn400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response KioskReturn400ApplicationProblemPlusJSONResponse) VisitKioskReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskReturn401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskReturn401ApplicationProblemPlusJSONResponse) VisitKioskReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskReturn404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskReturn404ApplicationProblemPlusJSONResponse) VisitKioskReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskReturn409ApplicationProblemPlusJSONResponse struct {
+	ConflictErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskReturn409ApplicationProblemPlusJSONResponse) VisitKioskReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KioskReturn500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response KioskReturn500ApplicationProblemPlusJSONResponse) VisitKioskReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListKiosksRequestObject struct {
+}
+
+type ListKiosksResponseObject interface {
+	VisitListKiosksResponse(w http.ResponseWriter) error
+}
+
+type ListKiosks200JSONResponse struct {
+	Results *[]KioskDevice `json:"results,omitempty"`
+}
+
+func (response ListKiosks200JSONResponse) VisitListKiosksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListKiosks401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListKiosks401ApplicationProblemPlusJSONResponse) VisitListKiosksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListKiosks500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListKiosks500ApplicationProblemPlusJSONResponse) VisitListKiosksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterKioskRequestObject struct {
+	Body *RegisterKioskJSONRequestBody
+}
+
+type RegisterKioskResponseObject interface {
+	VisitRegisterKioskResponse(w http.ResponseWriter) error
+}
+
+type RegisterKiosk201JSONResponse KioskRegistration
+
+func (response RegisterKiosk201JSONResponse) VisitRegisterKioskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterKiosk400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response RegisterKiosk400ApplicationProblemPlusJSONResponse) VisitRegisterKioskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterKiosk401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RegisterKiosk401ApplicationProblemPlusJSONResponse) VisitRegisterKioskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterKiosk500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RegisterKiosk500ApplicationProblemPlusJSONResponse) VisitRegisterKioskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeKioskRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RevokeKioskResponseObject interface {
+	VisitRevokeKioskResponse(w http.ResponseWriter) error
+}
+
+type RevokeKiosk204Response struct {
+}
+
+func (response RevokeKiosk204Response) VisitRevokeKioskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeKiosk401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeKiosk401ApplicationProblemPlusJSONResponse) VisitRevokeKioskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeKiosk404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeKiosk404ApplicationProblemPlusJSONResponse) VisitRevokeKioskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeKiosk500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeKiosk500ApplicationProblemPlusJSONResponse) VisitRevokeKioskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListKioskTransactionsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params ListKioskTransactionsParams
+}
+
+type ListKioskTransactionsResponseObject interface {
+	VisitListKioskTransactionsResponse(w http.ResponseWriter) error
+}
+
+type ListKioskTransactions200JSONResponse struct {
+	Pagination *PaginationInfo     `json:"pagination,omitempty"`
+	Results    *[]KioskTransaction `json:"results,omitempty"`
+}
+
+func (response ListKioskTransactions200JSONResponse) VisitListKioskTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListKioskTransactions400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response ListKioskTransactions400ApplicationProblemPlusJSONResponse) VisitListKioskTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListKioskTransactions401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListKioskTransactions401ApplicationProblemPlusJSONResponse) VisitListKioskTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListKioskTransactions404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListKioskTransactions404ApplicationProblemPlusJSONResponse) VisitListKioskTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListKioskTransactions500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListKioskTransactions500ApplicationProblemPlusJSONResponse) VisitListKioskTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LibrarianRequestObject struct {
+}
+
+type LibrarianResponseObject interface {
+	VisitLibrarianResponse(w http.ResponseWriter) error
+}
+
+type Librarian200JSONResponse struct {
+	LibrarianId *openapi_types.UUID `json:"librarian_id,omitempty"`
+	Message     *string             `json:"message,omitempty"`
+}
+
+func (response Librarian200JSONResponse) VisitLibrarianResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Librarian401ApplicationProblemPlusJSONResponse Problem
+
+func (response Librarian401ApplicationProblemPlusJSONResponse) VisitLibrarianResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Librarian500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response Librarian500ApplicationProblemPlusJSONResponse) VisitLibrarianResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200ResponseHeaders struct {
+	SetCookie string
+}
+
+type Login200JSONResponse struct {
+	Body struct {
+		LibrarianId *openapi_types.UUID `json:"librarian_id,omitempty"`
+		Message     *string             `json:"message,omitempty"`
+	}
+	Headers Login200ResponseHeaders
+}
+
+func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type Login401ApplicationProblemPlusJSONResponse Problem
+
+func (response Login401ApplicationProblemPlusJSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response Login500ApplicationProblemPlusJSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OIDCLoginRequestObject struct {
+}
+
+type OIDCLoginResponseObject interface {
+	VisitOIDCLoginResponse(w http.ResponseWriter) error
+}
+
+type OIDCLogin302ResponseHeaders struct {
+	Location string
+}
+
+type OIDCLogin302Response struct {
+	Headers OIDCLogin302ResponseHeaders
+}
+
+func (response OIDCLogin302Response) VisitOIDCLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type OIDCLogin404ApplicationProblemPlusJSONResponse Problem
+
+func (response OIDCLogin404ApplicationProblemPlusJSONResponse) VisitOIDCLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OIDCLogin500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response OIDCLogin500ApplicationProblemPlusJSONResponse) VisitOIDCLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OIDCCallbackRequestObject struct {
+	Params OIDCCallbackParams
+}
+
+type OIDCCallbackResponseObject interface {
+	VisitOIDCCallbackResponse(w http.ResponseWriter) error
+}
+
+type OIDCCallback302ResponseHeaders struct {
+	SetCookie string
+}
+
+type OIDCCallback302Response struct {
+	Headers OIDCCallback302ResponseHeaders
+}
+
+func (response OIDCCallback302Response) VisitOIDCCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(302)
+	return nil
+}
+
+type OIDCCallback401ApplicationProblemPlusJSONResponse Problem
+
+func (response OIDCCallback401ApplicationProblemPlusJSONResponse) VisitOIDCCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OIDCCallback403ApplicationProblemPlusJSONResponse Problem
+
+func (response OIDCCallback403ApplicationProblemPlusJSONResponse) VisitOIDCCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OIDCCallback404ApplicationProblemPlusJSONResponse Problem
+
+func (response OIDCCallback404ApplicationProblemPlusJSONResponse) VisitOIDCCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OIDCCallback500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response OIDCCallback500ApplicationProblemPlusJSONResponse) VisitOIDCCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutRequestObject struct {
+}
+
+type LogoutResponseObject interface {
+	VisitLogoutResponse(w http.ResponseWriter) error
+}
+
+type Logout200JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response Logout200JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Logout500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response Logout500ApplicationProblemPlusJSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOpenAPISpecRequestObject struct {
+}
+
+type GetOpenAPISpecResponseObject interface {
+	VisitGetOpenAPISpecResponse(w http.ResponseWriter) error
+}
+
+type GetOpenAPISpec200JSONResponse json.RawMessage
+
+func (response GetOpenAPISpec200JSONResponse) VisitGetOpenAPISpecResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOpenAPISpecdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetOpenAPISpecdefaultApplicationProblemPlusJSONResponse) VisitGetOpenAPISpecResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOverdueRentalsRequestObject struct {
+	Params ListOverdueRentalsParams
+}
+
+type ListOverdueRentalsResponseObject interface {
+	VisitListOverdueRentalsResponse(w http.ResponseWriter) error
+}
+
+type ListOverdueRentals200JSONResponse struct {
+	Aging      *[]AgingBucket  `json:"aging,omitempty"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Results    *[]OverdueUser  `json:"results,omitempty"`
+}
+
+func (response ListOverdueRentals200JSONResponse) VisitListOverdueRentalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOverdueRentals400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response ListOverdueRentals400ApplicationProblemPlusJSONResponse) VisitListOverdueRentalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOverdueRentals401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListOverdueRentals401ApplicationProblemPlusJSONResponse) VisitListOverdueRentalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOverdueRentals500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListOverdueRentals500ApplicationProblemPlusJSONResponse) VisitListOverdueRentalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortalHistoryRequestObject struct {
+	Params GetPortalHistoryParams
+}
+
+type GetPortalHistoryResponseObject interface {
+	VisitGetPortalHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetPortalHistory200JSONResponse struct {
+	Pagination *PaginationInfo  `json:"pagination,omitempty"`
+	Results    *[]StudentRental `json:"results,omitempty"`
+}
+
+func (response GetPortalHistory200JSONResponse) VisitGetPortalHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortalHistory400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response GetPortalHistory400ApplicationProblemPlusJSONResponse) VisitGetPortalHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortalHistory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetPortalHistory401ApplicationProblemPlusJSONResponse) VisitGetPortalHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortalHistory500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetPortalHistory500ApplicationProblemPlusJSONResponse) VisitGetPortalHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPortalLoansRequestObject struct {
+}
+
+type ListPortalLoansResponseObject interface {
+	VisitListPortalLoansResponse(w http.ResponseWriter) error
+}
+
+type ListPortalLoans200JSONResponse struct {
+	Results *[]StudentRental `json:"results,omitempty"`
+}
+
+func (response ListPortalLoans200JSONResponse) VisitListPortalLoansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPortalLoans401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListPortalLoans401ApplicationProblemPlusJSONResponse) VisitListPortalLoansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPortalLoans500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListPortalLoans500ApplicationProblemPlusJSONResponse) VisitListPortalLoansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PortalLoginRequestObject struct {
+	Body *PortalLoginJSONRequestBody
+}
+
+type PortalLoginResponseObject interface {
+	VisitPortalLoginResponse(w http.ResponseWriter) error
+}
+
+type PortalLogin200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PortalLogin200JSONResponse struct {
+	Body    PortalLoginResponse
+	Headers PortalLogin200ResponseHeaders
+}
+
+func (response PortalLogin200JSONResponse) VisitPortalLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PortalLogin400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response PortalLogin400ApplicationProblemPlusJSONResponse) VisitPortalLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PortalLogin401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PortalLogin401ApplicationProblemPlusJSONResponse) VisitPortalLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PortalLogin429ApplicationProblemPlusJSONResponse Problem
+
+func (response PortalLogin429ApplicationProblemPlusJSONResponse) VisitPortalLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PortalLogin500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PortalLogin500ApplicationProblemPlusJSONResponse) VisitPortalLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendPortalLoginLinkRequestObject struct {
+	Body *SendPortalLoginLinkJSONRequestBody
+}
+
+type SendPortalLoginLinkResponseObject interface {
+	VisitSendPortalLoginLinkResponse(w http.ResponseWriter) error
+}
+
+type SendPortalLoginLink202JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response SendPortalLoginLink202JSONResponse) VisitSendPortalLoginLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendPortalLoginLink400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response SendPortalLoginLink400ApplicationProblemPlusJSONResponse) VisitSendPortalLoginLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendPortalLoginLink404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response SendPortalLoginLink404ApplicationProblemPlusJSONResponse) VisitSendPortalLoginLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendPortalLoginLink500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response SendPortalLoginLink500ApplicationProblemPlusJSONResponse) VisitSendPortalLoginLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PortalLoginWithTokenRequestObject struct {
+	Body *PortalLoginWithTokenJSONRequestBody
+}
+
+type PortalLoginWithTokenResponseObject interface {
+	VisitPortalLoginWithTokenResponse(w http.ResponseWriter) error
+}
+
+type PortalLoginWithToken200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PortalLoginWithToken200JSONResponse struct {
+	Body    PortalLoginResponse
+	Headers PortalLoginWithToken200ResponseHeaders
+}
+
+func (response PortalLoginWithToken200JSONResponse) VisitPortalLoginWithTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PortalLoginWithToken400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response PortalLoginWithToken400ApplicationProblemPlusJSONResponse) VisitPortalLoginWithTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PortalLoginWithToken401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PortalLoginWithToken401ApplicationProblemPlusJSONResponse) VisitPortalLoginWithTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PortalLoginWithToken500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PortalLoginWithToken500ApplicationProblemPlusJSONResponse) VisitPortalLoginWithTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PortalLogoutRequestObject struct {
+}
+
+type PortalLogoutResponseObject interface {
+	VisitPortalLogoutResponse(w http.ResponseWriter) error
+}
+
+type PortalLogout200JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PortalLogout200JSONResponse) VisitPortalLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PortalLogout500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PortalLogout500ApplicationProblemPlusJSONResponse) VisitPortalLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortalStudentRequestObject struct {
+}
+
+type GetPortalStudentResponseObject interface {
+	VisitGetPortalStudentResponse(w http.ResponseWriter) error
+}
+
+type GetPortalStudent200JSONResponse Students
+
+func (response GetPortalStudent200JSONResponse) VisitGetPortalStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortalStudent401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetPortalStudent401ApplicationProblemPlusJSONResponse) VisitGetPortalStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPortalStudent500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetPortalStudent500ApplicationProblemPlusJSONResponse) VisitGetPortalStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePortalContactRequestObject struct {
+	Body *UpdatePortalContactJSONRequestBody
+}
+
+type UpdatePortalContactResponseObject interface {
+	VisitUpdatePortalContactResponse(w http.ResponseWriter) error
+}
+
+type UpdatePortalContact200JSONResponse Students
+
+func (response UpdatePortalContact200JSONResponse) VisitUpdatePortalContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePortalContact400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response UpdatePortalContact400ApplicationProblemPlusJSONResponse) VisitUpdatePortalContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePortalContact401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdatePortalContact401ApplicationProblemPlusJSONResponse) VisitUpdatePortalContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePortalContact500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdatePortalContact500ApplicationProblemPlusJSONResponse) VisitUpdatePortalContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadyzRequestObject struct {
+}
+
+type GetReadyzResponseObject interface {
+	VisitGetReadyzResponse(w http.ResponseWriter) error
+}
+
+type GetReadyz200JSONResponse HealthStatus
+
+func (response GetReadyz200JSONResponse) VisitGetReadyzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadyz503JSONResponse HealthStatus
+
+func (response GetReadyz503JSONResponse) VisitGetReadyzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRentsRequestObject struct {
+	Params ListRentsParams
+}
+
+type ListRentsResponseObject interface {
+	VisitListRentsResponse(w http.ResponseWriter) error
+}
+
+type ListRents200JSONResponse struct {
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Results    *[]RentSummary  `json:"results,omitempty"`
+}
+
+func (response ListRents200JSONResponse) VisitListRentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRents400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response ListRents400ApplicationProblemPlusJSONResponse) VisitListRentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRents401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListRents401ApplicationProblemPlusJSONResponse) VisitListRentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRents500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListRents500ApplicationProblemPlusJSONResponse) VisitListRentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRentTransactionRequestObject struct {
+	Body *CreateRentTransactionJSONRequestBody
+}
+
+type CreateRentTransactionResponseObject interface {
+	VisitCreateRentTransactionResponse(w http.ResponseWriter) error
+}
+
+type CreateRentTransaction201JSONResponse struct {
+	// BookIds The rented book of every requested copy, resolved from the request identifiers
+	BookIds   *[]openapi_types.UUID `json:"book_ids,omitempty"`
+	CartId    *openapi_types.UUID   `json:"cart_id,omitempty"`
+	Message   *string               `json:"message,omitempty"`
+	StudentId *openapi_types.UUID   `json:"student_id,omitempty"`
+}
+
+func (response CreateRentTransaction201JSONResponse) VisitCreateRentTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRentTransaction400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response CreateRentTransaction400ApplicationProblemPlusJSONResponse) VisitCreateRentTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRentTransaction401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateRentTransaction401ApplicationProblemPlusJSONResponse) VisitCreateRentTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRentTransaction404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateRentTransaction404ApplicationProblemPlusJSONResponse) VisitCreateRentTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRentTransaction409ApplicationProblemPlusJSONResponse struct {
+	ConflictErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateRentTransaction409ApplicationProblemPlusJSONResponse) VisitCreateRentTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRentTransaction422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateRentTransaction422ApplicationProblemPlusJSONResponse) VisitCreateRentTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRentTransaction500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateRentTransaction500ApplicationProblemPlusJSONResponse) VisitCreateRentTransactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentReceiptRequestObject struct {
+	CartId openapi_types.UUID `json:"cart_id"`
+	Params GetRentReceiptParams
+}
+
+type GetRentReceiptResponseObject interface {
+	VisitGetRentReceiptResponse(w http.ResponseWriter) error
+}
+
+type GetRentReceipt200ApplicationpdfResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetRentReceipt200ApplicationpdfResponse) VisitGetRentReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetRentReceipt200TextResponse string
+
+func (response GetRentReceipt200TextResponse) VisitGetRentReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetRentReceipt400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response GetRentReceipt400ApplicationProblemPlusJSONResponse) VisitGetRentReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentReceipt401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetRentReceipt401ApplicationProblemPlusJSONResponse) VisitGetRentReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentReceipt404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetRentReceipt404ApplicationProblemPlusJSONResponse) VisitGetRentReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentReceipt500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetRentReceipt500ApplicationProblemPlusJSONResponse) VisitGetRentReceiptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentalReportsRequestObject struct {
+	Params GetRentalReportsParams
+}
+
+type GetRentalReportsResponseObject interface {
+	VisitGetRentalReportsResponse(w http.ResponseWriter) error
+}
+
+type GetRentalReports200JSONResponse RentReport
+
+func (response GetRentalReports200JSONResponse) VisitGetRentalReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentalReports400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response GetRentalReports400ApplicationProblemPlusJSONResponse) VisitGetRentalReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentalReports401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetRentalReports401ApplicationProblemPlusJSONResponse) VisitGetRentalReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentalReports500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetRentalReports500ApplicationProblemPlusJSONResponse) VisitGetRentalReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCirculationAnalyticsRequestObject struct {
+	Params GetCirculationAnalyticsParams
+}
+
+type GetCirculationAnalyticsResponseObject interface {
+	VisitGetCirculationAnalyticsResponse(w http.ResponseWriter) error
+}
+
+type GetCirculationAnalytics200JSONResponse AnalyticsResponse
+
+func (response GetCirculationAnalytics200JSONResponse) VisitGetCirculationAnalyticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCirculationAnalytics400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response GetCirculationAnalytics400ApplicationProblemPlusJSONResponse) VisitGetCirculationAnalyticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCirculationAnalytics401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetCirculationAnalytics401ApplicationProblemPlusJSONResponse) VisitGetCirculationAnalyticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCirculationAnalytics500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetCirculationAnalytics500ApplicationProblemPlusJSONResponse) VisitGetCirculationAnalyticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCollectionReportRequestObject struct {
+	Params GetCollectionReportParams
+}
+
+type GetCollectionReportResponseObject interface {
+	VisitGetCollectionReportResponse(w http.ResponseWriter) error
+}
+
+type GetCollectionReport200JSONResponse CollectionReport
+
+func (response GetCollectionReport200JSONResponse) VisitGetCollectionReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCollectionReport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetCollectionReport200TextcsvResponse) VisitGetCollectionReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCollectionReport400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response GetCollectionReport400ApplicationProblemPlusJSONResponse) VisitGetCollectionReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCollectionReport401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetCollectionReport401ApplicationProblemPlusJSONResponse) VisitGetCollectionReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCollectionReport500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetCollectionReport500ApplicationProblemPlusJSONResponse) VisitGetCollectionReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadReportFileRequestObject struct {
+	Id     openapi_types.UUID             `json:"id"`
+	Format DownloadReportFileParamsFormat `json:"format"`
+}
+
+type DownloadReportFileResponseObject interface {
+	VisitDownloadReportFileResponse(w http.ResponseWriter) error
+}
+
+type DownloadReportFile200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response DownloadReportFile200TextcsvResponse) VisitDownloadReportFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadReportFile200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response DownloadReportFile200TexthtmlResponse) VisitDownloadReportFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadReportFile401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DownloadReportFile401ApplicationProblemPlusJSONResponse) VisitDownloadReportFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadReportFile404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DownloadReportFile404ApplicationProblemPlusJSONResponse) VisitDownloadReportFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadReportFile500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DownloadReportFile500ApplicationProblemPlusJSONResponse) VisitDownloadReportFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReportSchedulesRequestObject struct {
+}
+
+type ListReportSchedulesResponseObject interface {
+	VisitListReportSchedulesResponse(w http.ResponseWriter) error
+}
+
+type ListReportSchedules200JSONResponse struct {
+	Results *[]ReportSchedule `json:"results,omitempty"`
+}
+
+func (response ListReportSchedules200JSONResponse) VisitListReportSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReportSchedules401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListReportSchedules401ApplicationProblemPlusJSONResponse) VisitListReportSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReportSchedules500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListReportSchedules500ApplicationProblemPlusJSONResponse) VisitListReportSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReportScheduleRequestObject struct {
+	Body *CreateReportScheduleJSONRequestBody
+}
+
+type CreateReportScheduleResponseObject interface {
+	VisitCreateReportScheduleResponse(w http.ResponseWriter) error
+}
+
+type CreateReportSchedule201ResponseHeaders struct {
+	Location string
+}
+
+type CreateReportSchedule201JSONResponse struct {
+	Body    ReportSchedule
+	Headers CreateReportSchedule201ResponseHeaders
+}
+
+func (response CreateReportSchedule201JSONResponse) VisitCreateReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateReportSchedule400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response CreateReportSchedule400ApplicationProblemPlusJSONResponse) VisitCreateReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReportSchedule401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateReportSchedule401ApplicationProblemPlusJSONResponse) VisitCreateReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReportSchedule500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateReportSchedule500ApplicationProblemPlusJSONResponse) VisitCreateReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReportScheduleRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteReportScheduleResponseObject interface {
+	VisitDeleteReportScheduleResponse(w http.ResponseWriter) error
+}
+
+type DeleteReportSchedule204Response struct {
+}
+
+func (response DeleteReportSchedule204Response) VisitDeleteReportScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteReportSchedule401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteReportSchedule401ApplicationProblemPlusJSONResponse) VisitDeleteReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReportSchedule404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteReportSchedule404ApplicationProblemPlusJSONResponse) VisitDeleteReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReportSchedule500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteReportSchedule500ApplicationProblemPlusJSONResponse) VisitDeleteReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportScheduleRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetReportScheduleResponseObject interface {
+	VisitGetReportScheduleResponse(w http.ResponseWriter) error
+}
+
+type GetReportSchedule200JSONResponse ReportSchedule
+
+func (response GetReportSchedule200JSONResponse) VisitGetReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportSchedule401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetReportSchedule401ApplicationProblemPlusJSONResponse) VisitGetReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportSchedule404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetReportSchedule404ApplicationProblemPlusJSONResponse) VisitGetReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportSchedule500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetReportSchedule500ApplicationProblemPlusJSONResponse) VisitGetReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateReportScheduleRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateReportScheduleJSONRequestBody
+}
+
+type UpdateReportScheduleResponseObject interface {
+	VisitUpdateReportScheduleResponse(w http.ResponseWriter) error
+}
+
+type UpdateReportSchedule200JSONResponse ReportSchedule
+
+func (response UpdateReportSchedule200JSONResponse) VisitUpdateReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateReportSchedule400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateReportSchedule400ApplicationProblemPlusJSONResponse) VisitUpdateReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateReportSchedule401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateReportSchedule401ApplicationProblemPlusJSONResponse) VisitUpdateReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateReportSchedule404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateReportSchedule404ApplicationProblemPlusJSONResponse) VisitUpdateReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateReportSchedule500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateReportSchedule500ApplicationProblemPlusJSONResponse) VisitUpdateReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunReportScheduleRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RunReportScheduleResponseObject interface {
+	VisitRunReportScheduleResponse(w http.ResponseWriter) error
+}
+
+type RunReportSchedule201JSONResponse ReportRun
+
+func (response RunReportSchedule201JSONResponse) VisitRunReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunReportSchedule401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RunReportSchedule401ApplicationProblemPlusJSONResponse) VisitRunReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunReportSchedule404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RunReportSchedule404ApplicationProblemPlusJSONResponse) VisitRunReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunReportSchedule500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RunReportSchedule500ApplicationProblemPlusJSONResponse) VisitRunReportScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReportRunsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params ListReportRunsParams
+}
+
+type ListReportRunsResponseObject interface {
+	VisitListReportRunsResponse(w http.ResponseWriter) error
+}
+
+type ListReportRuns200JSONResponse struct {
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Results    *[]ReportRun    `json:"results,omitempty"`
+}
+
+func (response ListReportRuns200JSONResponse) VisitListReportRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReportRuns400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response ListReportRuns400ApplicationProblemPlusJSONResponse) VisitListReportRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReportRuns401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListReportRuns401ApplicationProblemPlusJSONResponse) VisitListReportRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReportRuns404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListReportRuns404ApplicationProblemPlusJSONResponse) VisitListReportRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReportRuns500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListReportRuns500ApplicationProblemPlusJSONResponse) VisitListReportRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentedBooksByStudentRequestObject struct {
+	Params GetRentedBooksByStudentParams
+}
+
+type GetRentedBooksByStudentResponseObject interface {
+	VisitGetRentedBooksByStudentResponse(w http.ResponseWriter) error
+}
+
+type GetRentedBooksByStudent200JSONResponse struct {
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Results    *[]RentSummary  `json:"results,omitempty"`
+}
+
+func (response GetRentedBooksByStudent200JSONResponse) VisitGetRentedBooksByStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentedBooksByStudent400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response GetRentedBooksByStudent400ApplicationProblemPlusJSONResponse) VisitGetRentedBooksByStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentedBooksByStudent401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetRentedBooksByStudent401ApplicationProblemPlusJSONResponse) VisitGetRentedBooksByStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRentedBooksByStudent500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetRentedBooksByStudent500ApplicationProblemPlusJSONResponse) VisitGetRentedBooksByStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBooksRequestObject struct {
+	Body *ReturnBooksJSONRequestBody
+}
+
+type ReturnBooksResponseObject interface {
+	VisitReturnBooksResponse(w http.ResponseWriter) error
+}
+
+type ReturnBooks200JSONResponse struct {
+	CartId     *openapi_types.UUID `json:"cart_id,omitempty"`
+	Message    *string             `json:"message,omitempty"`
+	ReturnedAt *time.Time          `json:"returned_at,omitempty"`
+}
+
+func (response ReturnBooks200JSONResponse) VisitReturnBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBooks400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response ReturnBooks400ApplicationProblemPlusJSONResponse) VisitReturnBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBooks401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ReturnBooks401ApplicationProblemPlusJSONResponse) VisitReturnBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBooks404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ReturnBooks404ApplicationProblemPlusJSONResponse) VisitReturnBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBooks409ApplicationProblemPlusJSONResponse struct {
+	ConflictErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ReturnBooks409ApplicationProblemPlusJSONResponse) VisitReturnBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBooks500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ReturnBooks500ApplicationProblemPlusJSONResponse) VisitReturnBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReturnHistoryRequestObject struct {
+	Params ListReturnHistoryParams
+}
+
+type ListReturnHistoryResponseObject interface {
+	VisitListReturnHistoryResponse(w http.ResponseWriter) error
+}
+
+type ListReturnHistory200JSONResponse struct {
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Results    *[]ReturnRecord `json:"results,omitempty"`
+}
+
+func (response ListReturnHistory200JSONResponse) VisitListReturnHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReturnHistory400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
+
+func (response ListReturnHistory400ApplicationProblemPlusJSONResponse) VisitListReturnHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReturnHistory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListReturnHistory401ApplicationProblemPlusJSONResponse) VisitListReturnHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReturnHistory500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListReturnHistory500ApplicationProblemPlusJSONResponse) VisitListReturnHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAllStudentsRequestObject struct {
+	Params ListAllStudentsParams
+}
+
+type ListAllStudentsResponseObject interface {
+	VisitListAllStudentsResponse(w http.ResponseWriter) error
+}
+
+type ListAllStudents200JSONResponse struct {
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
+	Results    *[]Students     `json:"results,omitempty"`
+}
+
+func (response ListAllStudents200JSONResponse) VisitListAllStudentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+type ListAllStudents400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+func (response ListAllStudents400ApplicationProblemPlusJSONResponse) VisitListAllStudentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+type ListAllStudents401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+func (response ListAllStudents401ApplicationProblemPlusJSONResponse) VisitListAllStudentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+type ListAllStudents500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
 }
 
-type RequiredParamError struct {
-	ParamName string
+func (response ListAllStudents500ApplicationProblemPlusJSONResponse) VisitListAllStudentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+type AddStudentRequestObject struct {
+	Body *AddStudentJSONRequestBody
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+type AddStudentResponseObject interface {
+	VisitAddStudentResponse(w http.ResponseWriter) error
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+type AddStudent201ResponseHeaders struct {
+	Location string
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+type AddStudent201JSONResponse struct {
+	Body struct {
+		Message *string `json:"message,omitempty"`
+	}
+	Headers AddStudent201ResponseHeaders
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+func (response AddStudent201JSONResponse) VisitAddStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+type AddStudent400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+func (response AddStudent400ApplicationProblemPlusJSONResponse) VisitAddStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type AddStudent401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+func (response AddStudent401ApplicationProblemPlusJSONResponse) VisitAddStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type AddStudent409ApplicationProblemPlusJSONResponse struct {
+	ConflictErrorApplicationProblemPlusJSONResponse
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+func (response AddStudent409ApplicationProblemPlusJSONResponse) VisitAddStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+type AddStudent500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+func (response AddStudent500ApplicationProblemPlusJSONResponse) VisitAddStudentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type PrintStudentLabelsRequestObject struct {
+	Body *PrintStudentLabelsJSONRequestBody
+}
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+type PrintStudentLabelsResponseObject interface {
+	VisitPrintStudentLabelsResponse(w http.ResponseWriter) error
+}
+
+type PrintStudentLabels200ApplicationpdfResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response PrintStudentLabels200ApplicationpdfResponse) VisitPrintStudentLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
 	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/books", wrapper.ListOrSearchBooks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/books", wrapper.AddBook)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/books/{id}", wrapper.DeleteBookById)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/librarian", wrapper.Librarian)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/login", wrapper.Login)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/logout", wrapper.Logout)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/openapi.json", wrapper.GetOpenAPISpec)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/overdues", wrapper.ListOverdueRentals)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/rents", wrapper.ListRents)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/rents", wrapper.CreateRentTransaction)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/reports", wrapper.GetRentalReports)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/returns", wrapper.GetRentedBooksByStudent)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/returns", wrapper.ReturnBooks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/students", wrapper.ListAllStudents)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/students", wrapper.AddStudent)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/students/{id}", wrapper.DeleteStudentById)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/students/{id}", wrapper.GetStudentById)
-	})
+type PrintStudentLabels400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response PrintStudentLabels400ApplicationProblemPlusJSONResponse) VisitPrintStudentLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrintStudentLabels401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PrintStudentLabels401ApplicationProblemPlusJSONResponse) VisitPrintStudentLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrintStudentLabels404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PrintStudentLabels404ApplicationProblemPlusJSONResponse) VisitPrintStudentLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrintStudentLabels500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PrintStudentLabels500ApplicationProblemPlusJSONResponse) VisitPrintStudentLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStudentByIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteStudentByIdResponseObject interface {
+	VisitDeleteStudentByIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteStudentById201Response struct {
+}
+
+func (response DeleteStudentById201Response) VisitDeleteStudentByIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type DeleteStudentById401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteStudentById401ApplicationProblemPlusJSONResponse) VisitDeleteStudentByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStudentById404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteStudentById404ApplicationProblemPlusJSONResponse) VisitDeleteStudentByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteStudentById500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteStudentById500ApplicationProblemPlusJSONResponse) VisitDeleteStudentByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStudentByIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetStudentByIdResponseObject interface {
+	VisitGetStudentByIdResponse(w http.ResponseWriter) error
+}
+
+type GetStudentById200JSONResponse Students
+
+func (response GetStudentById200JSONResponse) VisitGetStudentByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStudentById401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetStudentById401ApplicationProblemPlusJSONResponse) VisitGetStudentByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
-	return r
+	return json.NewEncoder(w).Encode(response)
 }
 
-type InternalServerErrorJSONResponse Error
+type GetStudentById404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
 
-type InvalidRequestBodyJSONResponse Error
+func (response GetStudentById404ApplicationProblemPlusJSONResponse) VisitGetStudentByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
-type InvalidRequestParametersJSONResponse Error
+	return json.NewEncoder(w).Encode(response)
+}
 
-type UnauthorizedErrorJSONResponse Error
+type GetStudentById500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
 
-type ListOrSearchBooksRequestObject struct {
-	Params ListOrSearchBooksParams
+func (response GetStudentById500ApplicationProblemPlusJSONResponse) VisitGetStudentByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListOrSearchBooksResponseObject interface {
-	VisitListOrSearchBooksResponse(w http.ResponseWriter) error
+type GetStudentBarcodeRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetStudentBarcodeParams
 }
 
-type ListOrSearchBooks200JSONResponse struct {
-	Pagination *PaginationInfo `json:"pagination,omitempty"`
-	Results    *[]Books        `json:"results,omitempty"`
+type GetStudentBarcodeResponseObject interface {
+	VisitGetStudentBarcodeResponse(w http.ResponseWriter) error
 }
 
-func (response ListOrSearchBooks200JSONResponse) VisitListOrSearchBooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+type GetStudentBarcode200ImagepngResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetStudentBarcode200ImagepngResponse) VisitGetStudentBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type ListOrSearchBooks400JSONResponse struct {
-	InvalidRequestParametersJSONResponse
+type GetStudentBarcode400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
 }
 
-func (response ListOrSearchBooks400JSONResponse) VisitListOrSearchBooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetStudentBarcode400ApplicationProblemPlusJSONResponse) VisitGetStudentBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListOrSearchBooks401JSONResponse struct{ UnauthorizedErrorJSONResponse }
+type GetStudentBarcode401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
 
-func (response ListOrSearchBooks401JSONResponse) VisitListOrSearchBooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetStudentBarcode401ApplicationProblemPlusJSONResponse) VisitGetStudentBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListOrSearchBooks500JSONResponse struct {
-	InternalServerErrorJSONResponse
+type GetStudentBarcode404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
 }
 
-func (response ListOrSearchBooks500JSONResponse) VisitListOrSearchBooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func (response GetStudentBarcode404ApplicationProblemPlusJSONResponse) VisitGetStudentBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddBookRequestObject struct {
-	Body *AddBookJSONRequestBody
+type GetStudentBarcode500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
 }
 
-type AddBookResponseObject interface {
-	VisitAddBookResponse(w http.ResponseWriter) error
+func (response GetStudentBarcode500ApplicationProblemPlusJSONResponse) VisitGetStudentBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AddBook201ResponseHeaders struct {
-	Location string
+type GetStudentHistoryRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetStudentHistoryParams
 }
 
-type AddBook201JSONResponse struct {
-	Body struct {
-		Message *string `json:"message,omitempty"`
-	}
-	Headers AddBook201ResponseHeaders
+type GetStudentHistoryResponseObject interface {
+	VisitGetStudentHistoryResponse(w http.ResponseWriter) error
 }
 
-func (response AddBook201JSONResponse) VisitAddBookResponse(w http.ResponseWriter) error {
+type GetStudentHistory200JSONResponse struct {
+	Pagination *PaginationInfo  `json:"pagination,omitempty"`
+	Results    *[]StudentRental `json:"results,omitempty"`
+}
+
+func (response GetStudentHistory200JSONResponse) VisitGetStudentHistoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AddBook400JSONResponse struct{ InvalidRequestBodyJSONResponse }
+type GetStudentHistory400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
 
-func (response AddBook400JSONResponse) VisitAddBookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetStudentHistory400ApplicationProblemPlusJSONResponse) VisitGetStudentHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddBook401JSONResponse struct{ UnauthorizedErrorJSONResponse }
+type GetStudentHistory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
 
-func (response AddBook401JSONResponse) VisitAddBookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetStudentHistory401ApplicationProblemPlusJSONResponse) VisitGetStudentHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddBook500JSONResponse struct {
-	InternalServerErrorJSONResponse
+type GetStudentHistory404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
 }
 
-func (response AddBook500JSONResponse) VisitAddBookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetStudentHistory404ApplicationProblemPlusJSONResponse) VisitGetStudentHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStudentHistory500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetStudentHistory500ApplicationProblemPlusJSONResponse) VisitGetStudentHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteBookByIdRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type SetStudentPinRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *SetStudentPinJSONRequestBody
 }
 
-type DeleteBookByIdResponseObject interface {
-	VisitDeleteBookByIdResponse(w http.ResponseWriter) error
+type SetStudentPinResponseObject interface {
+	VisitSetStudentPinResponse(w http.ResponseWriter) error
 }
 
-type DeleteBookById201Response struct {
+type SetStudentPin204Response struct {
 }
 
-func (response DeleteBookById201Response) VisitDeleteBookByIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
+func (response SetStudentPin204Response) VisitSetStudentPinResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
 	return nil
 }
 
-type DeleteBookById401JSONResponse struct{ UnauthorizedErrorJSONResponse }
+type SetStudentPin400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestBodyApplicationProblemPlusJSONResponse
+}
+
+func (response SetStudentPin400ApplicationProblemPlusJSONResponse) VisitSetStudentPinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-func (response DeleteBookById401JSONResponse) VisitDeleteBookByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetStudentPin401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response SetStudentPin401ApplicationProblemPlusJSONResponse) VisitSetStudentPinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteBookById404JSONResponse struct {
-	Message *string `json:"message,omitempty"`
+type SetStudentPin404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
 }
 
-func (response DeleteBookById404JSONResponse) VisitDeleteBookByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response SetStudentPin404ApplicationProblemPlusJSONResponse) VisitSetStudentPinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteBookById500JSONResponse struct {
-	InternalServerErrorJSONResponse
+type SetStudentPin500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
 }
 
-func (response DeleteBookById500JSONResponse) VisitDeleteBookByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response SetStudentPin500ApplicationProblemPlusJSONResponse) VisitSetStudentPinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LibrarianRequestObject struct {
+type GetStudentSummaryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type LibrarianResponseObject interface {
-	VisitLibrarianResponse(w http.ResponseWriter) error
+type GetStudentSummaryResponseObject interface {
+	VisitGetStudentSummaryResponse(w http.ResponseWriter) error
 }
 
-type Librarian200JSONResponse struct {
-	LibrarianId *openapi_types.UUID `json:"librarian_id,omitempty"`
-	Message     *string             `json:"message,omitempty"`
-}
+type GetStudentSummary200JSONResponse StudentSummary
 
-func (response Librarian200JSONResponse) VisitLibrarianResponse(w http.ResponseWriter) error {
+func (response GetStudentSummary200JSONResponse) VisitGetStudentSummaryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type Librarian401JSONResponse Error
+type GetStudentSummary400ApplicationProblemPlusJSONResponse struct {
+	InvalidRequestParametersApplicationProblemPlusJSONResponse
+}
 
-func (response Librarian401JSONResponse) VisitLibrarianResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetStudentSummary400ApplicationProblemPlusJSONResponse) VisitGetStudentSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStudentSummary401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetStudentSummary401ApplicationProblemPlusJSONResponse) VisitGetStudentSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStudentSummary404ApplicationProblemPlusJSONResponse struct {
+	NotFoundErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetStudentSummary404ApplicationProblemPlusJSONResponse) VisitGetStudentSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStudentSummary500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetStudentSummary500ApplicationProblemPlusJSONResponse) VisitGetStudentSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersionRequestObject struct {
+}
+
+type GetVersionResponseObject interface {
+	VisitGetVersionResponse(w http.ResponseWriter) error
+}
+
+type GetVersion200JSONResponse VersionInfo
+
+func (response GetVersion200JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List or search books (newest first unless sorted)
+	// (GET /books)
+	ListOrSearchBooks(ctx context.Context, request ListOrSearchBooksRequestObject) (ListOrSearchBooksResponseObject, error)
+	// Add a new book
+	// (POST /books)
+	AddBook(ctx context.Context, request AddBookRequestObject) (AddBookResponseObject, error)
+	// Print book labels
+	// (POST /books/labels)
+	PrintBookLabels(ctx context.Context, request PrintBookLabelsRequestObject) (PrintBookLabelsResponseObject, error)
+	// List book tags
+	// (GET /books/tags)
+	ListBookTags(ctx context.Context, request ListBookTagsRequestObject) (ListBookTagsResponseObject, error)
+	// Delete a Book by ID
+	// (DELETE /books/{id})
+	DeleteBookById(ctx context.Context, request DeleteBookByIdRequestObject) (DeleteBookByIdResponseObject, error)
+	// Get the barcode of a book
+	// (GET /books/{id}/barcode)
+	GetBookBarcode(ctx context.Context, request GetBookBarcodeRequestObject) (GetBookBarcodeResponseObject, error)
+	// Classify a book
+	// (PUT /books/{id}/classification)
+	ClassifyBook(ctx context.Context, request ClassifyBookRequestObject) (ClassifyBookResponseObject, error)
+	// Browse categories
+	// (GET /categories)
+	ListCategories(ctx context.Context, request ListCategoriesRequestObject) (ListCategoriesResponseObject, error)
+	// Add a category
+	// (POST /categories)
+	CreateCategory(ctx context.Context, request CreateCategoryRequestObject) (CreateCategoryResponseObject, error)
+	// Delete a category
+	// (DELETE /categories/{id})
+	DeleteCategory(ctx context.Context, request DeleteCategoryRequestObject) (DeleteCategoryResponseObject, error)
+	// Browse a category
+	// (GET /categories/{id})
+	GetCategory(ctx context.Context, request GetCategoryRequestObject) (GetCategoryResponseObject, error)
+	// Rename or move a category
+	// (PUT /categories/{id})
+	UpdateCategory(ctx context.Context, request UpdateCategoryRequestObject) (UpdateCategoryResponseObject, error)
+	// Get CSRF token
+	// (GET /csrf)
+	GetCSRFToken(ctx context.Context, request GetCSRFTokenRequestObject) (GetCSRFTokenResponseObject, error)
+	// Liveness probe
+	// (GET /healthz)
+	GetHealthz(ctx context.Context, request GetHealthzRequestObject) (GetHealthzResponseObject, error)
+	// Check out books at a kiosk
+	// (POST /kiosk/checkout)
+	KioskCheckout(ctx context.Context, request KioskCheckoutRequestObject) (KioskCheckoutResponseObject, error)
+	// Identify a student at a kiosk
+	// (POST /kiosk/identify)
+	KioskIdentify(ctx context.Context, request KioskIdentifyRequestObject) (KioskIdentifyResponseObject, error)
+	// Return books at a kiosk
+	// (POST /kiosk/return)
+	KioskReturn(ctx context.Context, request KioskReturnRequestObject) (KioskReturnResponseObject, error)
+	// List kiosks
+	// (GET /kiosks)
+	ListKiosks(ctx context.Context, request ListKiosksRequestObject) (ListKiosksResponseObject, error)
+	// Register a kiosk
+	// (POST /kiosks)
+	RegisterKiosk(ctx context.Context, request RegisterKioskRequestObject) (RegisterKioskResponseObject, error)
+	// Revoke a kiosk
+	// (DELETE /kiosks/{id})
+	RevokeKiosk(ctx context.Context, request RevokeKioskRequestObject) (RevokeKioskResponseObject, error)
+	// List the transactions of a kiosk
+	// (GET /kiosks/{id}/transactions)
+	ListKioskTransactions(ctx context.Context, request ListKioskTransactionsRequestObject) (ListKioskTransactionsResponseObject, error)
+	// Librarian profile
+	// (GET /librarian)
+	Librarian(ctx context.Context, request LibrarianRequestObject) (LibrarianResponseObject, error)
+	// Librarian login
+	// (POST /login)
+	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// Librarian single sign-on
+	// (GET /login/oidc)
+	OIDCLogin(ctx context.Context, request OIDCLoginRequestObject) (OIDCLoginResponseObject, error)
+	// Librarian single sign-on callback
+	// (GET /login/oidc/callback)
+	OIDCCallback(ctx context.Context, request OIDCCallbackRequestObject) (OIDCCallbackResponseObject, error)
+	// Logout librarian
+	// (POST /logout)
+	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
+	// Get this OpenAPI spec
+	// (GET /openapi.json)
+	GetOpenAPISpec(ctx context.Context, request GetOpenAPISpecRequestObject) (GetOpenAPISpecResponseObject, error)
+	// Get overdue rentals
+	// (GET /overdues)
+	ListOverdueRentals(ctx context.Context, request ListOverdueRentalsRequestObject) (ListOverdueRentalsResponseObject, error)
+	// Get rental history
+	// (GET /portal/history)
+	GetPortalHistory(ctx context.Context, request GetPortalHistoryRequestObject) (GetPortalHistoryResponseObject, error)
+	// List current loans
+	// (GET /portal/loans)
+	ListPortalLoans(ctx context.Context, request ListPortalLoansRequestObject) (ListPortalLoansResponseObject, error)
+	// Student login
+	// (POST /portal/login)
+	PortalLogin(ctx context.Context, request PortalLoginRequestObject) (PortalLoginResponseObject, error)
+	// Email a sign-in link
+	// (POST /portal/login/link)
+	SendPortalLoginLink(ctx context.Context, request SendPortalLoginLinkRequestObject) (SendPortalLoginLinkResponseObject, error)
+	// Student login with a sign-in link
+	// (POST /portal/login/token)
+	PortalLoginWithToken(ctx context.Context, request PortalLoginWithTokenRequestObject) (PortalLoginWithTokenResponseObject, error)
+	// Student logout
+	// (POST /portal/logout)
+	PortalLogout(ctx context.Context, request PortalLogoutRequestObject) (PortalLogoutResponseObject, error)
+	// Get the signed-in student
+	// (GET /portal/me)
+	GetPortalStudent(ctx context.Context, request GetPortalStudentRequestObject) (GetPortalStudentResponseObject, error)
+	// Update contact details
+	// (PUT /portal/me/contact)
+	UpdatePortalContact(ctx context.Context, request UpdatePortalContactRequestObject) (UpdatePortalContactResponseObject, error)
+	// Readiness probe
+	// (GET /readyz)
+	GetReadyz(ctx context.Context, request GetReadyzRequestObject) (GetReadyzResponseObject, error)
+	// Get list of all rents with optional filters
+	// (GET /rents)
+	ListRents(ctx context.Context, request ListRentsRequestObject) (ListRentsResponseObject, error)
+	// Create rental transaction
+	// (POST /rents)
+	CreateRentTransaction(ctx context.Context, request CreateRentTransactionRequestObject) (CreateRentTransactionResponseObject, error)
+	// Print a receipt
+	// (GET /rents/{cart_id}/receipt)
+	GetRentReceipt(ctx context.Context, request GetRentReceiptRequestObject) (GetRentReceiptResponseObject, error)
+	// Get rental report
+	// (GET /reports)
+	GetRentalReports(ctx context.Context, request GetRentalReportsRequestObject) (GetRentalReportsResponseObject, error)
+	// Get circulation analytics
+	// (GET /reports/analytics)
+	GetCirculationAnalytics(ctx context.Context, request GetCirculationAnalyticsRequestObject) (GetCirculationAnalyticsResponseObject, error)
+	// Get collection report
+	// (GET /reports/collection)
+	GetCollectionReport(ctx context.Context, request GetCollectionReportRequestObject) (GetCollectionReportResponseObject, error)
+	// Download a report file
+	// (GET /reports/runs/{id}/files/{format})
+	DownloadReportFile(ctx context.Context, request DownloadReportFileRequestObject) (DownloadReportFileResponseObject, error)
+	// List saved reports
+	// (GET /reports/schedules)
+	ListReportSchedules(ctx context.Context, request ListReportSchedulesRequestObject) (ListReportSchedulesResponseObject, error)
+	// Save a report
+	// (POST /reports/schedules)
+	CreateReportSchedule(ctx context.Context, request CreateReportScheduleRequestObject) (CreateReportScheduleResponseObject, error)
+	// Delete a saved report
+	// (DELETE /reports/schedules/{id})
+	DeleteReportSchedule(ctx context.Context, request DeleteReportScheduleRequestObject) (DeleteReportScheduleResponseObject, error)
+	// Get a saved report
+	// (GET /reports/schedules/{id})
+	GetReportSchedule(ctx context.Context, request GetReportScheduleRequestObject) (GetReportScheduleResponseObject, error)
+	// Replace a saved report
+	// (PUT /reports/schedules/{id})
+	UpdateReportSchedule(ctx context.Context, request UpdateReportScheduleRequestObject) (UpdateReportScheduleResponseObject, error)
+	// Run a saved report now
+	// (POST /reports/schedules/{id}/run)
+	RunReportSchedule(ctx context.Context, request RunReportScheduleRequestObject) (RunReportScheduleResponseObject, error)
+	// List the runs of a saved report
+	// (GET /reports/schedules/{id}/runs)
+	ListReportRuns(ctx context.Context, request ListReportRunsRequestObject) (ListReportRunsResponseObject, error)
+	// List books currently rented by a student
+	// (GET /returns)
+	GetRentedBooksByStudent(ctx context.Context, request GetRentedBooksByStudentRequestObject) (GetRentedBooksByStudentResponseObject, error)
+	// Mark a cart as returned
+	// (PUT /returns)
+	ReturnBooks(ctx context.Context, request ReturnBooksRequestObject) (ReturnBooksResponseObject, error)
+	// List completed returns
+	// (GET /returns/history)
+	ListReturnHistory(ctx context.Context, request ListReturnHistoryRequestObject) (ListReturnHistoryResponseObject, error)
+	// List all students
+	// (GET /students)
+	ListAllStudents(ctx context.Context, request ListAllStudentsRequestObject) (ListAllStudentsResponseObject, error)
+	// Register a new student
+	// (POST /students)
+	AddStudent(ctx context.Context, request AddStudentRequestObject) (AddStudentResponseObject, error)
+	// Print student card labels
+	// (POST /students/labels)
+	PrintStudentLabels(ctx context.Context, request PrintStudentLabelsRequestObject) (PrintStudentLabelsResponseObject, error)
+	// Delete a Student by ID
+	// (DELETE /students/{id})
+	DeleteStudentById(ctx context.Context, request DeleteStudentByIdRequestObject) (DeleteStudentByIdResponseObject, error)
+	// Get a Student by ID
+	// (GET /students/{id})
+	GetStudentById(ctx context.Context, request GetStudentByIdRequestObject) (GetStudentByIdResponseObject, error)
+	// Get the barcode of a student card
+	// (GET /students/{id}/barcode)
+	GetStudentBarcode(ctx context.Context, request GetStudentBarcodeRequestObject) (GetStudentBarcodeResponseObject, error)
+	// Get a student's borrowing history
+	// (GET /students/{id}/history)
+	GetStudentHistory(ctx context.Context, request GetStudentHistoryRequestObject) (GetStudentHistoryResponseObject, error)
+	// Set a student's portal PIN
+	// (PUT /students/{id}/pin)
+	SetStudentPin(ctx context.Context, request SetStudentPinRequestObject) (SetStudentPinResponseObject, error)
+	// Get a student's profile summary
+	// (GET /students/{id}/summary)
+	GetStudentSummary(ctx context.Context, request GetStudentSummaryRequestObject) (GetStudentSummaryResponseObject, error)
+	// Get build information
+	// (GET /version)
+	GetVersion(ctx context.Context, request GetVersionRequestObject) (GetVersionResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListOrSearchBooks operation middleware
+func (sh *strictHandler) ListOrSearchBooks(w http.ResponseWriter, r *http.Request, params ListOrSearchBooksParams) {
+	var request ListOrSearchBooksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrSearchBooks(ctx, request.(ListOrSearchBooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrSearchBooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOrSearchBooksResponseObject); ok {
+		if err := validResponse.VisitListOrSearchBooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddBook operation middleware
+func (sh *strictHandler) AddBook(w http.ResponseWriter, r *http.Request) {
+	var request AddBookRequestObject
+
+	var body AddBookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddBook(ctx, request.(AddBookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddBook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-type Librarian500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddBookResponseObject); ok {
+		if err := validResponse.VisitAddBookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response Librarian500JSONResponse) VisitLibrarianResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PrintBookLabels operation middleware
+func (sh *strictHandler) PrintBookLabels(w http.ResponseWriter, r *http.Request) {
+	var request PrintBookLabelsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PrintBookLabelsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type LoginRequestObject struct {
-	Body *LoginJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PrintBookLabels(ctx, request.(PrintBookLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PrintBookLabels")
+	}
 
-type LoginResponseObject interface {
-	VisitLoginResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type Login200ResponseHeaders struct {
-	SetCookie string
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PrintBookLabelsResponseObject); ok {
+		if err := validResponse.VisitPrintBookLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Login200JSONResponse struct {
-	Body struct {
-		LibrarianId *openapi_types.UUID `json:"librarian_id,omitempty"`
-		Message     *string             `json:"message,omitempty"`
+// ListBookTags operation middleware
+func (sh *strictHandler) ListBookTags(w http.ResponseWriter, r *http.Request) {
+	var request ListBookTagsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBookTags(ctx, request.(ListBookTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBookTags")
 	}
-	Headers Login200ResponseHeaders
-}
 
-func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListBookTagsResponseObject); ok {
+		if err := validResponse.VisitListBookTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Login401JSONResponse Error
-
-func (response Login401JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// DeleteBookById operation middleware
+func (sh *strictHandler) DeleteBookById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteBookByIdRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type Login500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBookById(ctx, request.(DeleteBookByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBookById")
+	}
 
-func (response Login500JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteBookByIdResponseObject); ok {
+		if err := validResponse.VisitDeleteBookByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type LogoutRequestObject struct {
-}
+// GetBookBarcode operation middleware
+func (sh *strictHandler) GetBookBarcode(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBookBarcodeParams) {
+	var request GetBookBarcodeRequestObject
 
-type LogoutResponseObject interface {
-	VisitLogoutResponse(w http.ResponseWriter) error
-}
+	request.Id = id
+	request.Params = params
 
-type Logout200JSONResponse struct {
-	Message *string `json:"message,omitempty"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBookBarcode(ctx, request.(GetBookBarcodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBookBarcode")
+	}
 
-func (response Logout200JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBookBarcodeResponseObject); ok {
+		if err := validResponse.VisitGetBookBarcodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Logout500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// ClassifyBook operation middleware
+func (sh *strictHandler) ClassifyBook(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ClassifyBookRequestObject
 
-func (response Logout500JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ClassifyBookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetOpenAPISpecRequestObject struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ClassifyBook(ctx, request.(ClassifyBookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClassifyBook")
+	}
 
-type GetOpenAPISpecResponseObject interface {
-	VisitGetOpenAPISpecResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ClassifyBookResponseObject); ok {
+		if err := validResponse.VisitClassifyBookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetOpenAPISpec200JSONResponse json.RawMessage
+// ListCategories operation middleware
+func (sh *strictHandler) ListCategories(w http.ResponseWriter, r *http.Request) {
+	var request ListCategoriesRequestObject
 
-func (response GetOpenAPISpec200JSONResponse) VisitGetOpenAPISpecResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCategories(ctx, request.(ListCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCategories")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetOpenAPISpecdefaultJSONResponse struct {
-	Body       Error
-	StatusCode int
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCategoriesResponseObject); ok {
+		if err := validResponse.VisitListCategoriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetOpenAPISpecdefaultJSONResponse) VisitGetOpenAPISpecResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+// CreateCategory operation middleware
+func (sh *strictHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	var request CreateCategoryRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	var body CreateCategoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ListOverdueRentalsRequestObject struct {
-	Params ListOverdueRentalsParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCategory(ctx, request.(CreateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCategory")
+	}
 
-type ListOverdueRentalsResponseObject interface {
-	VisitListOverdueRentalsResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListOverdueRentals200JSONResponse struct {
-	Pagination *PaginationInfo `json:"pagination,omitempty"`
-	Results    *[]OverdueUser  `json:"results,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCategoryResponseObject); ok {
+		if err := validResponse.VisitCreateCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ListOverdueRentals200JSONResponse) VisitListOverdueRentalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeleteCategory operation middleware
+func (sh *strictHandler) DeleteCategory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteCategoryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type ListOverdueRentals400JSONResponse struct {
-	InvalidRequestParametersJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCategory(ctx, request.(DeleteCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCategory")
+	}
 
-func (response ListOverdueRentals400JSONResponse) VisitListOverdueRentalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCategoryResponseObject); ok {
+		if err := validResponse.VisitDeleteCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListOverdueRentals401JSONResponse struct{ UnauthorizedErrorJSONResponse }
-
-func (response ListOverdueRentals401JSONResponse) VisitListOverdueRentalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetCategory operation middleware
+func (sh *strictHandler) GetCategory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetCategoryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type ListOverdueRentals500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategory(ctx, request.(GetCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategory")
+	}
 
-func (response ListOverdueRentals500JSONResponse) VisitListOverdueRentalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCategoryResponseObject); ok {
+		if err := validResponse.VisitGetCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListRentsRequestObject struct {
-	Params ListRentsParams
-}
+// UpdateCategory operation middleware
+func (sh *strictHandler) UpdateCategory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateCategoryRequestObject
 
-type ListRentsResponseObject interface {
-	VisitListRentsResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type ListRents200JSONResponse struct {
-	Pagination *PaginationInfo `json:"pagination,omitempty"`
-	Results    *[]RentSummary  `json:"results,omitempty"`
-}
+	var body UpdateCategoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response ListRents200JSONResponse) VisitListRentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCategory(ctx, request.(UpdateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCategory")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListRents400JSONResponse struct {
-	InvalidRequestParametersJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCategoryResponseObject); ok {
+		if err := validResponse.VisitUpdateCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ListRents400JSONResponse) VisitListRentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetCSRFToken operation middleware
+func (sh *strictHandler) GetCSRFToken(w http.ResponseWriter, r *http.Request) {
+	var request GetCSRFTokenRequestObject
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCSRFToken(ctx, request.(GetCSRFTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCSRFToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCSRFTokenResponseObject); ok {
+		if err := validResponse.VisitGetCSRFTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListRents401JSONResponse struct{ UnauthorizedErrorJSONResponse }
+// GetHealthz operation middleware
+func (sh *strictHandler) GetHealthz(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthzRequestObject
 
-func (response ListRents401JSONResponse) VisitListRentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealthz(ctx, request.(GetHealthzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealthz")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListRents500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthzResponseObject); ok {
+		if err := validResponse.VisitGetHealthzResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ListRents500JSONResponse) VisitListRentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// KioskCheckout operation middleware
+func (sh *strictHandler) KioskCheckout(w http.ResponseWriter, r *http.Request) {
+	var request KioskCheckoutRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body KioskCheckoutJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CreateRentTransactionRequestObject struct {
-	Body *CreateRentTransactionJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.KioskCheckout(ctx, request.(KioskCheckoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "KioskCheckout")
+	}
 
-type CreateRentTransactionResponseObject interface {
-	VisitCreateRentTransactionResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateRentTransaction201JSONResponse struct {
-	CartId  *openapi_types.UUID `json:"cart_id,omitempty"`
-	Message *string             `json:"message,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(KioskCheckoutResponseObject); ok {
+		if err := validResponse.VisitKioskCheckoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response CreateRentTransaction201JSONResponse) VisitCreateRentTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// KioskIdentify operation middleware
+func (sh *strictHandler) KioskIdentify(w http.ResponseWriter, r *http.Request) {
+	var request KioskIdentifyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body KioskIdentifyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type CreateRentTransaction400JSONResponse struct{ InvalidRequestBodyJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.KioskIdentify(ctx, request.(KioskIdentifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "KioskIdentify")
+	}
 
-func (response CreateRentTransaction400JSONResponse) VisitCreateRentTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(KioskIdentifyResponseObject); ok {
+		if err := validResponse.VisitKioskIdentifyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateRentTransaction401JSONResponse struct{ UnauthorizedErrorJSONResponse }
+// KioskReturn operation middleware
+func (sh *strictHandler) KioskReturn(w http.ResponseWriter, r *http.Request) {
+	var request KioskReturnRequestObject
 
-func (response CreateRentTransaction401JSONResponse) VisitCreateRentTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var body KioskReturnJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.KioskReturn(ctx, request.(KioskReturnRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "KioskReturn")
+	}
 
-type CreateRentTransaction500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(KioskReturnResponseObject); ok {
+		if err := validResponse.VisitKioskReturnResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response CreateRentTransaction500JSONResponse) VisitCreateRentTransactionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// ListKiosks operation middleware
+func (sh *strictHandler) ListKiosks(w http.ResponseWriter, r *http.Request) {
+	var request ListKiosksRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListKiosks(ctx, request.(ListKiosksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListKiosks")
+	}
 
-type GetRentalReportsRequestObject struct {
-	Params GetRentalReportsParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetRentalReportsResponseObject interface {
-	VisitGetRentalReportsResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListKiosksResponseObject); ok {
+		if err := validResponse.VisitListKiosksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetRentalReports200JSONResponse RentReport
+// RegisterKiosk operation middleware
+func (sh *strictHandler) RegisterKiosk(w http.ResponseWriter, r *http.Request) {
+	var request RegisterKioskRequestObject
 
-func (response GetRentalReports200JSONResponse) VisitGetRentalReportsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var body RegisterKioskJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RegisterKiosk(ctx, request.(RegisterKioskRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RegisterKiosk")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-type GetRentalReports400JSONResponse struct {
-	InvalidRequestParametersJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterKioskResponseObject); ok {
+		if err := validResponse.VisitRegisterKioskResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetRentalReports400JSONResponse) VisitGetRentalReportsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// RevokeKiosk operation middleware
+func (sh *strictHandler) RevokeKiosk(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RevokeKioskRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type GetRentalReports401JSONResponse struct{ UnauthorizedErrorJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeKiosk(ctx, request.(RevokeKioskRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeKiosk")
+	}
 
-func (response GetRentalReports401JSONResponse) VisitGetRentalReportsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeKioskResponseObject); ok {
+		if err := validResponse.VisitRevokeKioskResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetRentalReports500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// ListKioskTransactions operation middleware
+func (sh *strictHandler) ListKioskTransactions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListKioskTransactionsParams) {
+	var request ListKioskTransactionsRequestObject
 
-func (response GetRentalReports500JSONResponse) VisitGetRentalReportsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListKioskTransactions(ctx, request.(ListKioskTransactionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListKioskTransactions")
+	}
 
-type GetRentedBooksByStudentRequestObject struct {
-	Params GetRentedBooksByStudentParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetRentedBooksByStudentResponseObject interface {
-	VisitGetRentedBooksByStudentResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListKioskTransactionsResponseObject); ok {
+		if err := validResponse.VisitListKioskTransactionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetRentedBooksByStudent200JSONResponse struct {
-	Pagination *PaginationInfo `json:"pagination,omitempty"`
-	Results    *[]RentSummary  `json:"results,omitempty"`
-}
+// Librarian operation middleware
+func (sh *strictHandler) Librarian(w http.ResponseWriter, r *http.Request) {
+	var request LibrarianRequestObject
 
-func (response GetRentedBooksByStudent200JSONResponse) VisitGetRentedBooksByStudentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Librarian(ctx, request.(LibrarianRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Librarian")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetRentedBooksByStudent400JSONResponse struct {
-	InvalidRequestParametersJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LibrarianResponseObject); ok {
+		if err := validResponse.VisitLibrarianResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetRentedBooksByStudent400JSONResponse) VisitGetRentedBooksByStudentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// Login operation middleware
+func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var request LoginRequestObject
 
-type GetRentedBooksByStudent401JSONResponse struct{ UnauthorizedErrorJSONResponse }
+	var body LoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response GetRentedBooksByStudent401JSONResponse) VisitGetRentedBooksByStudentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetRentedBooksByStudent500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetRentedBooksByStudent500JSONResponse) VisitGetRentedBooksByStudentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// OIDCLogin operation middleware
+func (sh *strictHandler) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+	var request OIDCLoginRequestObject
 
-type ReturnBooksRequestObject struct {
-	Body *ReturnBooksJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.OIDCLogin(ctx, request.(OIDCLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OIDCLogin")
+	}
 
-type ReturnBooksResponseObject interface {
-	VisitReturnBooksResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ReturnBooks200JSONResponse struct {
-	CartId  *openapi_types.UUID `json:"cart_id,omitempty"`
-	Message *string             `json:"message,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(OIDCLoginResponseObject); ok {
+		if err := validResponse.VisitOIDCLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ReturnBooks200JSONResponse) VisitReturnBooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// OIDCCallback operation middleware
+func (sh *strictHandler) OIDCCallback(w http.ResponseWriter, r *http.Request, params OIDCCallbackParams) {
+	var request OIDCCallbackRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type ReturnBooks400JSONResponse struct{ InvalidRequestBodyJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.OIDCCallback(ctx, request.(OIDCCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OIDCCallback")
+	}
 
-func (response ReturnBooks400JSONResponse) VisitReturnBooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(OIDCCallbackResponseObject); ok {
+		if err := validResponse.VisitOIDCCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ReturnBooks401JSONResponse struct{ UnauthorizedErrorJSONResponse }
+// Logout operation middleware
+func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var request LogoutRequestObject
 
-func (response ReturnBooks401JSONResponse) VisitReturnBooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Logout")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ReturnBooks500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LogoutResponseObject); ok {
+		if err := validResponse.VisitLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ReturnBooks500JSONResponse) VisitReturnBooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetOpenAPISpec operation middleware
+func (sh *strictHandler) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	var request GetOpenAPISpecRequestObject
 
-type ListAllStudentsRequestObject struct {
-	Params ListAllStudentsParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOpenAPISpec(ctx, request.(GetOpenAPISpecRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOpenAPISpec")
+	}
 
-type ListAllStudentsResponseObject interface {
-	VisitListAllStudentsResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListAllStudents200JSONResponse struct {
-	Pagination *PaginationInfo `json:"pagination,omitempty"`
-	Results    *[]Students     `json:"results,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOpenAPISpecResponseObject); ok {
+		if err := validResponse.VisitGetOpenAPISpecResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ListAllStudents200JSONResponse) VisitListAllStudentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListOverdueRentals operation middleware
+func (sh *strictHandler) ListOverdueRentals(w http.ResponseWriter, r *http.Request, params ListOverdueRentalsParams) {
+	var request ListOverdueRentalsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type ListAllStudents400JSONResponse struct {
-	InvalidRequestParametersJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOverdueRentals(ctx, request.(ListOverdueRentalsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOverdueRentals")
+	}
 
-func (response ListAllStudents400JSONResponse) VisitListAllStudentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOverdueRentalsResponseObject); ok {
+		if err := validResponse.VisitListOverdueRentalsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListAllStudents401JSONResponse struct{ UnauthorizedErrorJSONResponse }
-
-func (response ListAllStudents401JSONResponse) VisitListAllStudentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetPortalHistory operation middleware
+func (sh *strictHandler) GetPortalHistory(w http.ResponseWriter, r *http.Request, params GetPortalHistoryParams) {
+	var request GetPortalHistoryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type ListAllStudents500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPortalHistory(ctx, request.(GetPortalHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPortalHistory")
+	}
 
-func (response ListAllStudents500JSONResponse) VisitListAllStudentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPortalHistoryResponseObject); ok {
+		if err := validResponse.VisitGetPortalHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AddStudentRequestObject struct {
-	Body *AddStudentJSONRequestBody
-}
+// ListPortalLoans operation middleware
+func (sh *strictHandler) ListPortalLoans(w http.ResponseWriter, r *http.Request) {
+	var request ListPortalLoansRequestObject
 
-type AddStudentResponseObject interface {
-	VisitAddStudentResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPortalLoans(ctx, request.(ListPortalLoansRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPortalLoans")
+	}
 
-type AddStudent201ResponseHeaders struct {
-	Location string
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type AddStudent201JSONResponse struct {
-	Body struct {
-		Message *string `json:"message,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPortalLoansResponseObject); ok {
+		if err := validResponse.VisitListPortalLoansResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	Headers AddStudent201ResponseHeaders
 }
 
-func (response AddStudent201JSONResponse) VisitAddStudentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(201)
+// PortalLogin operation middleware
+func (sh *strictHandler) PortalLogin(w http.ResponseWriter, r *http.Request) {
+	var request PortalLoginRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	var body PortalLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type AddStudent400JSONResponse struct{ InvalidRequestBodyJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PortalLogin(ctx, request.(PortalLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PortalLogin")
+	}
 
-func (response AddStudent400JSONResponse) VisitAddStudentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PortalLoginResponseObject); ok {
+		if err := validResponse.VisitPortalLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AddStudent401JSONResponse struct{ UnauthorizedErrorJSONResponse }
-
-func (response AddStudent401JSONResponse) VisitAddStudentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// SendPortalLoginLink operation middleware
+func (sh *strictHandler) SendPortalLoginLink(w http.ResponseWriter, r *http.Request) {
+	var request SendPortalLoginLinkRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body SendPortalLoginLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type AddStudent500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SendPortalLoginLink(ctx, request.(SendPortalLoginLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SendPortalLoginLink")
+	}
 
-func (response AddStudent500JSONResponse) VisitAddStudentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SendPortalLoginLinkResponseObject); ok {
+		if err := validResponse.VisitSendPortalLoginLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteStudentByIdRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+// PortalLoginWithToken operation middleware
+func (sh *strictHandler) PortalLoginWithToken(w http.ResponseWriter, r *http.Request) {
+	var request PortalLoginWithTokenRequestObject
+
+	var body PortalLoginWithTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type DeleteStudentByIdResponseObject interface {
-	VisitDeleteStudentByIdResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PortalLoginWithToken(ctx, request.(PortalLoginWithTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PortalLoginWithToken")
+	}
 
-type DeleteStudentById201Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response DeleteStudentById201Response) VisitDeleteStudentByIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PortalLoginWithTokenResponseObject); ok {
+		if err := validResponse.VisitPortalLoginWithTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteStudentById401JSONResponse struct{ UnauthorizedErrorJSONResponse }
+// PortalLogout operation middleware
+func (sh *strictHandler) PortalLogout(w http.ResponseWriter, r *http.Request) {
+	var request PortalLogoutRequestObject
 
-func (response DeleteStudentById401JSONResponse) VisitDeleteStudentByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PortalLogout(ctx, request.(PortalLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PortalLogout")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteStudentById404JSONResponse struct {
-	Message *string `json:"message,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PortalLogoutResponseObject); ok {
+		if err := validResponse.VisitPortalLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response DeleteStudentById404JSONResponse) VisitDeleteStudentByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetPortalStudent operation middleware
+func (sh *strictHandler) GetPortalStudent(w http.ResponseWriter, r *http.Request) {
+	var request GetPortalStudentRequestObject
 
-type DeleteStudentById500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPortalStudent(ctx, request.(GetPortalStudentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPortalStudent")
+	}
 
-func (response DeleteStudentById500JSONResponse) VisitDeleteStudentByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPortalStudentResponseObject); ok {
+		if err := validResponse.VisitGetPortalStudentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStudentByIdRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+// UpdatePortalContact operation middleware
+func (sh *strictHandler) UpdatePortalContact(w http.ResponseWriter, r *http.Request) {
+	var request UpdatePortalContactRequestObject
 
-type GetStudentByIdResponseObject interface {
-	VisitGetStudentByIdResponse(w http.ResponseWriter) error
-}
+	var body UpdatePortalContactJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetStudentById200JSONResponse Students
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePortalContact(ctx, request.(UpdatePortalContactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePortalContact")
+	}
 
-func (response GetStudentById200JSONResponse) VisitGetStudentByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdatePortalContactResponseObject); ok {
+		if err := validResponse.VisitUpdatePortalContactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStudentById401JSONResponse struct{ UnauthorizedErrorJSONResponse }
+// GetReadyz operation middleware
+func (sh *strictHandler) GetReadyz(w http.ResponseWriter, r *http.Request) {
+	var request GetReadyzRequestObject
 
-func (response GetStudentById401JSONResponse) VisitGetStudentByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReadyz(ctx, request.(GetReadyzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReadyz")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetStudentById404JSONResponse struct {
-	Message *string `json:"message,omitempty"`
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReadyzResponseObject); ok {
+		if err := validResponse.VisitGetReadyzResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetStudentById404JSONResponse) VisitGetStudentByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// ListRents operation middleware
+func (sh *strictHandler) ListRents(w http.ResponseWriter, r *http.Request, params ListRentsParams) {
+	var request ListRentsRequestObject
 
-type GetStudentById500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	request.Params = params
 
-func (response GetStudentById500JSONResponse) VisitGetStudentByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRents(ctx, request.(ListRentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRents")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List or search books (order by newly created books)
-	// (GET /books)
-	ListOrSearchBooks(ctx context.Context, request ListOrSearchBooksRequestObject) (ListOrSearchBooksResponseObject, error)
-	// Add a new book
-	// (POST /books)
-	AddBook(ctx context.Context, request AddBookRequestObject) (AddBookResponseObject, error)
-	// Delete a Book by ID
-	// (DELETE /books/{id})
-	DeleteBookById(ctx context.Context, request DeleteBookByIdRequestObject) (DeleteBookByIdResponseObject, error)
-	// Librarian profile
-	// (GET /librarian)
-	Librarian(ctx context.Context, request LibrarianRequestObject) (LibrarianResponseObject, error)
-	// Librarian login
-	// (POST /login)
-	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
-	// Logout librarian
-	// (POST /logout)
-	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
-	// Get this OpenAPI spec
-	// (GET /openapi.json)
-	GetOpenAPISpec(ctx context.Context, request GetOpenAPISpecRequestObject) (GetOpenAPISpecResponseObject, error)
-	// Get overdue rentals
-	// (GET /overdues)
-	ListOverdueRentals(ctx context.Context, request ListOverdueRentalsRequestObject) (ListOverdueRentalsResponseObject, error)
-	// Get list of all rents with optional filters
-	// (GET /rents)
-	ListRents(ctx context.Context, request ListRentsRequestObject) (ListRentsResponseObject, error)
-	// Create rental transaction
-	// (POST /rents)
-	CreateRentTransaction(ctx context.Context, request CreateRentTransactionRequestObject) (CreateRentTransactionResponseObject, error)
-	// Get rental report
-	// (GET /reports)
-	GetRentalReports(ctx context.Context, request GetRentalReportsRequestObject) (GetRentalReportsResponseObject, error)
-	// List books currently rented by a student
-	// (GET /returns)
-	GetRentedBooksByStudent(ctx context.Context, request GetRentedBooksByStudentRequestObject) (GetRentedBooksByStudentResponseObject, error)
-	// Mark a cart as returned
-	// (PUT /returns)
-	ReturnBooks(ctx context.Context, request ReturnBooksRequestObject) (ReturnBooksResponseObject, error)
-	// List all students
-	// (GET /students)
-	ListAllStudents(ctx context.Context, request ListAllStudentsRequestObject) (ListAllStudentsResponseObject, error)
-	// Register a new student
-	// (POST /students)
-	AddStudent(ctx context.Context, request AddStudentRequestObject) (AddStudentResponseObject, error)
-	// Delete a Student by ID
-	// (DELETE /students/{id})
-	DeleteStudentById(ctx context.Context, request DeleteStudentByIdRequestObject) (DeleteStudentByIdResponseObject, error)
-	// Get a Student by ID
-	// (GET /students/{id})
-	GetStudentById(ctx context.Context, request GetStudentByIdRequestObject) (GetStudentByIdResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRentsResponseObject); ok {
+		if err := validResponse.VisitListRentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// CreateRentTransaction operation middleware
+func (sh *strictHandler) CreateRentTransaction(w http.ResponseWriter, r *http.Request) {
+	var request CreateRentTransactionRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body CreateRentTransactionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateRentTransaction(ctx, request.(CreateRentTransactionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateRentTransaction")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateRentTransactionResponseObject); ok {
+		if err := validResponse.VisitCreateRentTransactionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListOrSearchBooks operation middleware
-func (sh *strictHandler) ListOrSearchBooks(w http.ResponseWriter, r *http.Request, params ListOrSearchBooksParams) {
-	var request ListOrSearchBooksRequestObject
+// GetRentReceipt operation middleware
+func (sh *strictHandler) GetRentReceipt(w http.ResponseWriter, r *http.Request, cartId openapi_types.UUID, params GetRentReceiptParams) {
+	var request GetRentReceiptRequestObject
 
+	request.CartId = cartId
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListOrSearchBooks(ctx, request.(ListOrSearchBooksRequestObject))
+		return sh.ssi.GetRentReceipt(ctx, request.(GetRentReceiptRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListOrSearchBooks")
+		handler = middleware(handler, "GetRentReceipt")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListOrSearchBooksResponseObject); ok {
-		if err := validResponse.VisitListOrSearchBooksResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRentReceiptResponseObject); ok {
+		if err := validResponse.VisitGetRentReceiptResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1986,30 +9499,25 @@ func (sh *strictHandler) ListOrSearchBooks(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// AddBook operation middleware
-func (sh *strictHandler) AddBook(w http.ResponseWriter, r *http.Request) {
-	var request AddBookRequestObject
+// GetRentalReports operation middleware
+func (sh *strictHandler) GetRentalReports(w http.ResponseWriter, r *http.Request, params GetRentalReportsParams) {
+	var request GetRentalReportsRequestObject
 
-	var body AddBookJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AddBook(ctx, request.(AddBookRequestObject))
+		return sh.ssi.GetRentalReports(ctx, request.(GetRentalReportsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddBook")
+		handler = middleware(handler, "GetRentalReports")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AddBookResponseObject); ok {
-		if err := validResponse.VisitAddBookResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRentalReportsResponseObject); ok {
+		if err := validResponse.VisitGetRentalReportsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2017,25 +9525,25 @@ func (sh *strictHandler) AddBook(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteBookById operation middleware
-func (sh *strictHandler) DeleteBookById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteBookByIdRequestObject
+// GetCirculationAnalytics operation middleware
+func (sh *strictHandler) GetCirculationAnalytics(w http.ResponseWriter, r *http.Request, params GetCirculationAnalyticsParams) {
+	var request GetCirculationAnalyticsRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteBookById(ctx, request.(DeleteBookByIdRequestObject))
+		return sh.ssi.GetCirculationAnalytics(ctx, request.(GetCirculationAnalyticsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteBookById")
+		handler = middleware(handler, "GetCirculationAnalytics")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteBookByIdResponseObject); ok {
-		if err := validResponse.VisitDeleteBookByIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCirculationAnalyticsResponseObject); ok {
+		if err := validResponse.VisitGetCirculationAnalyticsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2043,23 +9551,25 @@ func (sh *strictHandler) DeleteBookById(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// Librarian operation middleware
-func (sh *strictHandler) Librarian(w http.ResponseWriter, r *http.Request) {
-	var request LibrarianRequestObject
+// GetCollectionReport operation middleware
+func (sh *strictHandler) GetCollectionReport(w http.ResponseWriter, r *http.Request, params GetCollectionReportParams) {
+	var request GetCollectionReportRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Librarian(ctx, request.(LibrarianRequestObject))
+		return sh.ssi.GetCollectionReport(ctx, request.(GetCollectionReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Librarian")
+		handler = middleware(handler, "GetCollectionReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LibrarianResponseObject); ok {
-		if err := validResponse.VisitLibrarianResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCollectionReportResponseObject); ok {
+		if err := validResponse.VisitGetCollectionReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2067,30 +9577,26 @@ func (sh *strictHandler) Librarian(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Login operation middleware
-func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
-	var request LoginRequestObject
+// DownloadReportFile operation middleware
+func (sh *strictHandler) DownloadReportFile(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, format DownloadReportFileParamsFormat) {
+	var request DownloadReportFileRequestObject
 
-	var body LoginJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Format = format
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+		return sh.ssi.DownloadReportFile(ctx, request.(DownloadReportFileRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Login")
+		handler = middleware(handler, "DownloadReportFile")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LoginResponseObject); ok {
-		if err := validResponse.VisitLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(DownloadReportFileResponseObject); ok {
+		if err := validResponse.VisitDownloadReportFileResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2098,23 +9604,23 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Logout operation middleware
-func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
-	var request LogoutRequestObject
+// ListReportSchedules operation middleware
+func (sh *strictHandler) ListReportSchedules(w http.ResponseWriter, r *http.Request) {
+	var request ListReportSchedulesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+		return sh.ssi.ListReportSchedules(ctx, request.(ListReportSchedulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Logout")
+		handler = middleware(handler, "ListReportSchedules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LogoutResponseObject); ok {
-		if err := validResponse.VisitLogoutResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListReportSchedulesResponseObject); ok {
+		if err := validResponse.VisitListReportSchedulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2122,23 +9628,30 @@ func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetOpenAPISpec operation middleware
-func (sh *strictHandler) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
-	var request GetOpenAPISpecRequestObject
+// CreateReportSchedule operation middleware
+func (sh *strictHandler) CreateReportSchedule(w http.ResponseWriter, r *http.Request) {
+	var request CreateReportScheduleRequestObject
+
+	var body CreateReportScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetOpenAPISpec(ctx, request.(GetOpenAPISpecRequestObject))
+		return sh.ssi.CreateReportSchedule(ctx, request.(CreateReportScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetOpenAPISpec")
+		handler = middleware(handler, "CreateReportSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetOpenAPISpecResponseObject); ok {
-		if err := validResponse.VisitGetOpenAPISpecResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateReportScheduleResponseObject); ok {
+		if err := validResponse.VisitCreateReportScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2146,25 +9659,25 @@ func (sh *strictHandler) GetOpenAPISpec(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// ListOverdueRentals operation middleware
-func (sh *strictHandler) ListOverdueRentals(w http.ResponseWriter, r *http.Request, params ListOverdueRentalsParams) {
-	var request ListOverdueRentalsRequestObject
+// DeleteReportSchedule operation middleware
+func (sh *strictHandler) DeleteReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteReportScheduleRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListOverdueRentals(ctx, request.(ListOverdueRentalsRequestObject))
+		return sh.ssi.DeleteReportSchedule(ctx, request.(DeleteReportScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListOverdueRentals")
+		handler = middleware(handler, "DeleteReportSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListOverdueRentalsResponseObject); ok {
-		if err := validResponse.VisitListOverdueRentalsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteReportScheduleResponseObject); ok {
+		if err := validResponse.VisitDeleteReportScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2172,25 +9685,25 @@ func (sh *strictHandler) ListOverdueRentals(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// ListRents operation middleware
-func (sh *strictHandler) ListRents(w http.ResponseWriter, r *http.Request, params ListRentsParams) {
-	var request ListRentsRequestObject
+// GetReportSchedule operation middleware
+func (sh *strictHandler) GetReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetReportScheduleRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListRents(ctx, request.(ListRentsRequestObject))
+		return sh.ssi.GetReportSchedule(ctx, request.(GetReportScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListRents")
+		handler = middleware(handler, "GetReportSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListRentsResponseObject); ok {
-		if err := validResponse.VisitListRentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetReportScheduleResponseObject); ok {
+		if err := validResponse.VisitGetReportScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2198,11 +9711,13 @@ func (sh *strictHandler) ListRents(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// CreateRentTransaction operation middleware
-func (sh *strictHandler) CreateRentTransaction(w http.ResponseWriter, r *http.Request) {
-	var request CreateRentTransactionRequestObject
+// UpdateReportSchedule operation middleware
+func (sh *strictHandler) UpdateReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateReportScheduleRequestObject
 
-	var body CreateRentTransactionJSONRequestBody
+	request.Id = id
+
+	var body UpdateReportScheduleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2210,18 +9725,18 @@ func (sh *strictHandler) CreateRentTransaction(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateRentTransaction(ctx, request.(CreateRentTransactionRequestObject))
+		return sh.ssi.UpdateReportSchedule(ctx, request.(UpdateReportScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateRentTransaction")
+		handler = middleware(handler, "UpdateReportSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateRentTransactionResponseObject); ok {
-		if err := validResponse.VisitCreateRentTransactionResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateReportScheduleResponseObject); ok {
+		if err := validResponse.VisitUpdateReportScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2229,25 +9744,52 @@ func (sh *strictHandler) CreateRentTransaction(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetRentalReports operation middleware
-func (sh *strictHandler) GetRentalReports(w http.ResponseWriter, r *http.Request, params GetRentalReportsParams) {
-	var request GetRentalReportsRequestObject
+// RunReportSchedule operation middleware
+func (sh *strictHandler) RunReportSchedule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RunReportScheduleRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RunReportSchedule(ctx, request.(RunReportScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RunReportSchedule")
+	}
 
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RunReportScheduleResponseObject); ok {
+		if err := validResponse.VisitRunReportScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListReportRuns operation middleware
+func (sh *strictHandler) ListReportRuns(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListReportRunsParams) {
+	var request ListReportRunsRequestObject
+
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRentalReports(ctx, request.(GetRentalReportsRequestObject))
+		return sh.ssi.ListReportRuns(ctx, request.(ListReportRunsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRentalReports")
+		handler = middleware(handler, "ListReportRuns")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRentalReportsResponseObject); ok {
-		if err := validResponse.VisitGetRentalReportsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListReportRunsResponseObject); ok {
+		if err := validResponse.VisitListReportRunsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2312,6 +9854,32 @@ func (sh *strictHandler) ReturnBooks(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ListReturnHistory operation middleware
+func (sh *strictHandler) ListReturnHistory(w http.ResponseWriter, r *http.Request, params ListReturnHistoryParams) {
+	var request ListReturnHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListReturnHistory(ctx, request.(ListReturnHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListReturnHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListReturnHistoryResponseObject); ok {
+		if err := validResponse.VisitListReturnHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ListAllStudents operation middleware
 func (sh *strictHandler) ListAllStudents(w http.ResponseWriter, r *http.Request, params ListAllStudentsParams) {
 	var request ListAllStudentsRequestObject
@@ -2369,6 +9937,37 @@ func (sh *strictHandler) AddStudent(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// PrintStudentLabels operation middleware
+func (sh *strictHandler) PrintStudentLabels(w http.ResponseWriter, r *http.Request) {
+	var request PrintStudentLabelsRequestObject
+
+	var body PrintStudentLabelsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PrintStudentLabels(ctx, request.(PrintStudentLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PrintStudentLabels")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PrintStudentLabelsResponseObject); ok {
+		if err := validResponse.VisitPrintStudentLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // DeleteStudentById operation middleware
 func (sh *strictHandler) DeleteStudentById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	var request DeleteStudentByIdRequestObject
@@ -2421,59 +10020,379 @@ func (sh *strictHandler) GetStudentById(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// GetStudentBarcode operation middleware
+func (sh *strictHandler) GetStudentBarcode(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetStudentBarcodeParams) {
+	var request GetStudentBarcodeRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStudentBarcode(ctx, request.(GetStudentBarcodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStudentBarcode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStudentBarcodeResponseObject); ok {
+		if err := validResponse.VisitGetStudentBarcodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStudentHistory operation middleware
+func (sh *strictHandler) GetStudentHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetStudentHistoryParams) {
+	var request GetStudentHistoryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStudentHistory(ctx, request.(GetStudentHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStudentHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStudentHistoryResponseObject); ok {
+		if err := validResponse.VisitGetStudentHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetStudentPin operation middleware
+func (sh *strictHandler) SetStudentPin(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request SetStudentPinRequestObject
+
+	request.Id = id
+
+	var body SetStudentPinJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetStudentPin(ctx, request.(SetStudentPinRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetStudentPin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetStudentPinResponseObject); ok {
+		if err := validResponse.VisitSetStudentPinResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStudentSummary operation middleware
+func (sh *strictHandler) GetStudentSummary(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetStudentSummaryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStudentSummary(ctx, request.(GetStudentSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStudentSummary")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStudentSummaryResponseObject); ok {
+		if err := validResponse.VisitGetStudentSummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetVersion operation middleware
+func (sh *strictHandler) GetVersion(w http.ResponseWriter, r *http.Request) {
+	var request GetVersionRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVersion(ctx, request.(GetVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVersionResponseObject); ok {
+		if err := validResponse.VisitGetVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xc727bOBJ/FYJ3wO0BcuykTrfrb+l2N8hdew3iLg5FNwhoaWxzI5FakkrjLfzuhyEp",
-	"WbJoW3Gc3bbXb7FEzXCG8+c3w2k/0VhmuRQgjKajTzRnimVgQNlfKc+4ucRH+CsBHSueGy4FHdE37J5n",
-	"RUZEkU1AETkl3ECmiZFEgSmUOKIR5bjy9wLUgkZUsAzoyBGlEdXxHDLmCE9ZkRo6OhlEdCpVxgwdUS7M",
-	"sxMa0cwxoqPjwSCiGRf+V0TNIge3EGag6HIZUTmdati05f+0t6pveU4mMJUK/La5mBEzx1+6SI3eJIVj",
-	"FBYjKEW570Fg38uIKtC5FBqs3i+EASVYOgZ1B+onpaTCx7EUBoTBP1mepzxmKFj/N43SfaJwz7I8Bbcy",
-	"ATo6tQoDrdkM+Z0JUgi4zyE2kBBAqkTGcaEUJHRZl+TvCqZ0RP/WXxlH373Vfbcbu+emdstNE2137Rgg",
-	"3Qtxx1KeXMHvBWjzUiaLfYQZNoXxRIlyVIklewghwmSbMlw2vOTAktRc8PDyNIn/Ilhh5lLxPyDZ28qG",
-	"g+OGlRVmDsL4zyxjfhgD20CZSEU0aI3P4D53zCpu9oReSnl7BcKMDfNhTskclOHO3SZS3t4YbpxU3ju1",
-	"UVzMqHVNYSC5iWXhtBIIO/6RnPwGscFvkGOA00Yaa7IGdsETfFzFlaLgCY3ayzaJ0dpkRO97M9nzDzOZ",
-	"QKqPcNv1Nz2e5VLZHfu451fkzMzx19X4JYtvQST9/HbWd1Qss8qc1hWQQDsw28XEvguEzrauKmsLEypf",
-	"RwElVPY4+kA9w3L59TKir+WMC+/l7d3nTOvg4RQaVFjpdX52VeSoXHc6j9d8opjiTGw/lPqynSfz9g5U",
-	"UsAvfstr58NUcuNMrSVkzJS56WiGCTNw4xynsR6f9wzPIPzRQt9It72wk+RzKcJOqk2RgDA3TiOBBUYa",
-	"lt5MSr/s4sSXbMaFDTYXYirbypozfSPg3rTt8L9zMHNQCCQUEKaAZIgwHOxgd4ynbJLWdDCRMgUmkCkS",
-	"zRXccVnoLoTLtZ2IO+zVGcs5RAQJ3Y67Sti1G3Eh3Mqb9AYhevaw2uTe4ePWLusib4VZgSPGrHAFpTs1",
-	"j9fIfGUvltWu1NXMMyt+TCm2cHLldQPvRLTur0GSaNeqBPAbdHnj/aOz7TvFbIiDNmHypKmY3alpbeel",
-	"y3YKKWuhtPZttNrO9QZBxkWWMbV4cOZ/SMRTnUWpEAUGw+7RcUeECx3iuHbo3eP8lCu9JZB2lDFl24hk",
-	"7Depgm82hfhuAMZLvD1drhbtSJaodYgLxc1ijN5YYhh5ywHRqLV/DEzu0ao69Hj0pq4alvN/w8JR5T6f",
-	"rAFcgu6vYA5C8zsgZ5cXZIp4hgnMRDPCSGoz/eIfmqDpEjQkrLgW2kAWES7itEi4mI3Ir6JHKlhAWBM6",
-	"40uMVYSLOxBGqoXjARkIY996FREFM66Ncp8xkayve1nbBb52GYMYxYRmMX6lcZmPYfg8vrWS2LV4LlzM",
-	"fkXc4r3QAkxy5SiOrVyoBxrRO1Da6en4aHA0sGknB8FyTkf02dHg6Jk/UHtI/Spyz0KJ6QqM4nAHhKWp",
-	"VaXGMmLKUwOKTBZYJxnOUmJ3ha8uXlHLz6niIrGoS5u3agxMxXOH96NG/+TDOtO39g9bIeM3xIDKyHdN",
-	"Vhkz8RwZwj2LDbl45Z78k0aryou+mwOemZySn+5ALcwcPSTcpyh/rmqvjN2/BjFD6y07KtXvQNgNJ6eV",
-	"nP1ak6jD6nqDZnm91vc4GQw6VKArUdbReQnXdqXUNWDn2i9Fah6W6QMZvh2E22XsuIhj0HpapOkC/cXa",
-	"YUJSrg2e6KSkPHTKCO2iUlp/Y1/CEjjeTaDdAlhG9LQb63aTykbMMtlaB3HVuTV352bfSZU4HxPwMV2Q",
-	"WAEzkLi3aOaGzdB5fAmNRVkuddCFMTSBIgwpuXjIhe3d+Si5Cm8t3z1LEl/Jqv3bUraWP10/33dzIOco",
-	"FDlnRk8WERHyDlKU+CwDxWMfj6UiPx+RcSyNIT9z88cMFEuTiOTFJOV6DglKc/zDyelRLTquE+/eVvEW",
-	"2wRSRhWwbDnh8SOcsFaar+KVjeksSSAhumb9QZS30382E5sDS3xP7rWMq0jQ/PyXq9foZmgmbQMkCrQs",
-	"VAyNcOtzSV3TK/ijeECM5X7+W3Yb/3LPPUuSmlsFnHIZea30P/Fk6bScggm0ZF7Z54QRnUPMpzwuSTYd",
-	"0i1D8i8XF8muTIpucPGqPEdrEe7ewQbTMhVadFdlQovFmqYfPtFw/XEddpOAbTpRkscd5HAwfBIvFNKQ",
-	"qSxE8gjnW9E4nMVVZmI5TBYOcIXMLq2aXZvA3b8KbSxwjufg4OZH3zapviVcE5YqYMmCpHLGBeYpIU0A",
-	"5K1aa3tglVq+qHjbuosenzwbnj7//kUPTn6Y9IbHybDHvj9+3hsOnz8/PR0OB4PBgNYb666R7yuLZtxv",
-	"Hn6TUYdSrWYte5hEY18e2Ds91dSdKznlKdR84pHXDOXFhkXKtulfbuGIXKbAkLucYQplM8bF0QHuH7ho",
-	"itpmfVDstK66lTc0L0JKt0ArtsYQREu1b+rnEivAOo+zVNujc8kQo7WXqOUPls0jYJProbu6Gi6Z1h/R",
-	"Jl0DnbIk46L7UTW69Z2gzZ/ss3aDNZjyGbnt+tZIrzJsj4iaiGoMpveja3O0jGtcfmjfYyrOGBeGceGD",
-	"qzbMQANAhRHTISNDzbQPeJGKhoqAAr0fTfmjVAdIg77JREcfrsNBIPVutysEyMJsjgFeBnRwhE325l+Y",
-	"Ruh2JGpFlMt8rRiAfA5avAcRi2NU959oT1NfI/NkB+Y41VW39cR8A+uo1I6HM011n4N5m4M4u7wY5xA/",
-	"Vu1VWLGvAqXLhn6Fu6P2Ey77tyW2KO8c0PC4Jl5aWzEg2/venCcJiCqo9/0dyub+nm09lHce5CM3c+K/",
-	"qTdOdbin5xZeVUvWSpFQm61szpd99W2h7ltL7eH3XJ3c3HfQyoPGUP2VdNLQNUqxVqZbhhZ3gVnWR9VV",
-	"YDCYoJKu7IodJfbPVS/cOozrTlfN6qopHXIGe6tm/97hBps4em8i9iYKM23KtCE27XbbQeOubM9N2Nxo",
-	"k+V379+/f99786b36tUmhsk6vmnc6dFvffWOQaB+W/uoIKDc7VUsVfIVxYDykoClqZWwTG3lxZK7wGrG",
-	"BmG2d9GFIVJYPGtnVFyX3kisAat70mYM+dHWB/jlu9U932NqwtU8wYcuNdYxjejALfth67Lr5pABffH9",
-	"89Phs5PjLR+ddK8V6iMST95Xf8gowsbunyZu8uAAXXhrN2XrvEHuC299O+Mu77RNw8DXncqlW5d8d940",
-	"N+/2y5t7wwzXhse6diXehqXnYBwcvaoWrCXvzzeZ7HYhO56xycacSmoXpQcwts8wsnt7cNJuwXamUEJv",
-	"KxWvrIdbb3+5qE+6bMF65bQHVi/ExpTH1jjfEEjZXkkXZdB1mXUFb7+iS34nWlviRQNFNMNnhKHRWrNF",
-	"J0XAnN2CcrbmYfDi8Yl0fVrcf3gdtIFDNJ4PnPv3yOo/MmVIxtQtJITV5n+/8Jz+hqlbwjC8mYZY20wS",
-	"w219aHbHGBnxMao2yeNAuhtSwbxVEgv1nM7SdLx6/6fl9mi/TPB1Z4DqIJ5ivEvXiH8lwR/tvGbcpUtV",
-	"atxcfK7GTMqOj5H27sENtIamtlaIZt900O3o/5I5qdL7DjUqtZXeYael/m9Go9bGDtvopmb39Ryyx6DU",
-	"pv6LW+n5PHxcarzytb9+YqrczOc6NFXu75FzU20yTzA6VTJZn55qROLtOCYBw3hqZ0/d8dqL4YksTBfD",
-	"PAfzBVrl4InSR9gCqtP/ZuYP75J0svG1O+bmP9j5cI0W4P6LgpBRYipMiQZT5DSihUrpiM6NyUf9foqv",
-	"5lKb0YvBiwFdXi//FwAA//+d9H3+PkMAAA==",
+	"H4sIAAAAAAAC/+x9a3fbtrLoX8HSPXftdB3Klh2naZ11PuTZnbvTNNdOT89eda4FkZCEbQrQBkA7alf+",
+	"+10zeBAUQYnyI07afkosksBgMBjMe34f5HKxlIIJowfHvw+WVNEFM0zhXxMpL06lMu/gV/ihYDpXfGm4",
+	"FIPjATwiU87K4glZKjblH8kVN3NyNhieDchUKgLvM1FwMSNSFUztDbIBh0//XTG1GmQDQRdscDzQUplB",
+	"NtD5nC2onWhKq9IMjgfDXDFqWHFO4Q0mqsXg+NeB4aZkg2ww9P/JZSXghaH/T+Oz5iA5LctzUS0mTOGz",
+	"6M8P2cCslgiSUVzMBp8+wdhlyXJY9H0jw692AxqWnGmLB/c/xYShJf5W/7cyvOS/UYQ9GwzjP9MoqJSW",
+	"qmPpPy3pvytG7DvE0AsmyFTJBRkL9tGc29/HRCoyXip2Wf8wJRRwdcllpYlieimFZntn4pc5E/BAM2Ey",
+	"MpbTqWZmTLgmfCakYgWhoiBmzsiSzhjJpTBcVEzbWeF3B0uluZidCfhF0wUjY8DteO9MdKDeftZAfhsX",
+	"c66NVKtetEAewBkiuD2AANgBUlDDvvkCjkzXCUlSQMkXvGu9P9KPfFEtiD1EsK/csIUmRhLFTKVE1yJw",
+	"0PQqDkfZYCrVgsKKuDAPDwfZYGEnGhwfjEbZYMGF+ysAzIVhM6YQYks2HSC/bYOqL/iSTNhUKubAhk0A",
+	"2lFMV6XRXauwE6WXkVyFh3uUhvuSqaJiPelLm6oAogJYsmgHgO70NUmOvLDgI1oAAaUUM6YNcaCRKVfa",
+	"XIMyk4SJn2WDofu3PydP0iksd+ejmZEYjV/aQV1H0I74AEruiRH7MjEcaKnJuWIE3SlOLAzrSGn+uvbS",
+	"Zowl0eKW82VKN2sbvqD/wktp6P+zCwUgCdibFWW651JMS56bl0pJBT/A7cmEgf/S5bLkOYoB+0slJyVb",
+	"/Oe/NGDj9wH7SBfLktkvCsuzdDWd8pwzYc61kfnFIBsUzFBerj0lVhjxbIn87UUl2N+OCb2kvKSTkv3X",
+	"KCOK/bti2rDivw4AWYaaSg+Oj0bf+/09DrAPwjIrJY4nSh87aI8TMH2KMf8fik0Hx4P/tV+Lvfv2qd5/",
+	"Z8ewOGvSwvs58/CR3AGhLTk4aQP5BQDNYJXu0pCVyhkA8FoYpgQtT5m6ZOo2UG/HO2c4VIT2p4JUgn1c",
+	"stywguBjInMEsIjQ+mg0qtHqoSMWPPLSDdqJ48bkt4HfAIG2EISRX4tLWvLixOL+mSxWN8IbDoYvn08p",
+	"L1kRo87NFTYaZ4spMULZM1oQB1Q3otqz3Q6uEmC2UPWuochdG2ETWpyrsM5OVEVq400QFs92F6iKwPyU",
+	"Dd5K80pWorj5cQSmdi6kOZ/CeDGi4AkR0hD/pEbOUY2ct9KQV+6FLtQ0p7hlpsaKwK5IIZlGkNlHbvfh",
+	"Z0ErM5eK/8ZuAVlVNFqDc1VmzoRxAyFkvMm0jkYHNc5+bg7TgbbGZLeBtA4oUUJiWsNv7OOSKzvdz2Kp",
+	"ZM60hkvu5qiz+vs5ak3n7GPOWNHEISU5VYYs6AqVYsoFoYYspDbkISm4NlzkxqoGGZlJQ45i9B4exuiN",
+	"ICcvheFm1Y3mNGC3ffNecllSwzShZAKaPdOaqKpkVqCzY8FUT2dczJ5V+QVDHC+VXDJluJV/UN1L2DCc",
+	"ZmPVJi7wCp/YMdpaWjYo6YSVjV0afDd8OBq0BDBUWs8LukpNuuAGzh4IkzCfXDIxZKJgBSmpNpvmX3AR",
+	"Bm0/ddJtYspT94TMZVk4/XYxSOqh7ic5+RfLkQ88FbRcGZ7rGre0LH+aDo5/XceyNlThC0H5BV1qkJLE",
+	"16fZTCoBhuegHerBpw8xYO7H1qbnc5ZfyCqFkGe44fgCHOMqjW7Brs57IFWxGdeGKVYkR3HqcxcQV3Op",
+	"GQEqBGSRKSvLJiU6sxc15Iophkx6QvMLMlnBSyI5p9WUOhfuFaldSeAHJatlG9EWzuZB67WdjqTqOalS",
+	"FOWaC7ZqAw9sAbWhjLizgzctL1IHMJzVXmN4HbI1jJFoPD3ekUA3ovHEqWafBZNgHe1xJrPBDDb3fLJK",
+	"2D/dw2vAZSkmARbqE5e0TM5mZC+Ib3Fv4Fw8L6nWfOpu5gQ/iVwGx78Dh3/DxMzMB8ffHiWgy6lhM6lW",
+	"57xok+Fz95AYSaa8ZDUlVqJg6gmRC27gYcnoJSPckEpMne4S8FJVadI3dNbcqgjUR6P0ZfXavns4Wt+q",
+	"Lmy9ojkzepON1d6qC2ryubepakZVPkeOxi6ZWsHaDVMEpIelIWNnG+AlN6txdib0suQGOF38wFrym3sT",
+	"jAopO7UDwMKD+js1gFhtiBQM7BQrIi3L1XNWTgdN8+23R2m+XplzOXX2hl6zCultIiWbmj6zdCH/DTA3",
+	"r1m1CVUu3f+Czekg69wmZJSaLBUXXixhNJ8TJoxaxeb3R1us79kADWcJmngDYhvMZdhHwLmdACfOYHcd",
+	"mLgD1vboXT1MgBRckEtaVmyQ1SS9bjedUAVvwit6gp6eImEYi0n9KMGUiua52XrS6tEORs454f9ujx7E",
+	"o+5deSc1h/96cxKa3C2iPIXan/ScMZMRtJaDZyxXUmt8ruSVHmzbKL1aTGQpZ6s1S70s2MHhd5FRsv7l",
+	"3yqJT8MWS5DPmwM9Ovh2NGhpUXjil0oWVW4IjOyXaReIa4rmdoM8Ovj2of3n8SAbvHlsf33z2P785vG3",
+	"jw6SkFmSaYBVE0kTsl9AukIObF/Qju4iYOpPk4SFMpfTXY9/RUL60HF2T8ACbWhKYkV93yljiWtRMdjs",
+	"c9z0lArQxS1SM7nltI7qz4KDW9c99xsEgD0hMyaYokBvV+CrlVaR2SPPPNaQwoHpIbt7dnJKKLrV0MBn",
+	"WUs9hhQMnWvb79HGtbsmhAO/JvCK84JlRFdww4C++IJdsRWIeW+ePyc53O5kQdVFrzl73d1BAtV4iRf2",
+	"8u5zRXfu4RppJqjAgrR1BmSDXdv7+vTZ2+HBCHBj//vwCZmvlnMmNLJevaQ50273FvKSFU2kHTy+a7Ej",
+	"GCV+36o9ZoOPw5kcuh8XsmCl3gO6j58M+WIpLft1Dhr3xpICfINnJ6fPaH7BRLG/vJjt21FwsmdSKXnF",
+	"ivcensSZ7bknW873TgfbU2Hi+q9dQ+tS9NDwRVKU7rkAi7oE6EsKzKkvHqplsSOE/TY94GTjxkdvbd18",
+	"/+5bxyx7S53Pg/PLoILvPPM7S5kTz79TenzEdHBcz7bwDHOjia4m7jcbFtRjwnzOy0Ix0VvXa6AooenF",
+	"0uhWDGUNFAF/koKUkop+wH9OMt50KDtFcz9zxBoPHz1CcS3w1mwLXGsiIz4KW+80R7hqKVFS1k+2X0tr",
+	"QsyaHz1aZQiNA/a95Vi0N4lezs5hSzvsoyAmQoBXUSkay8LwibaGMGstdVayJVNcwmpEVbpZjapYgNuJ",
+	"Dp+yWOhJs+ae5LONomMKhpPoSJgIeZWk2oItqCicCV2f62q5LGMzzETKklER3+pJ2ZCWKaC8FbSFrzYg",
+	"3VdTFCt4vsxNShADYUFOoynwv07hRdOlw0MGkCyZypkwg8Q2/caUPM+5yquSrklBAROfNpLlCfMsv0mY",
+	"vW1hSzrjIky+0YsR3nwtptLuBMaN9eefzdOU4KC6WiyoWvUf6NR90NeUthmbp/X0XXaG3Wm6YawOpNtB",
+	"kh3PNhDKRtHpFZgpgmNufU0Fa3p4Ip9ki07Q4NF83TESnXp9Aa61GUt/AFpE91xrvNlOnA2cTuoHTnHr",
+	"vzNamjmygfZyI4ha0HoXYW1sQYEZohq2a8Du426ATsPoCa8N/o8WBRpDaPmu8camMxAvNrX1d7Wmf3Cp",
+	"L16wS56zzyqQg8fwXDMmzmmCKb8BE6d3pDrefAGQZj7Gc8FFhRyhH1SdglPtA3MehLbXpeQTRRWnAlxe",
+	"kdOshqqP4qzYpby4A9Uh3sCN2kPzxa0KBL7+umDC8Gm3WJhTVTjpYzMJ+hc7afAE8aoCP6x9tZuOTbyo",
+	"T9k6dEln3NN3r8kFW62RlZ7LK0GkKMGinve6aj6kQN8qQNf886UwioqckTkty0HWEq2vKeQ6kAIYbYKG",
+	"vfDL94GyIO41nAtoRQeBJ7B5qez/0WTWcmREj5JKX22idLYbTR6gtU0qtLrJynhLzjexvbxtrVkTMjx8",
+	"uxnA10e5PUJ27vWEEMeVNuednKikG5+CEtGlTscbOaeayMpkRJYFcE+cNUbopuPkYD9BwSYp0dkXrq9o",
+	"IoreKyo0zdPeyvr3YMt3msDAxwckzeYbDA4EyBco3BIg1UTnVNg4gv6E1k0i+Ky3Eek6tyqGmJ6nLd8u",
+	"HgkXZz1RNXqJDeXEE3aD2xqZZN/1tUUVXeUh+ssClNzBmxFX9+0Yk9v2K7L59tZ78o2c8W6uv6Q6TVyV",
+	"ZirxYI3Z4FuZHeVDrwW/8cLK5pXGr21doos2S9tMdrI/7HBKwMZy3hmCdGqoMqwg8BZZwp1VVCyrb7CD",
+	"tL2iYueF8/n1O3e7GIedl2uXY93bVVDvws+OcPrKYRlCcG6B67ayeHnA3RoyCjDsLWRv3rIXsFUdUxBe",
+	"72Jy5wKX7nWNxQSbYOTLuRRdmuMOHKh+vfPWxkCf846bqY5kiFGhe0ZTrNlvWhQxp/ocElrb8/4yZ2Zu",
+	"De+KoatsIRVzmYW1CTRLWPFgUJ8C22dg/26vwW16Ze90zSgWcHPMQJTWm6B//D3EtMK7mKb7xHuJvVUU",
+	"Q1zhSYoQXE7l1rxNSNpcNkEepUCOEo+3ghxwnATbRl10wY30mVAS4OcWwuPd25IU2qZWqQwt33Bx0UeV",
+	"3OhU2EEed7NuvKH7TpsNllxcFzj78VYQu6IrNxu7biiRWwjeywvWjSQDT3ddvP0ouWgXQd8ivJNXz8nj",
+	"70aPiQvVJzZXQO+RMUi3mFRPiTZAhRlZUIhUY0PFaAG/EG7NFZypJ2ciLzlGOeu5rMqCTEDXnsOx4IYo",
+	"6hgVBZ2fjO004z3yDwFmAJhLH5+JcZTiM87IuJUiBT9ym7ZzzvEvr42ee0xANOA4TuyIv8kVQ5BpqeHn",
+	"qVQTXhRMwB+5VtNzxOG5ex2HClk1YbbGL+FKin9EuSv65UyMVeslhb6Hc7hDi6pkyYdT3nxwJsYlkO55",
+	"ycXFeSUCi4BvrNLQfHvBzFwW+CMtS4hOQPhckmJYEibz6Hg9eJL8zzCtF2Cjd8MyrbATfqKLCZ9VstJ2",
+	"JXBtnHMBckPJDLP7sZ6IGZDUShSJ988uHjcp/tmu/IKt4McloEdCvL59JU5HHKNWOoYwI56zBgJTwaJp",
+	"/e8Uj4PLnvTRX00rfZzt1RbbXD7O+rh/rxZU1IeLfVyWVESeTa59rqbI23M28sjS+mxChHjH1NBmd9dn",
+	"DXXYSjEdIt+9Zbg+786/ijG7rIC5IYa1r9kj8qskI761ATtdgl2FTD1MraXGBQk627BjYg3U7KOMt39w",
+	"+PDo0bePvxuyw+8nw6OD4mhIHx98Ozw6+vbbR4+Ojkaj0UFax8AZkw711wEbdVIvvp0RWmoZBCbvT/2f",
+	"oYN/+PoFmTNqI8A2qPNr5PH+/TtiH7aoDvMFW5UkNvht132yUhnivId+MdF8DYwm0hHXbTitYLKT10Sx",
+	"KUPK9VS08rHe/u7Bb+OZtmQ5br0P7Xg+8DcsBZGXuiZPWM74Mi2z9NZRuNbVri6cnZQtB2WXsnXBRbG7",
+	"Mc+6VdryNNa7iAz39tTZNTqStzjr1tZ2U55vFpjTlrc+de/zLdhXpLCex+17Bph/Hl6/lm3Eaa0d0TC/",
+	"zGXJrH0mhJteUU0u2NJYXZ8bHdLHNiSDsWJHuHYxqpygtTsddGHkslbdex2FZpR04jDAkJGBZBdjBtp9",
+	"kkOCiKJ8ql+Cx+ILcTpgH4XNIqbDifSSo/hcqx5wMbtTlP3lStrATm6srSF5dcW1bAvT3eHauIbhc7dT",
+	"usV2ll47HNRXPBXN7LLUz/2F35rPQxXdRPpykA3mZlEmr6C2w9ad3OHh6PDb4cFoePD9nh2jvTj+W0cM",
+	"YaXKVCgkyI+SFPJKlJIWznJTNsWPfauE6X1VCb3/cDrKD+n3bPhoclAMj6bfseH39OHj4UF+WDxkR9NH",
+	"9NvJPoyh95NAdiMYi3GkZPNQj8LWwtP0EisxwDd7a5oaL8YEqwWwUJXLGzlLDhLpGJWqMTHSFryzqpYb",
+	"LWRRSeAzYYS6sqGfNaEj7RCT2GFwrI12WIrAUrdN79LNYmMHo52qvGUDG1/YcWPW806rsrRXpyvwhhiq",
+	"rNjuUJQDPtfg+b4Bz8Nvv90Gz9qeJXL+ISAi3j0XDu70P/d96ghcNxjS0uBJlfAMMx/5ti5prAKCQp2c",
+	"9vnnJdtFqA2sJpWazAXX87sJjAr2l/7eVrWr26ntoVWVEFZh7umrNYrPZkzFY3jIkQZFRTsC03o4MWsa",
+	"2OjEjF/b6sS0L596GHtHGDW/Own1ftaJc5e4N1Wlw97e80WUWqgN8SgtgLgzNKlgrg2oqnALELf7kIs6",
+	"p6LoHw/HPsZgXCsc7dOHDZt3WhPD1h2M3t1xGzsN1gVdQY7zQgozb+P5BV3ZKwyflyvSIF3HPg+/28Y9",
+	"C1ZySA2NDwFbUF4OskHBFcuNVKvk6WECTGpFI8mzEfsf3VJ2b5qsq6cI053Vmw0qTKhzj2FumAoNRiJv",
+	"rKigvIQPrhi7wP84pCVnnMsqwaH/LqtgkyroCuPof37/vIHth9t8Ym157BeEqCFYJMLotmeo0L73gZOM",
+	"UOTN+ZKn66u8BBIgtCgU0xqslqHsEqao49NAOllC0fA0tFnTSG+hCsqs3z+HnlBbeRCXiE5uIux0QVcb",
+	"zo2lhXBsMjLCtZ1WoqCN1Ptvt7oKWzGNYQ0xPTrKqk9DdPrSprOmgSPCx0xiEklBF3SGt1wptUniwQ7i",
+	"zTJrUaS1ORVEoqy2RSMzdppkQ9Ht1nMTaq5PY7YKbpzOnJFK+0xmPw23NdBm/DKuq3PdJKHrG5GENKyd",
+	"QTvqqXzAcCcsl6q422zV24rjuwGigvsqYZzWTInIztkMP3d1zoK1E0ZOyhn+q76rCVt3Q1OA9a7t8vbO",
+	"wuttxuikKNFFwT6XwtDcdMoYlk1vZv/IFjWfiSEXBJyk+glhi6VZ2WrjkKFOuNke/B0FLe10v60xWDvI",
+	"h+41/4Hrs+CZ8oYFDIHnRaowi69bHUI3bB3jzTVZHv5Vk+UPXJOlZ5EUd4bebYoH5gmOf4S2pENS8BlH",
+	"0WZJjWEKHv2/X0fD7z/8fpQdHH76j60exq4go2ZY/x3freYP5616IBWmVUwYE/YXLcmUqm96+7Hu2Jbe",
+	"cpStL46J5rL8B08InWgmDLma8xKrtaFRj5elqy15XXvSycu371++GGSDk5fvfz55+/JFH1tQTandmbu2",
+	"dnm5Op+46iYNLHUXWLB2l90jtBdSm8Zca8La0hbCu3T1v5q5OP47a0iWU4PSeU9XYly8JWGD9PQcaq8k",
+	"C4V6dMF2Wmo1c8ZVTK89kBd5zntkD+mmWzKZ3G/BC/jhguAH1y0tdxp5N/v79jsEuJ+WNnn3SVNw816I",
+	"JcZLYngzHh0jrQS3XX3fkgC2iwWxcxArrKSedEW99zPJngZL/wZzXv3SVjveezp77im3fRvpnqfa0FlP",
+	"mf6/mdKdsfKTipddzPMZPMMeI9rQxTIjfApixyUvGLq5J+F58rrEkfHMrA/8AzdEMafXcwGRTsCa4QuD",
+	"raFS483k+aVdSdq9jCcxfmXdgGPohGpG7IvEvUhCCwaszcstFB1VdmOBo15eA7QWIB9ScTCa5ZXiZgXG",
+	"3IVXMOQFZ1C1HP7iYnDsforak9i65efx4aBL/g+2CnFD/vvOvF9KNCunQx+K5HOAg0UHbSv2MqzTvffI",
+	"a/wFc4RpnrNlQBgj+zgEYaJYSi6i5kshrs6B/z9DTDIbArwJ+B2nTa/ARmqHyu12IfaDjGhmAjCWRe1j",
+	"dGoEk1uAkKYFf21kCK+Du0GtPfRTu6/sPGGtra1yqrf7qr3eTxhdOZXtpT4lcL8oBjEccLnC5oECuaAC",
+	"8l5mhDqoVn/TVqxxDmS90oYtwMKcl1XBxeyYnIkhCfluhDZL4sNDmyIqLpkwUq3sHGwBvB2eOqZGVJTl",
+	"jXrk2nvPIijgse9OVKcTanjNl243iuYXuBLhnejY/W1InlrqdEHB/r6Z1l2NdOZKZ43dTo8zW7gQJFGI",
+	"X3f3FkLfJvToc/x7nMVIsTRByZKpIT4m7uCciTPxHHd42Hz93U+n7zPy7uf3uJQXL9+8fP/Sh55qsqi0",
+	"ISyf28szimofE0svZ+JBMzx1siLjH16+J/vwro2QdhGr4/8ZPj89eTV8b7/3nYJc8Oo37rUz0XoPYXGv",
+	"7fnOHjoEC1EbbUwQLlc88F+WISJej0YPbahB6A8Ce23VKXKKFAdYGmSDwHkHB3ujvREKaksm6JIPjgcP",
+	"90Z7D93liOxuP9x1s1T20AkzirNLRqBKZOiQ5ioPT1ZkSZXhtKy7Xr0GiRsuNpsXVqCFRJuf1CmWL37m",
+	"0svitpm/dkk/vuSxYWpBHjSnwjBrmJB9pLkhr1/YX75phKu8nzNpo3dfgq4eArITLaf8n3X/hbhko7OF",
+	"bDJyJcMWogLGeaLA1YNxHWz/DSxGSMGwwDF5MI5rJI+/6QA7ru3cgN5rQXHGVDzi4EOPNfxojS5RGlZy",
+	"FcjogEd2QQm9F3xzuhrEHdv8bU/KuyZw9OPtAxftPi0KmwsnFaFTgzk/XIOiTx7885///Ofwxx+HL150",
+	"bjB8fe5ksQR4XUWpegIUgnt2hcjIW4OnWYmR67oUI2BMrGz6X7sgYwq4uPhsEr6uMMdtxxcoytDZE7gn",
+	"GTXWdo5SKNEMqu6VBGu4AvtZluhQs970FJSgM8TQ3VLVV21WyPZgsZ19QWrOux91KO3xdtwdtMfrzVbI",
+	"PT6IO+ZCXEejCd/haLShEZBvAFRjdK3sSai3vy102lXm/8xl7Oyt+Gl7+4B2u59TiJXSGsL1VkS527rA",
+	"QITQRwBGPhqNuqAIiN7v7IiGAxxsH6Dd+OpTNnjUb+p2w79Pcf0+FCNs1ygUCuzZfCDYVSgxQypRgsNL",
+	"S2VYAfwLj+Txr66SN1RLWkqdFHOsikUoEezK1QMQkeaxqoXzlnzztChcPWTVq+tesl0V2iEepZo5/aCA",
+	"3/xAjZ6sQCG6ZCVIXk8XTPHcaRNSkVd75DSXxpBX3Pw2A4ZUZGRZTUoME4TVHHx/+GgvkiDXB+/ffsrR",
+	"a1MVD9EfjWN7cINjmyz5B3O7K0xHtN8nWKx9eroHs4I6gvFG1l1N1pO43niXj2BX5Yq4ujpeHXT9LNvJ",
+	"dx13k+KJZXy63un1XQ7v/dw+LYroWCUO5afMpyRady0SQvKYvgHniNWW8NXaoRsFp2iM7cL+slgRGd1w",
+	"Nl5cEOuz804+LHV/Jt69eLVHXga3LXhmFXd29EQx/9qDO5eaCdvyFks6lc4XfCbAGZwRoyphFVS00Js9",
+	"8ixIFHYZfnir8C2lqg0iXBEuCvYRVjPmhXbpuE3e805xYUJXE70zD+p/3htu+V5Hf9ONvSymzenDIbB2",
+	"yEGyL+86NQQv63WvuJsfkqPR0fYvm40zb+9o4e5bmiz9/nefLvukQ9GH21V7KRfrHVea1aLvept0OCKY",
+	"rQqnL7POJYwJ87Xe2iYAAOi9FZFvUbTbVdQKRv9rSVsAfyRlNe6ML0ZGQnpwykg3NfzOi0+WDEqWcjq8",
+	"wN/BvLtkOZ/y3LPv5s7a12D4Z6vXxTbLDogcr194ZvoMAZUBoV6nQ89NUJZQX2vyml00uw9pkSQhB9il",
+	"FF8xPwh7ZlMUV9Yat5kG9qPK7knO8ELRq9ZdSN1NqAkl797+QDhEsma29Y9PYoc3/qbrrxRq8bzYAyNu",
+	"wcjB4XdEV9w0B7dlEZUmrq1QwcAv8n9PXOFE+CDUUkR/IsnpgimqU9fjDwzZzrPQgGhH6rwTisxS84YI",
+	"piBpBJiTFgSbyJ/qkL9z0FMboPWeTnZfO40uvsFYCpp6Gb27QbWg4YWZ+2QJiPXGGvj8Iyu7ANI5LTsA",
+	"ehgH/G8J+uthhUC631+K2Y2lGR9ujSPes8Z+r0zsB2bS3GY7K8vbXSirBEeDnDrtWZi1O9JgdMy8HT7z",
+	"brES+zkBn4p6ZeFDAGePgFBwJjAYw0gFxhd5xdQwp9o7b6zNkIXeUJD8QJeaFZnTXkTOUuzLddVchXZL",
+	"98+8PtydhrHWQ/TGOsbuEOhOG4GnLC8e/Nk0DU+Jm49iZJ7f4FCslNDNlktGMYbxJnjKlJTgX9Z8UnIx",
+	"01aVt2ox0PAeAVJHixlq9XCCzkQYC5OSa2MAvAGntuVAOCZj6y8aO0c9066Zkm+1ciYi95yNuQgvBAdT",
+	"6tiCFP489lTco76zucNUH53neWOXvmDl5xmEigeqspj3VBptR7cV+GkBcmVNlk4IAnqES8HfFLah1B6B",
+	"SkC2z5/NgiN0IcUs0G1G+ExIYJsEroK9NndHY2HUUe0uGOt6V607MNr2mX4jYaEB9ho216g715dvTT0a",
+	"fb/9y+euCuHd2GAjfCXPRZOF97QQNE4MlsKCOHUhm9wWxSUhEx5fbjpsCo2D0SDPow19RYv7U+LvdYeD",
+	"6r9tk7Md7uUQ0aWrCXJ/28CgwvgvGrqiNe7xlA7evZWjW+c09qLbwG1spbyvVxRz11yfnd5BYYgGuxOl",
+	"IamInXjlqpEcZ+9Xq/d5sPbIU1KLeFQIaciEEWyv6xiJFMxHimAAYsx+2pfvz9hG9Uu8fEef9/J1/WS/",
+	"MrXmXnntCUNalQrpr+/FqtV0i1aExwCiRl0UqK0nC8GrE5foEcqm1rGlLqQUlJJ859DYJLM+PXmFI9+u",
+	"4lJH3fYrUtmmWJtIFCGoKTLGSElWrG9/XcOaFhOPNqzXVWD9z92OpC87nlofQLZU0rj6Yi5M3tdpidMV",
+	"Bse/flg3mTUW5qnwaSPS3FHiHPv8/baBGLG6l/FGYJfvDwC5Gk1ISgAWMZJgg3pbGncTWf3dzXqH/K7R",
+	"HDHlvWsuhkLxjo14fQPFLOBt2GwW4dXO5PCJIer7oahsZ/QCBGu75Dzb+slJwy6py2fsBZ9rTlWR1X9q",
+	"4DmqQtOlPhNjONhkH4gaiorX4f46LkKbMk9g9sfzuAbu7d98jQ5wn1nn9DWLO2x5muBWsQLzS/8M197R",
+	"4WEfKN3RgHN9Szdmfax+j9Oifv3wqXHMkBZhN3yAsiE0dLX0Rw4pqnHifNHsDfFCOFy1TJ8vfwrhnOH5",
+	"KUMMxMT3tIOaZpfMZSInjpHvTnmXx2i9A+ZnliIbHQU7uGqSd/2pLOU9Sd3vZZ0215faLXPfdLvUzL95",
+	"v3BBZlKC4u6qF3ReFmhZtx+h2LmgF+xMVEsot4XVCVwh0pwqs969s7H1T2zZ1CuuWaNbAlRztOFv2Npi",
+	"vQ1GiKyzMODRMxKk35CZFUUFdN5tJ74k0Zdxs40+383m0fSXNndbB9ZpZrtcTJuD7Nx5CB2cdTI70rVq",
+	"JlIw7f1TRbbuCkt6nv5hYbhXr1OzJ/I1nE52EV98qN2Fx3WDGPpkGuh0BniDNXOjfearZc2QO8513Cga",
+	"DQJcnwkP+pOoMYVmotCYRB5yV0Pm99inoiaYqIfxH47S75CNtrtWf2ZloQVHJzVGh3bwlYfkR+kuSW6m",
+	"G+xsqyPoBJmVFSUaJQ7we8j5140cdPSZXrCl2UuQHgzlCW/Nfv05gkMTviW/+8iRv2LHgcXtDnu+H29a",
+	"56XmLQm6wbuoMWyB5R3qSzMjcbZWVCDBFoKzbaLhxuu+197HEH0GArnnJMo7zIn8nPmN61t3A5mgyUg2",
+	"yQd/zujJIGI28FRz465j36gGmzzn/wc0wqlU1nwGp/bKNdsN31qrqmK0WBFb+QVjKVP5IH62a9F0lDnZ",
+	"LDI76NHUDhtU+MzCARKALyrTzIBsnpedq9l2d0ztQ+wNuBxntXiK0L1Ucur6QxxtlInWnRWN1NMCIInb",
+	"gw7qhowDd0RspY0lVywAtUfelYwCPHIGEiadUS726q5yx0ejgzrZ9Ofm+G796+3sGlD0Tkfd4FRx7TAD",
+	"ItvLuNWzt74xWzwxeEa6TTrRN/GuRx1bbUAGxpERGlbUOm04zQ3Sk7H5/7HVmdk7qvUV7GGlmRocD2ix",
+	"4KL/ZjVaId+a/eQWOQICGN0nXxBTWAeNDANhu8zjpkv0lJmhrZeUaHDpP8TnxEiyoFwYyoVj3XCMWQ8n",
+	"6c34TqINcYr9NB/fgMOk5rsNRuMBrULpdEXg2FxJVdycxXT6Jz1PKN0R385u9iUv8s5L/tRQZeWHn5ZM",
+	"vH5BnkshWG6Ixy2OZ4sdT0t55brevfvH85ff2BvAiR+5FFM+q4DRWj+NWfmaharFoH56/eJ5zaSiw/9w",
+	"dJhSN21nE+84TY3fK5T0aWNNvuqcN2ynht0xzPSzxg+ccjErmS28VocP1PvwGahQN0DoT477kFgzoflF",
+	"t5LpXAQp0sRxMrtNyNKaBQOl8GXH4b1+NyYQ5HMPU1rNXC88ZFPauplllv5uO5ft+NB2Irvuh+cxejcN",
+	"8qHPidx+L2VErZ3a6Cx84ZfWbZ9M19IM4Xj4OeEAEzLNMX7Xs4cFXS5teQoaHRklvVLx5+ZgJK95wDZW",
+	"tjH0x0kH1DEwVxO7od7ZIZq8KyXJ+5idWzMEJavs2IliKTi7psC6NsydbaGdKUbdxh1zJTH3PHbcrdMK",
+	"W4O75um716dLlt8U7UFkwEc9UoJdbS/b583lL1/fpLUlfhAr77nVYs0HmPbjcM6Lgomgmu27mu9bfJy+",
+	"SqyNCmg07nQpryFcYO1hyDBoP8RuKlABCIvI235iiUqj9pMTV/a91+W93oN0y616nxZhh5EvpbIeVkLu",
+	"bQd+Cm8/q/ILZlL9BD6nSXpjS/NerM1V2PMkCorfH6TSHrADvywVzpFnpzY82NuNXZ3vOddYG297HWHi",
+	"djkqUsiwKJctWzO3ly8rhijN2diiOa0bNbiIWN89IhlrbKuU/93B1GIB93l+HaK+lPP7OY9cswXQtQ6d",
+	"/ZZ4avuaT1scf9Qoub8egQSnUTXXXR9GS+nNswjJ630KfYUg144TB8J3iLtbMZMRWRaR+9Zf4VxtOI0w",
+	"nQXyDYJ1r2FJt0CBPo/EIvlroB+8qPIG2Nvop79Xog5jnax8RzuU4d69fts0tSwbnSP2yNOpYepMjO3v",
+	"e1CFe8nFuYsb0GNypSCb/t3rtxjBSqERHBIrjMw1KSXG8IND0o8BP8nKpAslOhq8jiekp2Jbz3BP4aEN",
+	"COwcPb0Izc3Z3Zmw1hYkdOHYZoa5jwjVw+8/t82lSbGuBrwE25VYOTL3ZH9n+rFvIbLuKOhkAPvQcaqb",
+	"C2CLUQxitBaTSrNGr6otWU18Gv7Au4YK25X6TLiupTbg0a8X0BdyoHzYgY0tQMOVsD3lNNTlSBz+UyaK",
+	"6HC8gZXdKRPgYrcQ8cPbsd9cw0LztIm9zo3x3WRvHPp4n6kZjRPhmuQ2qLbXwQhJrB0n42M+p2Lm4iHx",
+	"ZdcnKT4ecG+tX4qbLq1fuJnXWbl3Rbg4w1+315d7e9393WA59a7HYhfrd1+iv0tL9xdty472w+JgE/IX",
+	"bJP52n5RN0W8s9NbN97syBBcVzG/Gt07qSBv3ZT93LZu7yxC2ah9YgvZRpUlG3duSLVbh2KPPBWul7v9",
+	"wLZz14SbroonFlrXV/6O7pJ08/rPfJ1sI0hX96RJjl8xR99CyXb3iaNKYgOsOi0BGML72+aQf1L4Tp65",
+	"DcTgl9ysMpeA2ujtGdI6aX4xUyDakX/JSdJ0e2JnvscqEQhBXdjCKDqd8txu1cPPBsVPwhWYUcwGXOsQ",
+	"N7Dh5gDY+faKFco3Kk5eG2AwwloV20rOvgptAK3tHhvzhT59oR9fyuMGH9gOwlt8bV0zer3ShvZIRUpw",
+	"CsKIPSHwPr+bAIEmNTxXPfqmFeuRKT16pt2npwIW92d0U5xEzd9v5BlUtmVrLlXxB3IMeqcdFNVVkY/f",
+	"9+y0vUGbDkNhNqfECkNkxO9CWRxaCzpxlYmcCqgJMIPqPNiq1Z9lXoxhkMnqTIydL3+c1bePDyIYw//O",
+	"oaVOVv+JBe7H5IErO459Tl+fPnurv8GK4BNp5k/OBMYuMGHUyi0e4M7lcrVHXGkHzpR2lbuwRamQ2HYU",
+	"cx4Bd6xuJYuReHp8Jpx8dzQ6ChavZDlwVGwBXXEu1Q3i+uuFwz59//i74Wh4dHQwPHh8+Phg+P0gG4xG",
+	"o6Ojg8eDD1ndxn5wOjwYHR71D5s+QZ/LnaX3tnu3w9626ez9PBSwgJdqz7PDHytwJzPYA1lCUhmWpI7r",
+	"V/B6jwdZzU62hv2vRzrkVJlrpAw0u6Bpv5rNfdBCR+9+E/Z1w4ZeZ7eQefdXOaUdq7Fbp5rzCpsGM1jn",
+	"ukHe2//dUd2nfVfvZUO0higsGwu1YXw5TmVzAJeKC4OtzSkJFQz8q8HWH9zCZ+JqzkvmTcuurb3AwGDq",
+	"25S3Pq8L1rgy7+7cipwRbs4Erwue7JHxspiOYdyScizthXAqNqtK6uBlSmdkbD6aMT4zc6YWtPTzngn/",
+	"ErRpAxuYcm29Q4v6KKnBt4UE4e4JeffilR8GMiC1tA2yzgR8BWacjuY0ljPid4Mdy7Uqky7V6lnLDbOe",
+	"UxKs+yzd6GVZTKMmL/Yv89GkOrx8uOtWcdnAsI9mf1lSvnZXbI25xJZqcGb9fv6Zk4kRGYQGVHRxFxsQ",
+	"tjX2C6BQbM4EtOP23Esbarg2PPf1A+xgHYeFlifhhc8W1fXhTks6CWOX1HnRWpR8mbnut6hYOHqwq+2M",
+	"N7SP9T4VtFwB1WxIGcIOIf520pm7LDSWoahDlIHqnGrAcrkAWd0HPy6ZgojjjFwxdkEeaEMVXHpn4kcp",
+	"Crr6xmoswsyx5ejP75/vkZc2lhFjXD2zVuAOxKsJ5f+48IU13krB9B75xZZFAwPZ8nyyGjtTGsP6+dzd",
+	"K3pZchMbQBb0X1JZrQeXYQsA4SBR6/KF1OZM5HWpjlobQQvKHnnbwIli6FsPs8HIHXfYc67yqsSfnoZN",
+	"2Wo5UhosJ6sGijJXzxvMhHX8gKs1x412aN0jL+ytgzriwxGMozNycIjbhIrbwaHdF+0b3I+NHHfYZW7e",
+	"W/8NTSymCaWRBV11ALB7K/3UKCi6XNKy43q20/vr2f4F6BpkA8TU4EPvmTyBNmbyIyM5DjJUw3p1d/vR",
+	"dmOLOow62rWNIW0hvxQYyNDTqz0YZTUauTAPDwdR27eD0c37vl2f4YczssnJHZ0pEjjdH/4GyFOr3noT",
+	"QDM1WzJ7S0VrjfwcVYi5vLLRV7YFFKoC2IQ5w0dyapgg3GBYjFOyndFmyRSXBbLmM0EvmaIzhlGVpKgU",
+	"NbG3Q8+Bjcpp9J3tImfnvGIq9JryjaHRbgQPzoSNvsWpYZhpSWcz+L8mvzElhxGunrjr6wqbUyVGJ9SQ",
+	"kgGT+m70v2176mglqAlRg7OAUQ8bU4vCjhCUtCYIBVvAK+xjzhjeY7paLsvV3hnGqZCxPXz/levLsbOx",
+	"oDEM3kT8R5qb7SH6/PS/CTWG5vMF1kR1KKztvmeivlAQGmyuxIquSykQxYmXJ3a7kCx2mkz8u+9t/tF9",
+	"3CkpeO7+UgFSO49ILeUomUhZMiq6B7HEcm6JRZ9bUrnWSBsVUJdV5y8i92euLzuuoXut6xXI8/48LBs7",
+	"h6wfn6BTAz5306jrsf4smky+vuKtd5iqhCu5B2WD9P7vltI/dTdllleilBT71cEnwKhDaVgaEF21maP/",
+	"0gLyylYp2sHy1Bj6TgruJQYNB797YH/ugUCzwdwsyuuZnvpRuTsNOM1ux8HinazV7frqOo07Kqppba3e",
+	"VZrOAU/QwblHiWRNgUG4wQs25QJNwTpOOwrD9auMbGE6DTDcay5SE5jreZpPIxx9+cWS4x1Ni/VdHmJY",
+	"pya0TQ1WcrXOBLv/zmyQgywsiOUb1sZTMOj3orDEKKFnwhMP+oBt+Jz7WLGcL7l176owYm37l5VZVobY",
+	"eibYnCzQFPDFM+GMQRtcuI29v5sovOYk99aLpUnlnfwQieMaPUBjovoq+oDe0omCIxFORH++u7WW86mR",
+	"S+3Pii/Na4exQRgooHiDHFZiAOEFYzK04WUJkRmFux2wN5/hpe2kYgsv7nW0+0yciW2FmU+bV8S9Nf68",
+	"7RaeazSd4pNd8d7bsDj6jCe7sT1fe9dNkOt77cwOovTaaPfUdzO6TW2aUrxt2I+zpK4ZgWAf8bjbwBgh",
+	"rzqCzL/0++3eToGyiP9ztUaKopNx9X3OUfflBbryxh57GLkSCXy6obby2dwQeoVOPW7mYOxcyEu46IDA",
+	"/VTQblZVTrzEpkVnAv0RttOs8mNjNw689WycJyuiIviswCkgPJGaSo99hXtv3LTxf8ls9ZNKtM7QF8ZY",
+	"7lhkPKnEBmkR9sZj/Gs+EJVYZ7iWqe56IDYEX1TClw1ps/f1hgxIrpDwh+ZlL8URE4t9GIeVMizVGvZJ",
+	"JfQXRrB/9XG4ndD4cDSvV73HH96/Ojds6NyA+Gmd1Q1cAaNaNmWBnqAXD12Mz1Z1MujGA3oagu2xqMuW",
+	"LJo+lfP+SgnxZXjKVRyIrqNwnj+GEwSXa5fWXnHU+7IVUJj5NpZWlao29LmMisZFMbFO/MKcmzqymF1i",
+	"qphU9vaSgtWRUYYvWHYmmvm1dSlcL6yFWGTvqhfSRPkcY6SjMXqmg/THxZlodt58Qsal1GbskOPDrMCa",
+	"iEFPrni1nrNyuncmzsR7v65WzouL83UJL3Gtlb9pErJfQJBtlGDRgDdangm3HTCM6/YJq6lDBQBJiF0t",
+	"61bgMShGlgWhS6pM1O3TIrLd7tPBSxcTPqtkpdNSLyIO0XsDnXGt7bzPWmmFB7piVWttTO3mecOXK0pa",
+	"aZDl50wQh3Vfmxi3I5VysUuCR2BBPXkRAPjasEVfVnS72nALvebmvS8AJHtmzqlphSsM4YheL1HlOdDr",
+	"gqoLG2fyV3vU3Xn5j1Rd+MyPGIWbWHckllyzMqic2QxKx0ndOWxqKza/I5ci5yUcWDdjh2ICz77IiqAW",
+	"7OvHgbRCh34SeNvaa9L2PHI1x+Zck+6QoZsHLSVmdrFSW6a+nWilKM/tFrJdXCLhrkNtwIjLCPP5RFzX",
+	"ckZnjGvUX+gW1hQEkUH/3E2A/Xn47usW42EtJygdXtPhHumqkQrrxMw/QlnatbKhru1KETHXmu3H7F77",
+	"civXqgAd98N2A2UhpbxcEc2oAqMXiuM2wxyvoDajf1qWofLLF8Xm3bpukc+vaejkAftIc5d2ntWNWENP",
+	"C/t6V3mKnrXv1+UrzYa4KTTPmTBDLjQTmhtI8LKbBhpIo7wRwsd0nN2hCBMoiccvuuZ2bnFLxab8I9NZ",
+	"vSBorRVGs3VAYC1ZVApEqtQAovBqEI7h1B9UDXE4Qt1wjbIidgBwX9dp2DOqck47MOr/rPG5oB/fMDEz",
+	"8zoJIfzd9zIJOTqu9bdLAHqQb9qKiDK69t8nbiShPXz06IbQohL4YAwayBjzprz35cF4SksNP6YNBh3g",
+	"zqk+p1BziJ3XJeo3RxTfELx2RfwuuNybmwH66uu461u9Qv1NoKPB/yCXKCRV6PpO8vdnQGN33NvTAgIs",
+	"o2zFkBW30oYtWhfg06KIS/3dWWU3ffdlPJJVL/yNR4tia9mLXrS4abxdI9EEuypXoSoGms4U07JSOfsq",
+	"AtPu1cpw4gTAJrmnT0ssbu6XdMJK3R0Q8AYSdjClyUoC+H5kCm5Y/zTm8WIocQb6q54zZqwvRpCnYHA+",
+	"E4YtliWWtAfZ6t2LV3UjBOJqB0UG6VAPPw5K5awsdLJIveLCuJW+sQu703OMc9xa5Mw1CjW0HSW4PRbv",
+	"f84gGaSBqNSVp1nd4zBsC+6sIwyXLOdTnkcHLRWT6eZ5tnpd7ObPP62vK3/Ff844lDSXf/HHiRA9rZtu",
+	"vH7RJVNsVsNtBVD0UlksY+rqxLLKrfTxAzNfIXF8nuqyfn1/jJDXHqTW4kH77iLsTl9T9Eo37kgb7+Dm",
+	"slfr2x/ChcoXIAtuIEI33050WNP2ncQbtafWq8VElnIWknonAeykURzGTOe6wlcHh99F6a71L/9Wveou",
+	"/MILM7doX0iIJgPRZ8k/sk7VVue07ADoYVRe4fDm1RVwu/eXtrPgjeSJZw0C+hNHF/lK5pGIShtSRt+j",
+	"faud/pL9/YLXzzZ4WW/4Z2N7UawPdpYuvtDp8OtxP/0R4xD/6j54k95vHaYrS7nggf4yHEBfQp5MiERq",
+	"I6cnn1m6HnSpGLBT5loYQmetmJFABJe1jMu41QgwkIwYObONoxq9qAiHGiKnzBibBEBKPjUgf7huctZd",
+	"gOPGM8ETOY0nQd52NWeKpVtQeZ70josvhx99uFP7wrsdW+ElcvxghzUzf05bwOnaYXKU9u71276nKIy1",
+	"/bauTczxMfE1ZiX4PLLQRxIOhXeIYE9J/GUhtXEnnhW2H4DecDv7kNov6zTcrcbo19z/egm8zX/51+US",
+	"zoOSWPxEB0LqOBSuEcmmY1ApG3s7qXhZEMWmmfsvCJ0Z+UE2upmE3ifNTicpYv/v8OjOaMtNYUWjhBKE",
+	"64jsPBt7iACSJ60vUp1E1hvQ2MZpjf4zuJWpQw0ulRI4e7UcZINKlYPjwdyY5fH+fgmP5lKb4+9G340G",
+	"nz58+v8DACJw1Z91RwEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
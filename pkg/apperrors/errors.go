@@ -0,0 +1,107 @@
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Kind string
+
+const (
+	KindNotFound          Kind = "not_found"
+	KindConflict          Kind = "conflict"
+	KindInsufficientStock Kind = "insufficient_stock"
+	KindRentalLimit       Kind = "rental_limit"
+	KindValidation        Kind = "validation"
+	KindUnauthorized      Kind = "unauthorized"
+	KindTooManyRequests   Kind = "too_many_requests"
+)
+
+var (
+	ErrNotFound          = &Error{Kind: KindNotFound}
+	ErrConflict          = &Error{Kind: KindConflict}
+	ErrInsufficientStock = &Error{Kind: KindInsufficientStock}
+	ErrRentalLimit       = &Error{Kind: KindRentalLimit}
+	ErrValidation        = &Error{Kind: KindValidation}
+	ErrUnauthorized      = &Error{Kind: KindUnauthorized}
+	ErrTooManyRequests   = &Error{Kind: KindTooManyRequests}
+)
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Fields  []FieldError
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return e.Kind == t.Kind && (t.Code == "" || e.Code == t.Code)
+}
+
+func (e *Error) Wrap(err error) *Error {
+	e.Err = err
+	return e
+}
+
+func (e *Error) WithFields(fields ...FieldError) *Error {
+	e.Fields = append(e.Fields, fields...)
+	return e
+}
+
+func As(err error) (*Error, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+	return nil, false
+}
+
+func NotFound(code, format string, args ...any) *Error {
+	return &Error{Kind: KindNotFound, Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func Conflict(code, format string, args ...any) *Error {
+	return &Error{Kind: KindConflict, Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func InsufficientStock(format string, args ...any) *Error {
+	return &Error{Kind: KindInsufficientStock, Code: "insufficient_stock", Message: fmt.Sprintf(format, args...)}
+}
+
+func RentalLimit(format string, args ...any) *Error {
+	return &Error{Kind: KindRentalLimit, Code: "rental_limit_exceeded", Message: fmt.Sprintf(format, args...)}
+}
+
+func Validation(code, message string, fields ...FieldError) *Error {
+	return &Error{Kind: KindValidation, Code: code, Message: message, Fields: fields}
+}
+
+func Unauthorized(code, format string, args ...any) *Error {
+	return &Error{Kind: KindUnauthorized, Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func TooManyRequests(code, format string, args ...any) *Error {
+	return &Error{Kind: KindTooManyRequests, Code: code, Message: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,105 @@
+// Package barcode encodes the Code 128 and QR codes printed on book labels
+// and student cards.
+package barcode
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+)
+
+type Symbology string
+
+const (
+	Code128 Symbology = "code128"
+	QR      Symbology = "qr"
+)
+
+// Symbol is an encoded barcode: a grid of modules, dark where set. A Code 128
+// symbol has a single row, stretched to any height when drawn.
+type Symbol struct {
+	Symbology Symbology
+	Width     int
+	Height    int
+	// QuietZone is the number of light modules to leave around the symbol
+	// for it to scan.
+	QuietZone int
+	modules   []bool
+}
+
+func newSymbol(symbology Symbology, width, height, quietZone int) *Symbol {
+	return &Symbol{
+		Symbology: symbology,
+		Width:     width,
+		Height:    height,
+		QuietZone: quietZone,
+		modules:   make([]bool, width*height),
+	}
+}
+
+// Dark reports whether the module in column x of row y is dark.
+func (s *Symbol) Dark(x, y int) bool {
+	return s.modules[y*s.Width+x]
+}
+
+func (s *Symbol) set(x, y int, dark bool) {
+	s.modules[y*s.Width+x] = dark
+}
+
+// Runs calls fn for every run of consecutive dark modules in row y, with the
+// column of its first module and its length.
+func (s *Symbol) Runs(y int, fn func(x, length int)) {
+	for x := 0; x < s.Width; {
+		if !s.Dark(x, y) {
+			x++
+			continue
+		}
+		start := x
+		for x < s.Width && s.Dark(x, y) {
+			x++
+		}
+		fn(start, x-start)
+	}
+}
+
+// Encode encodes data in the symbology.
+func Encode(symbology Symbology, data string) (*Symbol, error) {
+	switch symbology {
+	case Code128:
+		return encodeCode128(data)
+	case QR:
+		return encodeQR(data)
+	default:
+		return nil, fmt.Errorf("unknown symbology %q", symbology)
+	}
+}
+
+// Image draws the symbol with its quiet zone, each module scale pixels wide.
+// Code 128 bars are height pixels high; QR modules are square.
+func (s *Symbol) Image(scale, height int) image.Image {
+	rows := s.Height
+	rowHeight := scale
+	if s.Symbology == Code128 {
+		rowHeight = height
+	}
+	quiet := s.QuietZone * scale
+	vertical := quiet
+	if s.Symbology == Code128 {
+		vertical = 0
+	}
+
+	img := image.NewGray(image.Rect(0, 0, s.Width*scale+2*quiet, rows*rowHeight+2*vertical))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	for y := 0; y < rows; y++ {
+		s.Runs(y, func(x, length int) {
+			for py := vertical + y*rowHeight; py < vertical+(y+1)*rowHeight; py++ {
+				for px := quiet + x*scale; px < quiet+(x+length)*scale; px++ {
+					img.SetGray(px, py, color.Gray{})
+				}
+			}
+		})
+	}
+	return img
+}
@@ -0,0 +1,190 @@
+package barcode
+
+import (
+	"bytes"
+	"image/color"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCode128Values(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []int
+	}{
+		{
+			name: "even digits in code set C",
+			data: "00012345",
+			// 105 + 1*0 + 2*1 + 3*23 + 4*45 = 356, 356 mod 103 = 47
+			want: []int{code128StartC, 0, 1, 23, 45, 47, code128Stop},
+		},
+		{
+			name: "text in code set B",
+			data: "AB",
+			// 104 + 1*33 + 2*34 = 205, 205 mod 103 = 102
+			want: []int{code128StartB, 33, 34, 102, code128Stop},
+		},
+		{
+			name: "odd digit before switching to C",
+			data: "A12345",
+			// 104 + 33 + 2*17 + 3*99 + 4*23 + 5*45 = 785, 785 mod 103 = 64
+			want: []int{code128StartB, 33, 17, code128CodeC, 23, 45, 64, code128Stop},
+		},
+		{
+			name: "back to B after digits",
+			data: "1234A",
+			// 105 + 12 + 2*34 + 3*100 + 4*33 = 617, 617 mod 103 = 102
+			want: []int{code128StartC, 12, 34, code128CodeB, 33, 102, code128Stop},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := code128Values(tt.data)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	for _, data := range []string{"", "tab\t", "café"} {
+		if _, err := code128Values(data); err == nil {
+			t.Errorf("expected an error for %q", data)
+		}
+	}
+}
+
+func TestCode128Patterns(t *testing.T) {
+	for value, pattern := range code128Patterns {
+		want := 11
+		if value == code128Stop {
+			want = 13
+		}
+		sum := 0
+		for _, w := range pattern {
+			sum += int(w - '0')
+		}
+		if sum != want {
+			t.Errorf("pattern %d is %d modules wide, expected %d", value, sum, want)
+		}
+	}
+
+	symbol, err := Encode(Code128, "00012345")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Start, four values and the checksum of 11 modules, and the stop code.
+	if symbol.Width != 6*11+13 || symbol.Height != 1 {
+		t.Errorf("expected a 79x1 symbol, got %dx%d", symbol.Width, symbol.Height)
+	}
+}
+
+func TestReedSolomon(t *testing.T) {
+	// HELLO WORLD at version 1-M, from the worked example of the standard.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	if got := rsRemainder(data, rsDivisor(10)); !bytes.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestEncodeQR(t *testing.T) {
+	symbol, err := Encode(QR, "BRS-00000042")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		"#######.##.##.#######",
+		"#.....#.#.#.#.#.....#",
+		"#.###.#...###.#.###.#",
+		"#.###.#.#..##.#.###.#",
+		"#.###.#..##...#.###.#",
+		"#.....#...#...#.....#",
+		"#######.#.#.#.#######",
+		"........##...........",
+		"#.##.###..#...#..#.##",
+		"####.#.##.#.#...#.##.",
+		".#.##.##..#.#.#..####",
+		"#..##....##.##..##.##",
+		".##...#..###.###.##..",
+		"........##.#..####..#",
+		"#######.#....#..###..",
+		"#.....#.#.#....#..#..",
+		"#.###.#....#.#...###.",
+		"#.###.#.#.####.#.###.",
+		"#.###.#.##..#..#.##..",
+		"#.....#..#.#..#..#..#",
+		"#######.#.##..#.###..",
+	}
+	if symbol.Width != len(want) || symbol.Height != len(want) {
+		t.Fatalf("expected a version 1 symbol, got %dx%d", symbol.Width, symbol.Height)
+	}
+	for y, row := range want {
+		var got strings.Builder
+		for x := range symbol.Width {
+			if symbol.Dark(x, y) {
+				got.WriteByte('#')
+			} else {
+				got.WriteByte('.')
+			}
+		}
+		if got.String() != row {
+			t.Errorf("row %d: expected %s, got %s", y, row, got.String())
+		}
+	}
+}
+
+func TestEncodeQRVersions(t *testing.T) {
+	tests := []struct {
+		length int
+		size   int
+	}{
+		{length: 14, size: 21},
+		{length: 15, size: 25},
+		{length: 122, size: 45},
+		{length: 213, size: 57},
+	}
+	for _, tt := range tests {
+		symbol, err := Encode(QR, strings.Repeat("x", tt.length))
+		if err != nil {
+			t.Fatalf("unexpected error for %d bytes: %v", tt.length, err)
+		}
+		if symbol.Width != tt.size {
+			t.Errorf("expected %d bytes to fit %d modules, got %d", tt.length, tt.size, symbol.Width)
+		}
+	}
+
+	if _, err := Encode(QR, strings.Repeat("x", 214)); err == nil {
+		t.Errorf("expected an error for 214 bytes")
+	}
+	if _, err := Encode("ean13", "123"); err == nil {
+		t.Errorf("expected an error for an unknown symbology")
+	}
+}
+
+func TestImage(t *testing.T) {
+	symbol, err := Encode(QR, "BRS-00000042")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	img := symbol.Image(2, 0)
+	if size := (21 + 2*qrQuietZone) * 2; img.Bounds().Dx() != size || img.Bounds().Dy() != size {
+		t.Errorf("expected a %dx%d image, got %v", size, size, img.Bounds())
+	}
+	if img.At(0, 0) != (color.Gray{Y: 0xff}) || img.At(2*qrQuietZone, 2*qrQuietZone) != (color.Gray{}) {
+		t.Errorf("expected a light quiet zone around a dark finder pattern")
+	}
+
+	symbol, err = Encode(Code128, "AB")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	img = symbol.Image(1, 40)
+	if img.Bounds().Dx() != symbol.Width+2*code128QuietZone || img.Bounds().Dy() != 40 {
+		t.Errorf("expected bars 40 pixels high, got %v", img.Bounds())
+	}
+}
@@ -0,0 +1,124 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+)
+
+// code128Patterns holds the widths of the alternating bars and spaces of
+// every Code 128 symbol value, starting with a bar. 103 to 105 are the start
+// codes for code sets A, B and C, 106 is the stop code.
+var code128Patterns = [107]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+const (
+	code128CodeB  = 100
+	code128CodeC  = 99
+	code128StartB = 104
+	code128StartC = 105
+	code128Stop   = 106
+
+	code128QuietZone = 10
+)
+
+// code128Values encodes data in code set B, switching to code set C, which
+// packs two digits into a symbol, for runs of digits long enough to save
+// space.
+func code128Values(data string) ([]int, error) {
+	if data == "" {
+		return nil, errors.New("nothing to encode")
+	}
+	for _, r := range data {
+		if r < ' ' || r > '~' {
+			return nil, fmt.Errorf("cannot encode %q in Code 128", r)
+		}
+	}
+
+	digits := func(i int) int {
+		n := 0
+		for i+n < len(data) && data[i+n] >= '0' && data[i+n] <= '9' {
+			n++
+		}
+		return n
+	}
+
+	var values []int
+	setC := false
+	if n := digits(0); n == len(data) && n%2 == 0 || n >= 4 {
+		values = append(values, code128StartC)
+		setC = true
+	} else {
+		values = append(values, code128StartB)
+	}
+
+	for i := 0; i < len(data); {
+		n := digits(i)
+		if setC {
+			if n >= 2 {
+				values = append(values, int(data[i]-'0')*10+int(data[i+1]-'0'))
+				i += 2
+				continue
+			}
+			values = append(values, code128CodeB)
+			setC = false
+		}
+		// Switching costs a symbol, so only runs of six digits, or four at
+		// the end, are worth it. An odd digit is encoded before the switch.
+		if n >= 6 || n >= 4 && i+n == len(data) {
+			if n%2 == 1 {
+				values = append(values, int(data[i]-' '))
+				i++
+			}
+			values = append(values, code128CodeC)
+			setC = true
+			continue
+		}
+		values = append(values, int(data[i]-' '))
+		i++
+	}
+
+	checksum := values[0]
+	for i, value := range values[1:] {
+		checksum += (i + 1) * value
+	}
+	return append(values, checksum%103, code128Stop), nil
+}
+
+func encodeCode128(data string) (*Symbol, error) {
+	values, err := code128Values(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var widths []int
+	for _, value := range values {
+		for _, w := range code128Patterns[value] {
+			widths = append(widths, int(w-'0'))
+		}
+	}
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+
+	symbol := newSymbol(Code128, total, 1, code128QuietZone)
+	x := 0
+	for i, w := range widths {
+		for range w {
+			symbol.set(x, 0, i%2 == 0)
+			x++
+		}
+	}
+	return symbol, nil
+}
@@ -0,0 +1,461 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+)
+
+// QR codes are encoded in byte mode at error correction level M, which
+// restores up to 15% of a damaged code. Versions 1 to 10 hold up to 213
+// bytes, plenty for the identifiers on labels.
+
+const qrQuietZone = 4
+
+// qrBlocks describes the error correction blocks of a version at level M:
+// the error correction codewords per block, and the number of blocks with
+// dataShort and dataShort+1 data codewords.
+type qrBlocks struct {
+	ecc       int
+	short     int
+	dataShort int
+	long      int
+}
+
+var qrVersions = [...]qrBlocks{
+	1:  {ecc: 10, short: 1, dataShort: 16},
+	2:  {ecc: 16, short: 1, dataShort: 28},
+	3:  {ecc: 26, short: 1, dataShort: 44},
+	4:  {ecc: 18, short: 2, dataShort: 32},
+	5:  {ecc: 24, short: 2, dataShort: 43},
+	6:  {ecc: 16, short: 4, dataShort: 27},
+	7:  {ecc: 18, short: 4, dataShort: 31},
+	8:  {ecc: 22, short: 2, dataShort: 38, long: 2},
+	9:  {ecc: 22, short: 3, dataShort: 36, long: 2},
+	10: {ecc: 26, short: 4, dataShort: 43, long: 1},
+}
+
+var qrAlignment = [...][]int{
+	2:  {6, 18},
+	3:  {6, 22},
+	4:  {6, 26},
+	5:  {6, 30},
+	6:  {6, 34},
+	7:  {6, 22, 38},
+	8:  {6, 24, 42},
+	9:  {6, 26, 46},
+	10: {6, 28, 50},
+}
+
+func (b qrBlocks) dataCodewords() int {
+	return b.short*b.dataShort + b.long*(b.dataShort+1)
+}
+
+func encodeQR(data string) (*Symbol, error) {
+	if data == "" {
+		return nil, errors.New("nothing to encode")
+	}
+
+	version := 0
+	for v := 1; v < len(qrVersions); v++ {
+		if qrCapacity(v) >= len(data) {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("%d bytes are too long for a QR code, at most %d fit", len(data), qrCapacity(len(qrVersions)-1))
+	}
+
+	codewords := qrCodewords(version, qrDataCodewords(version, []byte(data)))
+
+	q := newQRMatrix(version)
+	q.drawFunctionPatterns()
+	q.drawCodewords(codewords)
+
+	// The mask that leaves the fewest patterns confusing to a scanner wins.
+	best, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		q.applyMask(mask)
+		q.drawFormatBits(mask)
+		if penalty := q.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			best, bestPenalty = mask, penalty
+		}
+		q.applyMask(mask)
+	}
+	q.applyMask(best)
+	q.drawFormatBits(best)
+
+	return q.Symbol, nil
+}
+
+// qrCapacity returns the number of bytes a version holds.
+func qrCapacity(version int) int {
+	bits := qrVersions[version].dataCodewords()*8 - 4 - qrCountBits(version)
+	return bits / 8
+}
+
+func qrCountBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+	return 16
+}
+
+// qrDataCodewords lays out the data segment: the byte mode indicator, the
+// length, the bytes, a terminator and alternating pad codewords.
+func qrDataCodewords(version int, data []byte) []byte {
+	capacity := qrVersions[version].dataCodewords()
+	var bits bitBuffer
+	bits.append(0b0100, 4)
+	bits.append(len(data), qrCountBits(version))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+	bits.append(0, min(4, capacity*8-len(bits)))
+	bits.append(0, (8-len(bits)%8)%8)
+
+	codewords := bits.bytes()
+	for pad := byte(0xec); len(codewords) < capacity; pad ^= 0xec ^ 0x11 {
+		codewords = append(codewords, pad)
+	}
+	return codewords
+}
+
+// qrCodewords splits the data into blocks, adds the error correction
+// codewords of each block and interleaves the blocks.
+func qrCodewords(version int, data []byte) []byte {
+	layout := qrVersions[version]
+	divisor := rsDivisor(layout.ecc)
+
+	var blocks, eccs [][]byte
+	for i := 0; i < layout.short+layout.long; i++ {
+		size := layout.dataShort
+		if i >= layout.short {
+			size++
+		}
+		blocks = append(blocks, data[:size])
+		eccs = append(eccs, rsRemainder(data[:size], divisor))
+		data = data[size:]
+	}
+
+	var result []byte
+	for i := 0; i <= layout.dataShort; i++ {
+		for _, block := range blocks {
+			if i < len(block) {
+				result = append(result, block[i])
+			}
+		}
+	}
+	for i := 0; i < layout.ecc; i++ {
+		for _, ecc := range eccs {
+			result = append(result, ecc[i])
+		}
+	}
+	return result
+}
+
+type qrMatrix struct {
+	*Symbol
+	version  int
+	size     int
+	function []bool
+}
+
+func newQRMatrix(version int) *qrMatrix {
+	size := version*4 + 17
+	return &qrMatrix{
+		Symbol:   newSymbol(QR, size, size, qrQuietZone),
+		version:  version,
+		size:     size,
+		function: make([]bool, size*size),
+	}
+}
+
+func (q *qrMatrix) setFunction(x, y int, dark bool) {
+	q.set(x, y, dark)
+	q.function[y*q.size+x] = true
+}
+
+func (q *qrMatrix) drawFunctionPatterns() {
+	for i := 0; i < q.size; i++ {
+		q.setFunction(6, i, i%2 == 0)
+		q.setFunction(i, 6, i%2 == 0)
+	}
+
+	// Finder patterns with their light separators in three corners.
+	for _, corner := range [][2]int{{3, 3}, {q.size - 4, 3}, {3, q.size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := corner[0]+dx, corner[1]+dy
+				if x < 0 || x >= q.size || y < 0 || y >= q.size {
+					continue
+				}
+				dist := max(abs(dx), abs(dy))
+				q.setFunction(x, y, dist != 2 && dist != 4)
+			}
+		}
+	}
+
+	// Alignment patterns everywhere on the grid but over the finders.
+	positions := qrAlignment[q.version]
+	last := len(positions) - 1
+	for i, cy := range positions {
+		for j, cx := range positions {
+			if i == 0 && j == 0 || i == 0 && j == last || i == last && j == 0 {
+				continue
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					q.setFunction(cx+dx, cy+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	// Reserve the format areas; they are filled in once the mask is known.
+	q.drawFormatBits(0)
+
+	if q.version >= 7 {
+		rem := q.version
+		for range 12 {
+			rem = rem<<1 ^ (rem>>11)*0x1f25
+		}
+		bits := q.version<<12 | rem
+		for i := 0; i < 18; i++ {
+			dark := bits>>i&1 == 1
+			a, b := q.size-11+i%3, i/3
+			q.setFunction(a, b, dark)
+			q.setFunction(b, a, dark)
+		}
+	}
+}
+
+// drawFormatBits writes both copies of the error correction level and mask.
+func (q *qrMatrix) drawFormatBits(mask int) {
+	// Level M is encoded as 00.
+	data := mask
+	rem := data
+	for range 10 {
+		rem = rem<<1 ^ (rem>>9)*0x537
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+	bit := func(i int) bool { return bits>>i&1 == 1 }
+
+	for i := 0; i <= 5; i++ {
+		q.setFunction(8, i, bit(i))
+	}
+	q.setFunction(8, 7, bit(6))
+	q.setFunction(8, 8, bit(7))
+	q.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		q.setFunction(14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		q.setFunction(q.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		q.setFunction(8, q.size-15+i, bit(i))
+	}
+	q.setFunction(8, q.size-8, true)
+}
+
+// drawCodewords fills the modules outside the function patterns in the
+// zigzag order of the standard, two columns at a time from the bottom right.
+func (q *qrMatrix) drawCodewords(codewords []byte) {
+	i := 0
+	for right := q.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		upward := (right+1)&2 == 0
+		for vert := 0; vert < q.size; vert++ {
+			y := vert
+			if upward {
+				y = q.size - 1 - vert
+			}
+			for j := 0; j < 2; j++ {
+				x := right - j
+				if q.function[y*q.size+x] || i >= len(codewords)*8 {
+					continue
+				}
+				q.set(x, y, codewords[i>>3]>>(7-i&7)&1 == 1)
+				i++
+			}
+		}
+	}
+}
+
+// applyMask inverts the data modules selected by the mask. Applying the same
+// mask twice undoes it.
+func (q *qrMatrix) applyMask(mask int) {
+	for y := 0; y < q.size; y++ {
+		for x := 0; x < q.size; x++ {
+			if q.function[y*q.size+x] {
+				continue
+			}
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if invert {
+				q.set(x, y, !q.Dark(x, y))
+			}
+		}
+	}
+}
+
+// penalty scores the matrix by the rules of the standard: long runs of one
+// color, 2x2 blocks, patterns that look like finders and an uneven balance
+// of dark and light modules.
+func (q *qrMatrix) penalty() int {
+	penalty := 0
+	line := make([]bool, q.size)
+	for _, horizontal := range []bool{true, false} {
+		for a := 0; a < q.size; a++ {
+			for b := 0; b < q.size; b++ {
+				if horizontal {
+					line[b] = q.Dark(b, a)
+				} else {
+					line[b] = q.Dark(a, b)
+				}
+			}
+			penalty += linePenalty(line)
+		}
+	}
+
+	dark := 0
+	for y := 0; y < q.size; y++ {
+		for x := 0; x < q.size; x++ {
+			if q.Dark(x, y) {
+				dark++
+			}
+			if x+1 < q.size && y+1 < q.size {
+				c := q.Dark(x, y)
+				if q.Dark(x+1, y) == c && q.Dark(x, y+1) == c && q.Dark(x+1, y+1) == c {
+					penalty += 3
+				}
+			}
+		}
+	}
+	total := q.size * q.size
+	penalty += abs(dark*20-total*10) / total * 10
+	return penalty
+}
+
+var finderLike = [2][11]bool{
+	{true, false, true, true, true, false, true, false, false, false, false},
+	{false, false, false, false, true, false, true, true, true, false, true},
+}
+
+func linePenalty(line []bool) int {
+	penalty := 0
+	run := 1
+	for i := 1; i <= len(line); i++ {
+		if i < len(line) && line[i] == line[i-1] {
+			run++
+			continue
+		}
+		if run >= 5 {
+			penalty += run - 2
+		}
+		run = 1
+	}
+
+	for i := 0; i+11 <= len(line); i++ {
+		for _, pattern := range finderLike {
+			match := true
+			for j, dark := range pattern {
+				if line[i+j] != dark {
+					match = false
+					break
+				}
+			}
+			if match {
+				penalty += 40
+			}
+		}
+	}
+	return penalty
+}
+
+type bitBuffer []bool
+
+func (b *bitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		*b = append(*b, value>>i&1 == 1)
+	}
+}
+
+func (b bitBuffer) bytes() []byte {
+	result := make([]byte, (len(b)+7)/8)
+	for i, bit := range b {
+		if bit {
+			result[i>>3] |= 0x80 >> (i & 7)
+		}
+	}
+	return result
+}
+
+// rsDivisor returns the Reed-Solomon generator polynomial of the degree,
+// without its leading coefficient.
+func rsDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+func rsRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i := range result {
+			result[i] ^= gfMultiply(divisor[i], factor)
+		}
+	}
+	return result
+}
+
+// gfMultiply multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1.
+func gfMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = z<<1 ^ (z>>7)*0x11d
+		z ^= int(y>>i&1) * int(x)
+	}
+	return byte(z)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,24 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	fake := NewFake(start)
+
+	fake.Advance(36 * time.Hour)
+	if want := start.Add(36 * time.Hour); !fake.Now().Equal(want) {
+		t.Errorf("expected %s after advancing, got %s", want, fake.Now())
+	}
+
+	shifted := Offset{Base: fake, Offset: -24 * time.Hour}
+	if want := start.Add(12 * time.Hour); !shifted.Now().Equal(want) {
+		t.Errorf("expected the offset clock at %s, got %s", want, shifted.Now())
+	}
+	if want := fake.Now(); !Wall(shifted).Equal(want) {
+		t.Errorf("expected the wall time %s, got %s", want, Wall(shifted))
+	}
+}
@@ -2,6 +2,9 @@ package config
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -11,15 +14,104 @@ type AppConfig struct {
 	Database  DatabaseConfig  `mapstructure:"database"`
 	Librarian LibrarianConfig `mapstructure:"librarian"`
 	Rent      RentalConfig    `mapstructure:"rent"`
+	OIDC      OIDCConfig      `mapstructure:"oidc"`
+	Cookie    CookieConfig    `mapstructure:"cookie"`
+	CSRF      CSRFConfig      `mapstructure:"csrf"`
+	Log       LogConfig       `mapstructure:"log"`
+	Metrics   MetricsConfig   `mapstructure:"metrics"`
+	Tracing   TracingConfig   `mapstructure:"tracing"`
+	Reports   ReportsConfig   `mapstructure:"reports"`
+	Portal    PortalConfig    `mapstructure:"portal"`
+	Receipts  ReceiptsConfig  `mapstructure:"receipts"`
 }
 
 type ServerConfig struct {
-	Port string `mapstructure:"port"`
-	Env  string `mapstructure:"env"`
+	Port              string        `mapstructure:"port"`
+	Env               string        `mapstructure:"env"`
+	AllowedOrigins    []string      `mapstructure:"allowed_origins"`
+	ReadTimeout       time.Duration `mapstructure:"read_timeout"`
+	ReadHeaderTimeout time.Duration `mapstructure:"read_header_timeout"`
+	WriteTimeout      time.Duration `mapstructure:"write_timeout"`
+	IdleTimeout       time.Duration `mapstructure:"idle_timeout"`
+	ShutdownTimeout   time.Duration `mapstructure:"shutdown_timeout"`
+	MaxHeaderBytes    int           `mapstructure:"max_header_bytes"`
+	TLS               TLSConfig     `mapstructure:"tls"`
+	// ClockOffset shifts the server time, to rehearse overdue scenarios.
+	// Only allowed in dev.
+	ClockOffset time.Duration `mapstructure:"clock_offset"`
+}
+
+type TLSConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	CertFile     string `mapstructure:"cert_file"`
+	KeyFile      string `mapstructure:"key_file"`
+	RedirectPort string `mapstructure:"redirect_port"`
 }
 
 type DatabaseConfig struct {
-	DSN string `mapstructure:"dsn"`
+	DSN           string        `mapstructure:"dsn"`
+	LogLevel      string        `mapstructure:"log_level"`
+	SlowThreshold time.Duration `mapstructure:"slow_threshold"`
+}
+
+type MetricsConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	Path            string        `mapstructure:"path"`
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+type TracingConfig struct {
+	Enabled     bool    `mapstructure:"enabled"`
+	ServiceName string  `mapstructure:"service_name"`
+	Exporter    string  `mapstructure:"exporter"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	File        string  `mapstructure:"file"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+// ReportsConfig configures the delivery of saved reports. Email delivery is
+// available when SMTP.Host is set, directory delivery when OutputDir is.
+type ReportsConfig struct {
+	OutputDir string        `mapstructure:"output_dir"`
+	Retention time.Duration `mapstructure:"retention"`
+	SMTP      SMTPConfig    `mapstructure:"smtp"`
+}
+
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     string `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+	StartTLS bool   `mapstructure:"starttls"`
+}
+
+// PortalConfig configures the sign-in of students to the self-service
+// portal. Sign-in links are sent only when LinkURL and reports.smtp.host are
+// set.
+type PortalConfig struct {
+	CookieName     string        `mapstructure:"cookie_name"`
+	SessionTTL     time.Duration `mapstructure:"session_ttl"`
+	MaxPinAttempts int           `mapstructure:"max_pin_attempts"`
+	Lockout        time.Duration `mapstructure:"lockout"`
+	LinkURL        string        `mapstructure:"link_url"`
+	LinkTTL        time.Duration `mapstructure:"link_ttl"`
+}
+
+// ReceiptsConfig configures the printed receipts. Logo is the path of a PNG
+// or JPEG image printed on PDF receipts; TextWidth is the line length of text
+// receipts, 32 or 42 characters on most thermal printers.
+type ReceiptsConfig struct {
+	LibraryName string `mapstructure:"library_name"`
+	Logo        string `mapstructure:"logo"`
+	Paper       string `mapstructure:"paper"`
+	TextWidth   int    `mapstructure:"text_width"`
+}
+
+type LogConfig struct {
+	Level  string `mapstructure:"level"`
+	Format string `mapstructure:"format"`
 }
 
 type LibrarianConfig struct {
@@ -31,18 +123,119 @@ type RentalConfig struct {
 	RentalDays int `mapstructure:"rental_days"`
 }
 
+type OIDCConfig struct {
+	Enabled           bool              `mapstructure:"enabled"`
+	Issuer            string            `mapstructure:"issuer"`
+	ClientID          string            `mapstructure:"client_id"`
+	ClientSecret      string            `mapstructure:"client_secret"`
+	RedirectURL       string            `mapstructure:"redirect_url"`
+	PostLoginRedirect string            `mapstructure:"post_login_redirect"`
+	Scopes            []string          `mapstructure:"scopes"`
+	UsernameClaim     string            `mapstructure:"username_claim"`
+	GroupsClaim       string            `mapstructure:"groups_claim"`
+	RoleMapping       map[string]string `mapstructure:"role_mapping"`
+	DefaultRole       string            `mapstructure:"default_role"`
+}
+
+type CookieConfig struct {
+	Name     string `mapstructure:"name"`
+	Domain   string `mapstructure:"domain"`
+	Secure   bool   `mapstructure:"secure"`
+	SameSite string `mapstructure:"same_site"`
+}
+
+type CSRFConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	CookieName string `mapstructure:"cookie_name"`
+	HeaderName string `mapstructure:"header_name"`
+}
+
+func (c CookieConfig) SameSiteMode() (http.SameSite, error) {
+	switch strings.ToLower(c.SameSite) {
+	case "", "lax":
+		return http.SameSiteLaxMode, nil
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "none":
+		if !c.Secure {
+			return 0, fmt.Errorf("cookie.same_site none requires cookie.secure to be true")
+		}
+		return http.SameSiteNoneMode, nil
+	default:
+		return 0, fmt.Errorf("invalid cookie.same_site %q: must be lax, strict or none", c.SameSite)
+	}
+}
+
+func SetDefaults(v *viper.Viper) {
+	v.SetDefault("server.port", "8080")
+	v.SetDefault("server.env", "dev")
+	v.SetDefault("server.allowed_origins", []string{"http://localhost:5173", "https://*.onrender.com"})
+	v.SetDefault("server.read_timeout", "15s")
+	v.SetDefault("server.read_header_timeout", "5s")
+	v.SetDefault("server.write_timeout", "30s")
+	v.SetDefault("server.idle_timeout", "60s")
+	v.SetDefault("server.shutdown_timeout", "5s")
+	v.SetDefault("server.max_header_bytes", 1<<20)
+	v.SetDefault("server.clock_offset", "0s")
+	v.SetDefault("database.log_level", "warn")
+	v.SetDefault("database.slow_threshold", "200ms")
+	v.SetDefault("log.level", "info")
+	v.SetDefault("log.format", "text")
+	v.SetDefault("metrics.enabled", true)
+	v.SetDefault("metrics.path", "/metrics")
+	v.SetDefault("metrics.refresh_interval", "30s")
+	v.SetDefault("tracing.service_name", "brs-backend")
+	v.SetDefault("tracing.exporter", "otlp")
+	v.SetDefault("tracing.file", "traces.jsonl")
+	v.SetDefault("tracing.sample_ratio", 1.0)
+	v.SetDefault("rent.rental_days", 7)
+	v.SetDefault("reports.retention", "2160h")
+	v.SetDefault("reports.smtp.port", "587")
+	v.SetDefault("reports.smtp.starttls", true)
+	v.SetDefault("portal.cookie_name", "student_session")
+	v.SetDefault("portal.session_ttl", "12h")
+	v.SetDefault("portal.max_pin_attempts", 5)
+	v.SetDefault("portal.lockout", "15m")
+	v.SetDefault("portal.link_ttl", "15m")
+	v.SetDefault("receipts.library_name", "Library")
+	v.SetDefault("receipts.paper", "a4")
+	v.SetDefault("receipts.text_width", 42)
+	v.SetDefault("oidc.scopes", []string{"openid", "profile", "email"})
+	v.SetDefault("oidc.username_claim", "preferred_username")
+	v.SetDefault("oidc.groups_claim", "groups")
+	v.SetDefault("oidc.post_login_redirect", "/")
+	v.SetDefault("cookie.name", "session_id")
+	v.SetDefault("cookie.same_site", "lax")
+	v.SetDefault("csrf.enabled", true)
+	v.SetDefault("csrf.cookie_name", "csrf_token")
+	v.SetDefault("csrf.header_name", "X-CSRF-Token")
+}
+
 func LoadConfig(path string) (*AppConfig, error) {
-	viper.SetConfigFile(path)
-	viper.SetConfigType("yaml")
+	v := viper.New()
+	SetDefaults(v)
+
+	if path != "" {
+		v.SetConfigFile(path)
+		v.SetConfigType("yaml")
 
-	if err := viper.ReadInConfig(); err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+	}
+
+	if err := bindEnv(v); err != nil {
+		return nil, err
 	}
 
 	var config AppConfig
-	if err := viper.Unmarshal(&config); err != nil {
+	if err := v.Unmarshal(&config); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
 	return &config, nil
 }
@@ -2,20 +2,22 @@ package config
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
 
+	"BRSBackend/pkg/apperrors"
 	"BRSBackend/pkg/services"
 )
 
 func SeedLibrarian(authService services.AuthService, user, pass string) {
 	if err := authService.CreateLibrarian(context.Background(), user, pass); err != nil {
-		if err.Error() != "librarian already exists" {
-			log.Printf("Failed to create librarian %s: %v", user, err)
+		if !errors.Is(err, apperrors.ErrConflict) {
+			slog.Error("Failed to create librarian", "user", user, "error", err)
 		} else {
-			log.Printf("Librarian %s already exists", user)
+			slog.Info("Librarian already exists", "user", user)
 		}
 	} else {
-		log.Printf("Librarian %s created successfully", user)
+		slog.Info("Librarian created successfully", "user", user)
 	}
 }
 
@@ -3,16 +3,18 @@ package config
 import (
 	"database/sql"
 	"fmt"
-	"log"
-	"os"
+	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	sqliteGo "github.com/mattn/go-sqlite3"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
+	"gorm.io/gorm/clause"
 
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/logging"
 	"BRSBackend/pkg/models"
 )
 
@@ -20,38 +22,43 @@ type Database struct {
 	DB *gorm.DB
 }
 
-func NewDatabase(dbPath string) (*Database, error) {
+// registerDriver registers the driver once, as sql.Register panics on a
+// second registration when several databases are opened.
+var registerDriver sync.Once
+
+func NewDatabase(cfg DatabaseConfig, logger *slog.Logger, clock clock.Clock) (*Database, error) {
+	dbPath := cfg.DSN
 
 	const CustomDriverName = "sqlite3_extended"
-	sql.Register(CustomDriverName,
-		&sqliteGo.SQLiteDriver{
-			ConnectHook: func(conn *sqliteGo.SQLiteConn) error {
-				err := conn.RegisterFunc(
-					"gen_random_uuid",
-					func(arguments ...interface{}) (string, error) {
-						return uuid.New().String(), nil
-					},
-					true,
-				)
-				return err
+	registerDriver.Do(func() {
+		sql.Register(CustomDriverName,
+			&sqliteGo.SQLiteDriver{
+				ConnectHook: func(conn *sqliteGo.SQLiteConn) error {
+					// Not pure: SQLite would otherwise evaluate it once for all
+					// rows of a multi-row INSERT.
+					err := conn.RegisterFunc(
+						"gen_random_uuid",
+						func(arguments ...interface{}) (string, error) {
+							return uuid.New().String(), nil
+						},
+						false,
+					)
+					return err
+				},
 			},
-		},
-	)
+		)
+	})
 
 	conn, err := sql.Open(CustomDriverName, dbPath)
 	if err != nil {
 		panic(err)
 	}
 
-	newLogger := logger.New(
-		log.New(os.Stdout, "\r\n", log.LstdFlags),
-		logger.Config{
-			SlowThreshold:             time.Second,
-			LogLevel:                  logger.Info,
-			IgnoreRecordNotFoundError: true,
-			Colorful:                  true,
-		},
-	)
+	logLevel, err := logging.ParseGormLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+	newLogger := logging.NewGormLogger(logger, logLevel, cfg.SlowThreshold)
 
 	db, err := gorm.Open(sqlite.Dialector{
 		DriverName: CustomDriverName,
@@ -60,7 +67,11 @@ func NewDatabase(dbPath string) (*Database, error) {
 	}, &gorm.Config{
 		Logger:                   newLogger,
 		SkipDefaultTransaction:   true,
+		TranslateError:           true,
 		DisableNestedTransaction: true,
+		NowFunc: func() time.Time {
+			return clock.Now().Local()
+		},
 	})
 
 	if err != nil {
@@ -79,6 +90,15 @@ func NewDatabase(dbPath string) (*Database, error) {
 	return &Database{DB: db}, nil
 }
 
+// dayIndexes cover the date ranges of the circulation analytics, which
+// compare timestamps as julian days. Expression indexes are created by hand.
+var dayIndexes = []string{
+	"CREATE INDEX IF NOT EXISTS idx_carts_created_day ON carts(julianday(created_at))",
+	"CREATE INDEX IF NOT EXISTS idx_carts_updated_day ON carts(julianday(updated_at))",
+	"CREATE INDEX IF NOT EXISTS idx_students_created_day ON students(julianday(created_at))",
+	"CREATE INDEX IF NOT EXISTS idx_return_events_returned_day ON return_events(julianday(returned_at))",
+}
+
 func (db *Database) AutoMigrate() error {
 	if err := db.DB.Exec("PRAGMA foreign_keys = ON").Error; err != nil {
 		return fmt.Errorf("failed to enable foreign keys: %w", err)
@@ -87,16 +107,160 @@ func (db *Database) AutoMigrate() error {
 	err := db.DB.AutoMigrate(
 		&models.Librarian{},
 		&models.Book{},
+		&models.Category{},
 		&models.Student{},
 		&models.Cart{},
 		&models.Rent{},
 		&models.Session{},
+		&models.ReturnEvent{},
+		&models.CirculationDaily{},
+		&models.ReportSchedule{},
+		&models.ReportRun{},
+		&models.ReportFile{},
+		&models.StudentCredential{},
+		&models.StudentSession{},
+		&models.StudentLoginToken{},
+		&models.KioskDevice{},
+		&models.KioskTransaction{},
+		&models.SchemaMigration{},
+		&models.Sequence{},
 	)
 	if err != nil {
 		return fmt.Errorf("failed to migrate database: %w", err)
 	}
 
-	log.Println("Database migrated successfully")
+	for _, statement := range dayIndexes {
+		if err := db.DB.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to create index: %w", err)
+		}
+	}
+
+	var applied int
+	if err := db.DB.Model(&models.SchemaMigration{}).Select("COALESCE(MAX(version), 0)").Scan(&applied).Error; err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+	// Databases created before schema_migrations existed read as version 0.
+	if applied < 2 {
+		if err := db.backfillStudentSearch(); err != nil {
+			return err
+		}
+	}
+
+	// Books of databases of any earlier version may lack a barcode.
+	if applied < 9 {
+		if err := db.backfillBookBarcodes(); err != nil {
+			return err
+		}
+	}
+	if err := db.seedBookBarcodeSequence(); err != nil {
+		return err
+	}
+
+	migration := models.SchemaMigration{Version: models.SchemaVersion, AppliedAt: time.Now()}
+	if err := db.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&migration).Error; err != nil {
+		return fmt.Errorf("failed to record schema version: %w", err)
+	}
+
+	// Without full statistics SQLite prefers the deleted_at indexes, in which
+	// every live row shares the NULL key, over the search and date indexes.
+	if err := db.DB.Exec("ANALYZE").Error; err != nil {
+		return fmt.Errorf("failed to analyze database: %w", err)
+	}
+
+	slog.Info("Database migrated successfully", "schema_version", models.SchemaVersion)
+	return nil
+}
+
+// backfillStudentSearch fills the folded search columns added in schema
+// version 2 for students created before them.
+func (db *Database) backfillStudentSearch() error {
+	const batchSize = 500
+
+	for offset := 0; ; offset += batchSize {
+		var students []*models.Student
+		if err := db.DB.Unscoped().Order("id").Limit(batchSize).Offset(offset).Find(&students).Error; err != nil {
+			return fmt.Errorf("failed to load students for search backfill: %w", err)
+		}
+		err := db.DB.Transaction(func(tx *gorm.DB) error {
+			for _, student := range students {
+				_ = student.BeforeSave(tx)
+				if err := tx.Unscoped().Model(&models.Student{}).Where("id = ?", student.Id).
+					Select("search_first_name", "search_last_name", "search_card_id", "search_phone", "search_phone_rev", "search_major").
+					UpdateColumns(student).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to backfill student search columns: %w", err)
+		}
+		if len(students) < batchSize {
+			break
+		}
+	}
+
+	slog.Info("Backfilled student search columns")
+	return nil
+}
+
+// backfillBookBarcodes gives the books without a barcode generated ones, in
+// the order they were added, from schema version 9 on.
+func (db *Database) backfillBookBarcodes() error {
+	const batchSize = 500
+
+	last, err := models.LastBookBarcode(db.DB)
+	if err != nil {
+		return fmt.Errorf("failed to read last barcode: %w", err)
+	}
+
+	total := 0
+	for {
+		// Filled rows drop out of the query, so every batch starts at the top.
+		var books []*models.Book
+		if err := db.DB.Unscoped().Where("barcode IS NULL").Order("created_at, id").Limit(batchSize).Find(&books).Error; err != nil {
+			return fmt.Errorf("failed to load books for barcode backfill: %w", err)
+		}
+		err := db.DB.Transaction(func(tx *gorm.DB) error {
+			for _, book := range books {
+				last++
+				if err := tx.Unscoped().Model(&models.Book{}).Where("id = ?", book.Id).
+					UpdateColumn("barcode", models.BookBarcode(last)).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to backfill book barcodes: %w", err)
+		}
+		total += len(books)
+		if len(books) < batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		slog.Info("Backfilled book barcodes", "count", total)
+	}
+	return nil
+}
+
+// seedBookBarcodeSequence starts the sequence of generated barcodes after the
+// highest one in use. It only ever moves the sequence forward, so it can run
+// on every start.
+func (db *Database) seedBookBarcodeSequence() error {
+	last, err := models.LastBookBarcode(db.DB)
+	if err != nil {
+		return fmt.Errorf("failed to read last barcode: %w", err)
+	}
+	sequence := models.Sequence{Name: models.BookBarcodeSequence, Value: last}
+	if err := db.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.Set{{Column: clause.Column{Name: "value"}, Value: gorm.Expr("MAX(sequences.value, excluded.value)")}},
+	}).Create(&sequence).Error; err != nil {
+		return fmt.Errorf("failed to seed barcode sequence: %w", err)
+	}
 	return nil
 }
 
@@ -112,7 +276,7 @@ func (db *Database) SeedData() error {
 	var count int64
 	db.DB.Model(&models.Librarian{}).Count(&count)
 	if count > 0 {
-		log.Println("Database already seeded")
+		slog.Info("Database already seeded")
 		return nil
 	}
 
@@ -125,6 +289,6 @@ func (db *Database) SeedData() error {
 		return fmt.Errorf("failed to seed librarian data: %w", err)
 	}
 
-	log.Println("Librarian seeded successfully")
+	slog.Info("Librarian seeded successfully")
 	return nil
 }
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const EnvPrefix = "BRS"
+
+var envAliases = map[string][]string{
+	"server.env": {"BRS_ENV"},
+}
+
+func bindEnv(v *viper.Viper) error {
+	for _, key := range configKeys(reflect.TypeOf(AppConfig{}), "") {
+		name := EnvName(key)
+		if err := v.BindEnv(append([]string{key, name}, envAliases[key]...)...); err != nil {
+			return fmt.Errorf("failed to bind %s: %w", name, err)
+		}
+
+		if file := os.Getenv(name + "_FILE"); file != "" {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read %s_FILE: %w", name, err)
+			}
+			v.Set(key, strings.TrimRight(string(content), "\r\n"))
+		}
+	}
+
+	return nil
+}
+
+func EnvName(key string) string {
+	return EnvPrefix + "_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+func configKeys(t reflect.Type, prefix string) []string {
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("mapstructure")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+
+		switch field.Type.Kind() {
+		case reflect.Struct:
+			keys = append(keys, configKeys(field.Type, key)...)
+		case reflect.Map:
+			continue
+		default:
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
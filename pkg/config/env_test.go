@@ -0,0 +1,124 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	return path
+}
+
+func TestLoadConfigEnvOverrides(t *testing.T) {
+	path := writeConfigFile(t, `
+server:
+  port: 8080
+  env: dev
+database:
+  dsn: "./brs.sqlite"
+librarian:
+  user: "admin"
+  pass: "securePasswd"
+`)
+
+	t.Run("env overrides file values", func(t *testing.T) {
+		t.Setenv("BRS_SERVER_PORT", "9090")
+		t.Setenv("BRS_RENT_RENTAL_DAYS", "14")
+		t.Setenv("BRS_SERVER_ALLOWED_ORIGINS", "https://a.example.com,https://b.example.com")
+
+		cfg, err := LoadConfig(path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cfg.Server.Port != "9090" {
+			t.Errorf("expected port 9090, got %s", cfg.Server.Port)
+		}
+		if cfg.Rent.RentalDays != 14 {
+			t.Errorf("expected rental days 14, got %d", cfg.Rent.RentalDays)
+		}
+		if len(cfg.Server.AllowedOrigins) != 2 {
+			t.Errorf("expected 2 allowed origins, got %v", cfg.Server.AllowedOrigins)
+		}
+	})
+
+	t.Run("secrets can be read from files", func(t *testing.T) {
+		secret := filepath.Join(t.TempDir(), "librarian_pass")
+		if err := os.WriteFile(secret, []byte("s3cret\n"), 0o600); err != nil {
+			t.Fatalf("failed to write secret: %v", err)
+		}
+		t.Setenv("BRS_LIBRARIAN_PASS_FILE", secret)
+
+		cfg, err := LoadConfig(path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cfg.Librarian.Pass != "s3cret" {
+			t.Errorf("expected password from file, got %q", cfg.Librarian.Pass)
+		}
+	})
+
+	t.Run("missing secret file", func(t *testing.T) {
+		t.Setenv("BRS_LIBRARIAN_PASS_FILE", filepath.Join(t.TempDir(), "missing"))
+
+		if _, err := LoadConfig(path); err == nil || !strings.Contains(err.Error(), "BRS_LIBRARIAN_PASS_FILE") {
+			t.Errorf("expected error mentioning BRS_LIBRARIAN_PASS_FILE, got %v", err)
+		}
+	})
+
+	t.Run("env only without config file", func(t *testing.T) {
+		t.Setenv("BRS_DATABASE_DSN", "/data/brs.sqlite")
+		t.Setenv("BRS_ENV", "docker")
+
+		cfg, err := LoadConfig("")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cfg.Database.DSN != "/data/brs.sqlite" || cfg.Server.Env != "docker" {
+			t.Errorf("unexpected config: %+v", cfg)
+		}
+	})
+}
+
+func TestLoadConfigValidation(t *testing.T) {
+	path := writeConfigFile(t, `
+server:
+  port: 8080
+  env: prod
+librarian:
+  user: "admin"
+  pass: "securePasswd"
+rent:
+  rental_days: -1
+`)
+
+	_, err := LoadConfig(path)
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	for _, want := range []string{"database.dsn", "librarian.pass", "rent.rental_days", "cookie.secure"} {
+		found := false
+		for _, problem := range validationErr.Problems {
+			if strings.Contains(problem, want) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected a problem mentioning %q, got %v", want, validationErr.Problems)
+		}
+	}
+}
@@ -2,10 +2,10 @@ package config
 
 import (
 	"context"
+	"log/slog"
 	"math/rand"
 
 	"github.com/google/uuid"
-	"github.com/labstack/gommon/log"
 
 	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
@@ -19,20 +19,20 @@ func SeedData(bookService services.BookService, studentService services.StudentS
 }
 
 func seedRents(rentService services.RentService, bookService services.BookService, studentService services.StudentService) {
-	books, err := bookService.GetAllBooks(context.Background(), dto.PaginationParams{Limit: 100, Offset: 0})
+	books, err := bookService.GetAllBooks(context.Background(), dto.PaginationParams{Limit: 100, Offset: 0}, dto.BookFilters{})
 	if err != nil {
-		log.Errorf("Failed to get books for seeding rents: %v", err)
+		slog.Error("Failed to get books for seeding rents", "error", err)
 		return
 	}
 
-	students, err := studentService.GetAllStudents(context.Background(), dto.PaginationParams{Limit: 100, Offset: 0})
+	students, err := studentService.GetAllStudents(context.Background(), dto.PaginationParams{Limit: 100, Offset: 0}, dto.StudentFilters{})
 	if err != nil {
-		log.Errorf("Failed to get students for seeding rents: %v", err)
+		slog.Error("Failed to get students for seeding rents", "error", err)
 		return
 	}
 
 	if len(books.Results) == 0 || len(students.Results) == 0 {
-		log.Info("No books or students to seed rents with.")
+		slog.Info("No books or students to seed rents with")
 		return
 	}
 
@@ -47,7 +47,7 @@ func seedRents(rentService services.RentService, bookService services.BookServic
 
 		_, err := rentService.CreateRentTransaction(context.Background(), rentRequest)
 		if err != nil {
-			log.Errorf("Failed to create rent transaction: %v", err)
+			slog.Error("Failed to create rent transaction", "error", err)
 		}
 	}
 }
@@ -152,7 +152,7 @@ func seedBooks(bookService services.BookService) {
 
 	for _, book := range books {
 		if err := bookService.CreateBook(context.Background(), &book); err != nil {
-			log.Errorf("Failed to create book: %v", err)
+			slog.Error("Failed to create book", "error", err)
 		}
 	}
 }
@@ -183,7 +183,7 @@ func seedStudents(studentService services.StudentService) {
 
 	for _, student := range students {
 		if err := studentService.CreateStudent(context.Background(), &student); err != nil {
-			log.Errorf("Failed to create student: %v", err)
+			slog.Error("Failed to create student", "error", err)
 		}
 	}
 }
@@ -2,48 +2,178 @@ package config
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
-	"log"
+	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
-	"time"
 )
 
 type Server struct {
 	*http.Server
+	cfg      ServerConfig
+	redirect *http.Server
+	certs    *certReloader
 }
 
-func NewServer(addr string, handler http.Handler) *Server {
+func NewServer(cfg ServerConfig, handler http.Handler) *Server {
 	return &Server{
 		Server: &http.Server{
-			Addr:    addr,
-			Handler: handler,
+			Addr:              net.JoinHostPort("0.0.0.0", cfg.Port),
+			Handler:           handler,
+			ReadTimeout:       cfg.ReadTimeout,
+			ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+			WriteTimeout:      cfg.WriteTimeout,
+			IdleTimeout:       cfg.IdleTimeout,
+			MaxHeaderBytes:    cfg.MaxHeaderBytes,
+			ErrorLog:          slog.NewLogLogger(slog.Default().Handler(), slog.LevelError),
 		},
+		cfg: cfg,
 	}
 }
 
 func (s *Server) Start() {
+	if s.cfg.TLS.Enabled {
+		certs, err := newCertReloader(s.cfg.TLS.CertFile, s.cfg.TLS.KeyFile)
+		if err != nil {
+			slog.Error("Failed to load TLS certificate", "error", err)
+			os.Exit(1)
+		}
+		s.certs = certs
+		s.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certs.GetCertificate,
+		}
+
+		if s.cfg.TLS.RedirectPort != "" {
+			s.redirect = &http.Server{
+				Addr:              net.JoinHostPort("0.0.0.0", s.cfg.TLS.RedirectPort),
+				Handler:           httpsRedirectHandler(s.cfg.Port),
+				ReadTimeout:       s.cfg.ReadTimeout,
+				ReadHeaderTimeout: s.cfg.ReadHeaderTimeout,
+				WriteTimeout:      s.cfg.WriteTimeout,
+				IdleTimeout:       s.cfg.IdleTimeout,
+				MaxHeaderBytes:    s.cfg.MaxHeaderBytes,
+				ErrorLog:          s.ErrorLog,
+			}
+		}
+	}
+
 	stopChan := make(chan os.Signal, 1)
 	signal.Notify(stopChan, os.Interrupt, syscall.SIGTERM)
 
+	reloadChan := make(chan os.Signal, 1)
+	if s.certs != nil {
+		signal.Notify(reloadChan, syscall.SIGHUP)
+	}
+
 	go func() {
-		log.Printf("Server listening on %s", s.Addr)
-		if err := s.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			log.Fatalf("Could not listen on %s: %v", s.Addr, err)
+		var err error
+		if s.certs != nil {
+			slog.Info("Server listening", "addr", s.Addr, "tls", true)
+			err = s.ListenAndServeTLS("", "")
+		} else {
+			slog.Info("Server listening", "addr", s.Addr, "tls", false)
+			err = s.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Could not listen", "addr", s.Addr, "error", err)
+			os.Exit(1)
 		}
 	}()
 
-	<-stopChan
-	log.Println("Shutting down server...")
+	if s.redirect != nil {
+		go func() {
+			slog.Info("Redirecting HTTP to HTTPS", "addr", s.redirect.Addr)
+			if err := s.redirect.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("Could not listen", "addr", s.redirect.Addr, "error", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
+wait:
+	for {
+		select {
+		case <-reloadChan:
+			if err := s.certs.Reload(); err != nil {
+				slog.Error("Failed to reload TLS certificate, keeping the current one", "error", err)
+			} else {
+				slog.Info("TLS certificate reloaded")
+			}
+		case <-stopChan:
+			break wait
+		}
+	}
+	slog.Info("Shutting down server")
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
 	defer cancel()
 
+	if s.redirect != nil {
+		if err := s.redirect.Shutdown(ctx); err != nil {
+			slog.Error("Redirect server shutdown failed", "error", err)
+		}
+	}
+
 	if err := s.Shutdown(ctx); err != nil {
-		log.Fatalf("Server shutdown failed: %v", err)
+		slog.Error("Server shutdown failed", "error", err)
+		os.Exit(1)
 	}
 
-	log.Println("Server gracefully stopped")
+	slog.Info("Server gracefully stopped")
+}
+
+func httpsRedirectHandler(httpsPort string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(r.Host); err == nil {
+			host = h
+		}
+		if httpsPort != "443" {
+			host = net.JoinHostPort(host, httpsPort)
+		}
+
+		target := "https://" + host + r.URL.RequestURI()
+		http.Redirect(w, r, target, http.StatusPermanentRedirect)
+	})
+}
+
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+}
+
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	c := &certReloader{certFile: certFile, keyFile: keyFile}
+	if err := c.Reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *certReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load key pair: %w", err)
+	}
+
+	c.mu.Lock()
+	c.cert = &cert
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cert, nil
 }
@@ -0,0 +1,409 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/mail"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"BRSBackend/pkg/logging"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pdf"
+)
+
+const (
+	minHeaderBytes = 4 << 10
+	maxHeaderBytes = 16 << 20
+
+	defaultLibrarianPass = "securePasswd"
+
+	minReceiptWidth = 24
+	maxReceiptWidth = 120
+)
+
+var (
+	validEnvs  = []string{"dev", "docker", "prod"}
+	logFormats = []string{"text", "json"}
+	exporters  = []string{"otlp", "stdout", "file"}
+)
+
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+func (c *AppConfig) Validate() error {
+	var problems []string
+	collect := func(err error) {
+		if err == nil {
+			return
+		}
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				problems = append(problems, e.Error())
+			}
+			return
+		}
+		problems = append(problems, err.Error())
+	}
+
+	collect(c.Server.Validate())
+	if !slices.Contains(validEnvs, c.Server.Env) {
+		collect(fmt.Errorf("server.env must be one of %s, got %q", strings.Join(validEnvs, ", "), c.Server.Env))
+	}
+	collect(c.Database.validate())
+	collect(c.Librarian.validate(c.Server.Env))
+	collect(c.Rent.validate())
+	collect(c.Cookie.validate(c.Server.Env))
+	collect(c.CSRF.validate(c.Cookie))
+	collect(c.OIDC.validate())
+	collect(c.Log.validate())
+	collect(c.Metrics.validate())
+	collect(c.Tracing.validate())
+	collect(c.Reports.validate())
+	collect(c.Portal.validate(c.Cookie, c.CSRF))
+	collect(c.Receipts.validate())
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func (c DatabaseConfig) validate() error {
+	var errs []error
+	if strings.TrimSpace(c.DSN) == "" {
+		errs = append(errs, fmt.Errorf("database.dsn is required (set it in the config file or %s)", EnvName("database.dsn")))
+	}
+	if _, err := logging.ParseGormLevel(c.LogLevel); err != nil {
+		errs = append(errs, fmt.Errorf("database.log_level: %w", err))
+	}
+	if c.SlowThreshold < 0 {
+		errs = append(errs, errors.New("database.slow_threshold must not be negative"))
+	}
+	return errors.Join(errs...)
+}
+
+func (c MetricsConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var errs []error
+	if !strings.HasPrefix(c.Path, "/") {
+		errs = append(errs, fmt.Errorf("metrics.path must start with /, got %q", c.Path))
+	}
+	if c.RefreshInterval <= 0 {
+		errs = append(errs, errors.New("metrics.refresh_interval must be a positive duration such as \"30s\""))
+	}
+	return errors.Join(errs...)
+}
+
+func (c TracingConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var errs []error
+	if c.ServiceName == "" {
+		errs = append(errs, errors.New("tracing.service_name must not be empty"))
+	}
+	if !slices.Contains(exporters, c.Exporter) {
+		errs = append(errs, fmt.Errorf("tracing.exporter must be one of %s, got %q", strings.Join(exporters, ", "), c.Exporter))
+	}
+	if c.Exporter == "file" && c.File == "" {
+		errs = append(errs, errors.New("tracing.file is required when tracing.exporter is file"))
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("tracing.sample_ratio must be between 0 and 1, got %g", c.SampleRatio))
+	}
+	return errors.Join(errs...)
+}
+
+func (c ReportsConfig) validate() error {
+	var errs []error
+	if c.Retention <= 0 {
+		errs = append(errs, errors.New("reports.retention must be a positive duration such as \"2160h\""))
+	}
+	if c.SMTP.Host != "" {
+		if err := validatePort("reports.smtp.port", c.SMTP.Port); err != nil {
+			errs = append(errs, err)
+		}
+		if _, err := mail.ParseAddress(c.SMTP.From); err != nil {
+			errs = append(errs, fmt.Errorf("reports.smtp.from must be an email address when reports.smtp.host is set, got %q", c.SMTP.From))
+		}
+		if c.SMTP.Password != "" && c.SMTP.Username == "" {
+			errs = append(errs, errors.New("reports.smtp.password requires reports.smtp.username"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c PortalConfig) validate(cookie CookieConfig, csrf CSRFConfig) error {
+	var errs []error
+	switch c.CookieName {
+	case "":
+		errs = append(errs, errors.New("portal.cookie_name must not be empty"))
+	case cookie.Name:
+		errs = append(errs, errors.New("portal.cookie_name must differ from cookie.name"))
+	case csrf.CookieName:
+		errs = append(errs, errors.New("portal.cookie_name must differ from csrf.cookie_name"))
+	}
+	if c.SessionTTL <= 0 {
+		errs = append(errs, errors.New("portal.session_ttl must be a positive duration such as \"12h\""))
+	}
+	if c.MaxPinAttempts < 1 {
+		errs = append(errs, fmt.Errorf("portal.max_pin_attempts must be at least 1, got %d", c.MaxPinAttempts))
+	}
+	if c.Lockout <= 0 {
+		errs = append(errs, errors.New("portal.lockout must be a positive duration such as \"15m\""))
+	}
+	if c.LinkURL != "" {
+		if u, err := url.Parse(c.LinkURL); err != nil || u.Scheme == "" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("portal.link_url must be an absolute URL, got %q", c.LinkURL))
+		}
+		if c.LinkTTL <= 0 {
+			errs = append(errs, errors.New("portal.link_ttl must be a positive duration such as \"15m\""))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c ReceiptsConfig) validate() error {
+	var errs []error
+	if strings.TrimSpace(c.LibraryName) == "" {
+		errs = append(errs, errors.New("receipts.library_name must not be empty"))
+	}
+	if c.Logo != "" {
+		if _, err := os.Stat(c.Logo); err != nil {
+			errs = append(errs, fmt.Errorf("receipts.logo: %w", err))
+		}
+	}
+	if _, ok := pdf.Papers[c.Paper]; !ok {
+		errs = append(errs, fmt.Errorf("receipts.paper must be one of %s, got %q", strings.Join(slices.Sorted(maps.Keys(pdf.Papers)), ", "), c.Paper))
+	}
+	if c.TextWidth < minReceiptWidth || c.TextWidth > maxReceiptWidth {
+		errs = append(errs, fmt.Errorf("receipts.text_width must be between %d and %d, got %d", minReceiptWidth, maxReceiptWidth, c.TextWidth))
+	}
+	return errors.Join(errs...)
+}
+
+func (c LogConfig) validate() error {
+	var errs []error
+	if _, err := logging.ParseLevel(c.Level); err != nil {
+		errs = append(errs, fmt.Errorf("log.level: %w", err))
+	}
+	if !slices.Contains(logFormats, strings.ToLower(c.Format)) {
+		errs = append(errs, fmt.Errorf("log.format must be one of %s, got %q", strings.Join(logFormats, ", "), c.Format))
+	}
+	return errors.Join(errs...)
+}
+
+func (c LibrarianConfig) validate(env string) error {
+	var errs []error
+	if (c.User == "") != (c.Pass == "") {
+		errs = append(errs, errors.New("librarian.user and librarian.pass must be set together"))
+	}
+	if env == "prod" && c.Pass == defaultLibrarianPass {
+		errs = append(errs, fmt.Errorf("librarian.pass must not use the default password in prod (set %s or %s_FILE)", EnvName("librarian.pass"), EnvName("librarian.pass")))
+	}
+	return errors.Join(errs...)
+}
+
+func (c RentalConfig) validate() error {
+	if c.RentalDays < 0 {
+		return fmt.Errorf("rent.rental_days must not be negative, got %d", c.RentalDays)
+	}
+	return nil
+}
+
+func (c CookieConfig) validate(env string) error {
+	var errs []error
+	if c.Name == "" {
+		errs = append(errs, errors.New("cookie.name must not be empty"))
+	}
+	if _, err := c.SameSiteMode(); err != nil {
+		errs = append(errs, err)
+	}
+	if env == "prod" && !c.Secure {
+		errs = append(errs, errors.New("cookie.secure must be true in prod"))
+	}
+	return errors.Join(errs...)
+}
+
+func (c CSRFConfig) validate(cookie CookieConfig) error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var errs []error
+	if c.CookieName == "" {
+		errs = append(errs, errors.New("csrf.cookie_name must not be empty"))
+	} else if c.CookieName == cookie.Name {
+		errs = append(errs, errors.New("csrf.cookie_name must differ from cookie.name"))
+	}
+	if c.HeaderName == "" {
+		errs = append(errs, errors.New("csrf.header_name must not be empty"))
+	}
+	return errors.Join(errs...)
+}
+
+func (c OIDCConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var errs []error
+	if u, err := url.Parse(c.Issuer); err != nil || u.Scheme == "" || u.Host == "" {
+		errs = append(errs, fmt.Errorf("oidc.issuer must be an absolute URL, got %q", c.Issuer))
+	}
+	if c.ClientID == "" {
+		errs = append(errs, errors.New("oidc.client_id is required when oidc.enabled is true"))
+	}
+	if u, err := url.Parse(c.RedirectURL); err != nil || u.Scheme == "" || u.Host == "" {
+		errs = append(errs, fmt.Errorf("oidc.redirect_url must be an absolute URL, got %q", c.RedirectURL))
+	}
+	if c.UsernameClaim == "" {
+		errs = append(errs, errors.New("oidc.username_claim must not be empty"))
+	}
+
+	roles := []string{models.RoleLibrarian, models.RoleAdmin}
+	for _, group := range slices.Sorted(maps.Keys(c.RoleMapping)) {
+		role := c.RoleMapping[group]
+		if !slices.Contains(roles, role) {
+			errs = append(errs, fmt.Errorf("oidc.role_mapping.%s must be one of %s, got %q", group, strings.Join(roles, ", "), role))
+		}
+	}
+	if c.DefaultRole != "" && !slices.Contains(roles, c.DefaultRole) {
+		errs = append(errs, fmt.Errorf("oidc.default_role must be empty or one of %s, got %q", strings.Join(roles, ", "), c.DefaultRole))
+	}
+	if len(c.RoleMapping) == 0 && c.DefaultRole == "" {
+		errs = append(errs, errors.New("oidc.role_mapping or oidc.default_role is required, otherwise nobody can sign in"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c ServerConfig) Validate() error {
+	var errs []error
+
+	if err := validatePort("server.port", c.Port); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(c.AllowedOrigins) == 0 {
+		errs = append(errs, errors.New("server.allowed_origins must contain at least one origin"))
+	}
+	for _, origin := range c.AllowedOrigins {
+		if err := validateOrigin(origin); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	timeouts := []struct {
+		key   string
+		value time.Duration
+	}{
+		{"server.read_timeout", c.ReadTimeout},
+		{"server.read_header_timeout", c.ReadHeaderTimeout},
+		{"server.write_timeout", c.WriteTimeout},
+		{"server.idle_timeout", c.IdleTimeout},
+		{"server.shutdown_timeout", c.ShutdownTimeout},
+	}
+	for _, timeout := range timeouts {
+		if timeout.value <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be a positive duration such as \"15s\"", timeout.key))
+		}
+	}
+	if c.ReadHeaderTimeout > 0 && c.ReadTimeout > 0 && c.ReadHeaderTimeout > c.ReadTimeout {
+		errs = append(errs, errors.New("server.read_header_timeout must not exceed server.read_timeout"))
+	}
+
+	if c.MaxHeaderBytes < minHeaderBytes || c.MaxHeaderBytes > maxHeaderBytes {
+		errs = append(errs, fmt.Errorf("server.max_header_bytes must be between %d and %d", minHeaderBytes, maxHeaderBytes))
+	}
+
+	if err := c.TLS.validate(c.Port); err != nil {
+		errs = append(errs, err)
+	}
+
+	if c.ClockOffset != 0 && c.Env != "dev" {
+		errs = append(errs, errors.New("server.clock_offset is only allowed when server.env is dev"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c TLSConfig) validate(serverPort string) error {
+	if !c.Enabled {
+		if c.RedirectPort != "" {
+			return errors.New("server.tls.redirect_port requires server.tls.enabled")
+		}
+		return nil
+	}
+
+	var errs []error
+	files := []struct {
+		key  string
+		path string
+	}{
+		{"server.tls.cert_file", c.CertFile},
+		{"server.tls.key_file", c.KeyFile},
+	}
+	for _, file := range files {
+		key, path := file.key, file.path
+		if path == "" {
+			errs = append(errs, fmt.Errorf("%s is required when server.tls.enabled is true", key))
+			continue
+		}
+		if _, err := os.Stat(path); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+
+	if c.RedirectPort != "" {
+		if err := validatePort("server.tls.redirect_port", c.RedirectPort); err != nil {
+			errs = append(errs, err)
+		} else if c.RedirectPort == serverPort {
+			errs = append(errs, errors.New("server.tls.redirect_port must differ from server.port"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validatePort(key, port string) error {
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 1 || n > 65535 {
+		return fmt.Errorf("%s must be a port number between 1 and 65535, got %q", key, port)
+	}
+	return nil
+}
+
+func validateOrigin(origin string) error {
+	if origin == "*" {
+		return errors.New("server.allowed_origins must not contain \"*\" because credentials are allowed")
+	}
+
+	u, err := url.Parse(strings.Replace(origin, "*", "wildcard", 1))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("server.allowed_origins entry %q must look like https://example.com", origin)
+	}
+	if u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("server.allowed_origins entry %q must not contain a path", origin)
+	}
+	if strings.Count(origin, "*") > 1 {
+		return fmt.Errorf("server.allowed_origins entry %q may contain at most one wildcard", origin)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func validServerConfig() ServerConfig {
+	return ServerConfig{
+		Port:              "8080",
+		AllowedOrigins:    []string{"http://localhost:5173", "https://*.onrender.com"},
+		ReadTimeout:       15 * time.Second,
+		ReadHeaderTimeout: 5 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       60 * time.Second,
+		ShutdownTimeout:   5 * time.Second,
+		MaxHeaderBytes:    1 << 20,
+	}
+}
+
+func TestServerConfigValidate(t *testing.T) {
+	t.Run("defaults are valid", func(t *testing.T) {
+		if err := validServerConfig().Validate(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		modify func(c *ServerConfig)
+		want   string
+	}{
+		{"invalid port", func(c *ServerConfig) { c.Port = "http" }, "server.port"},
+		{"wildcard origin", func(c *ServerConfig) { c.AllowedOrigins = []string{"*"} }, "server.allowed_origins"},
+		{"origin with path", func(c *ServerConfig) { c.AllowedOrigins = []string{"https://example.com/app"} }, "must not contain a path"},
+		{"no origins", func(c *ServerConfig) { c.AllowedOrigins = nil }, "at least one origin"},
+		{"zero timeout", func(c *ServerConfig) { c.WriteTimeout = 0 }, "server.write_timeout"},
+		{"header timeout exceeds read timeout", func(c *ServerConfig) { c.ReadHeaderTimeout = time.Minute }, "server.read_header_timeout"},
+		{"header bytes too small", func(c *ServerConfig) { c.MaxHeaderBytes = 10 }, "server.max_header_bytes"},
+		{"tls without files", func(c *ServerConfig) { c.TLS.Enabled = true }, "server.tls.cert_file"},
+		{"redirect without tls", func(c *ServerConfig) { c.TLS.RedirectPort = "80" }, "server.tls.redirect_port"},
+		{"clock offset outside dev", func(c *ServerConfig) { c.Env, c.ClockOffset = "prod", 72*time.Hour }, "server.clock_offset"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validServerConfig()
+			tt.modify(&cfg)
+
+			err := cfg.Validate()
+			if err == nil {
+				t.Fatalf("expected error containing %q, got nil", tt.want)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestReportsConfigValidate(t *testing.T) {
+	valid := ReportsConfig{
+		Retention: 90 * 24 * time.Hour,
+		SMTP:      SMTPConfig{Host: "smtp.example.com", Port: "587", From: "Library <library@example.com>", StartTLS: true},
+	}
+	if err := valid.validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(c *ReportsConfig)
+		want   string
+	}{
+		{"zero retention", func(c *ReportsConfig) { c.Retention = 0 }, "reports.retention"},
+		{"invalid sender", func(c *ReportsConfig) { c.SMTP.From = "library" }, "reports.smtp.from"},
+		{"password without username", func(c *ReportsConfig) { c.SMTP.Password = "secret" }, "reports.smtp.password"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.modify(&cfg)
+
+			err := cfg.validate()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestPortalConfigValidate(t *testing.T) {
+	cookie := CookieConfig{Name: "session_id"}
+	csrf := CSRFConfig{CookieName: "csrf_token"}
+	valid := PortalConfig{
+		CookieName:     "student_session",
+		SessionTTL:     12 * time.Hour,
+		MaxPinAttempts: 5,
+		Lockout:        15 * time.Minute,
+		LinkURL:        "https://library.example.com/portal",
+		LinkTTL:        15 * time.Minute,
+	}
+	if err := valid.validate(cookie, csrf); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(c *PortalConfig)
+		want   string
+	}{
+		{"shared cookie", func(c *PortalConfig) { c.CookieName = "session_id" }, "differ from cookie.name"},
+		{"csrf cookie", func(c *PortalConfig) { c.CookieName = "csrf_token" }, "differ from csrf.cookie_name"},
+		{"no attempts", func(c *PortalConfig) { c.MaxPinAttempts = 0 }, "portal.max_pin_attempts"},
+		{"relative link", func(c *PortalConfig) { c.LinkURL = "/portal" }, "portal.link_url"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.modify(&cfg)
+
+			err := cfg.validate(cookie, csrf)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestReceiptsConfigValidate(t *testing.T) {
+	valid := ReceiptsConfig{LibraryName: "Springfield Library", Paper: "a4", TextWidth: 42}
+	if err := valid.validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(c *ReceiptsConfig)
+		want   string
+	}{
+		{"no name", func(c *ReceiptsConfig) { c.LibraryName = " " }, "receipts.library_name"},
+		{"missing logo", func(c *ReceiptsConfig) { c.Logo = "/nonexistent/logo.png" }, "receipts.logo"},
+		{"unknown paper", func(c *ReceiptsConfig) { c.Paper = "a5" }, "a4, letter"},
+		{"narrow text", func(c *ReceiptsConfig) { c.TextWidth = 10 }, "receipts.text_width"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.modify(&cfg)
+
+			err := cfg.validate()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
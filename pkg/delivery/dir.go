@@ -0,0 +1,40 @@
+package delivery
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// WriteDir writes the files into dir, creating it if needed. Each file is
+// written under a temporary name first, so a reader never sees it half
+// written.
+func WriteDir(dir string, files []File) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create report directory: %w", err)
+	}
+
+	for _, file := range files {
+		path := filepath.Join(dir, filepath.Base(file.Name))
+		tmp, err := os.CreateTemp(dir, ".report-*")
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		_, err = tmp.Write(file.Content)
+		if closeErr := tmp.Close(); err == nil {
+			err = closeErr
+		}
+		if err == nil {
+			err = os.Chmod(tmp.Name(), 0o644)
+		}
+		if err == nil {
+			err = os.Rename(tmp.Name(), path)
+		}
+		if err != nil {
+			os.Remove(tmp.Name())
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
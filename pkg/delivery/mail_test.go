@@ -0,0 +1,164 @@
+package delivery
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+// smtpStandIn accepts one SMTP session without TLS or authentication and
+// records what was sent.
+type smtpStandIn struct {
+	addr       string
+	from       string
+	recipients []string
+	data       string
+	done       chan struct{}
+}
+
+func newSMTPStandIn(t *testing.T) *smtpStandIn {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	s := &smtpStandIn{addr: listener.Addr().String(), done: make(chan struct{})}
+	go func() {
+		defer close(s.done)
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s.serve(conn)
+	}()
+	return s
+}
+
+func (s *smtpStandIn) serve(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	reply("220 stand-in ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 stand-in")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			s.from = strings.Trim(line[len("MAIL FROM:"):], "<>")
+			reply("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			s.recipients = append(s.recipients, strings.Trim(line[len("RCPT TO:"):], "<>"))
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+			s.data = data.String()
+			reply("250 queued")
+		case command == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPMailerSend(t *testing.T) {
+	server := newSMTPStandIn(t)
+	host, port, _ := net.SplitHostPort(server.addr)
+
+	mailer := NewSMTPMailer(SMTPOptions{Host: host, Port: port, From: "Library <library@example.com>"})
+	err := mailer.Send(context.Background(), Message{
+		To:      []string{"head@example.com", "dean@example.com"},
+		Subject: "Weekly overdue: Überfällig",
+		Text:    "Overdue books\n",
+		Date:    time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC),
+		Attachments: []File{
+			{Name: "overdue-2026-10-19.csv", ContentType: "text/csv; charset=utf-8", Content: []byte("title\n" + strings.Repeat("Dune\n", 100))},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-server.done
+
+	if server.from != "library@example.com" {
+		t.Errorf("expected sender library@example.com, got %q", server.from)
+	}
+	if strings.Join(server.recipients, ",") != "head@example.com,dean@example.com" {
+		t.Errorf("unexpected recipients %v", server.recipients)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(server.data))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+	subject, _ := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if subject != "Weekly overdue: Überfällig" {
+		t.Errorf("unexpected subject %q", subject)
+	}
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("failed to parse content type: %v", err)
+	}
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	var parts []string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to read part: %v", err)
+		}
+		// NextPart decodes quoted-printable only, so decode base64 here.
+		decoded, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, part))
+		if err != nil {
+			t.Fatalf("failed to decode part: %v", err)
+		}
+		parts = append(parts, part.FileName()+":"+string(decoded))
+	}
+
+	if len(parts) != 2 || parts[0] != ":Overdue books\n" || !strings.HasPrefix(parts[1], "overdue-2026-10-19.csv:title\nDune\n") {
+		t.Errorf("unexpected parts %q", parts)
+	}
+}
+
+func TestSMTPMailerRequiresStartTLS(t *testing.T) {
+	server := newSMTPStandIn(t)
+	host, port, _ := net.SplitHostPort(server.addr)
+
+	mailer := NewSMTPMailer(SMTPOptions{Host: host, Port: port, From: "library@example.com", StartTLS: true})
+	err := mailer.Send(context.Background(), Message{To: []string{"head@example.com"}, Subject: "Report"})
+	if err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Errorf("expected a STARTTLS error, got %v", err)
+	}
+}
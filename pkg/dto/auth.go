@@ -11,3 +11,15 @@ type LoginResponse struct {
 	Message     string    `json:"message"`
 	LibrarianId uuid.UUID `json:"librarian_id"`
 }
+
+type ExternalIdentity struct {
+	Subject  string
+	Username string
+	Role     string
+}
+
+type OIDCLoginResult struct {
+	Response  *LoginResponse
+	SessionId string
+	Redirect  string
+}
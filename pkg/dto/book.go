@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AvailabilityAvailable  = "available"
+	AvailabilityOutOfStock = "out_of_stock"
+)
+
+// BookFilters narrows a book listing. Zero values leave a filter unset;
+// AddedFrom and AddedTo are inclusive calendar days. CategoryID matches the
+// books of the category and all its subcategories, Tags the books with
+// every tag.
+type BookFilters struct {
+	Availability string
+	MinCount     *int
+	MaxCount     *int
+	AddedFrom    *time.Time
+	AddedTo      *time.Time
+	CategoryID   *uuid.UUID
+	Tags         []string
+}
+
+// BookFacets counts the books matching the query and every filter except
+// availability, split by availability.
+type BookFacets struct {
+	Available  int64 `json:"available"`
+	OutOfStock int64 `json:"out_of_stock"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+import "github.com/google/uuid"
+
+type CategoryRequest struct {
+	Name     string     `json:"name" validate:"required,max=255"`
+	ParentID *uuid.UUID `json:"parent_id"`
+}
+
+// CategoryCount counts the books filed directly under a category, and their
+// copies in total and on the shelf.
+type CategoryCount struct {
+	CategoryID uuid.UUID
+	Books      int64
+	Copies     int64
+	Available  int64
+}
+
+// CategoryNode is a category with its subcategories. The counts include the
+// books of the whole subtree.
+type CategoryNode struct {
+	ID        uuid.UUID       `json:"id"`
+	Name      string          `json:"name"`
+	ParentID  *uuid.UUID      `json:"parent_id,omitempty"`
+	Books     int64           `json:"books"`
+	Copies    int64           `json:"copies"`
+	Available int64           `json:"available"`
+	Children  []*CategoryNode `json:"children"`
+}
+
+// BookClassification files a book under a category, or none, with its call
+// number and tags. It replaces the previous classification.
+type BookClassification struct {
+	CategoryID *uuid.UUID `json:"category_id"`
+	CallNumber *string    `json:"call_number" validate:"omitempty,max=64"`
+	Tags       []string   `json:"tags" validate:"max=20,dive,max=50"`
+}
+
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Books int64  `json:"books"`
+}
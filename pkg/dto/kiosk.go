@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/models"
+)
+
+type KioskRegistrationRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+// KioskRegistration is the registered kiosk with its API key, which is only
+// ever returned here.
+type KioskRegistration struct {
+	*models.KioskDevice
+	Key string `json:"key"`
+}
+
+type KioskTransactionsResponse struct {
+	Results    []*models.KioskTransaction `json:"results"`
+	Pagination PaginationInfo             `json:"pagination"`
+}
+
+type KioskIdentifyRequest struct {
+	CardId string `json:"card_id" validate:"required,max=255"`
+}
+
+// KioskStudent is what a kiosk shows of the student whose card was scanned.
+type KioskStudent struct {
+	StudentID uuid.UUID        `json:"student_id"`
+	FirstName string           `json:"first_name"`
+	LastName  string           `json:"last_name"`
+	Loans     []*StudentRental `json:"loans"`
+}
+
+// KioskRequest checks out or returns the scanned books of the student with
+// the card. Each entry of BookIDs and BookCodes is one copy.
+type KioskRequest struct {
+	CardId    string      `json:"card_id" validate:"required,max=255"`
+	BookIDs   []uuid.UUID `json:"book_ids"`
+	BookCodes []string    `json:"book_codes" validate:"dive,required,max=64"`
+}
+
+// Receipt lists the books of a cart after a checkout or a return.
+const (
+	ReceiptCheckout = "checkout"
+	ReceiptReturn   = "return"
+)
+
+type Receipt struct {
+	Kind     string         `json:"kind"`
+	CartID   uuid.UUID      `json:"cart_id"`
+	Student  ReceiptStudent `json:"student"`
+	Items    []ReceiptItem  `json:"items"`
+	IssuedAt time.Time      `json:"issued_at"`
+	Kiosk    string         `json:"kiosk,omitempty"`
+}
+
+type ReceiptStudent struct {
+	ID     uuid.UUID `json:"id"`
+	Name   string    `json:"name"`
+	CardID string    `json:"card_id"`
+}
+
+type ReceiptItem struct {
+	BookID       uuid.UUID  `json:"book_id"`
+	Title        string     `json:"title"`
+	DueDate      time.Time  `json:"due_date"`
+	ReturnedDate *time.Time `json:"returned_date,omitempty"`
+	Condition    string     `json:"condition,omitempty"`
+	OverdueDays  int        `json:"overdue_days"`
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/barcode"
+)
+
+// What the barcode of a book label encodes.
+const (
+	LabelValueBarcode = "barcode"
+	LabelValueID      = "id"
+)
+
+// LabelSheet chooses the Avery template of a label sheet and the barcode
+// printed on each label. Start is the position of the first label on the
+// first sheet, counted across the rows, for sheets with labels already
+// peeled off. Every label is printed Copies times.
+type LabelSheet struct {
+	Template  string            `json:"template" validate:"omitempty,max=16"`
+	Symbology barcode.Symbology `json:"symbology" validate:"omitempty,oneof=code128 qr"`
+	Start     int               `json:"start" validate:"omitempty,min=1"`
+	Copies    int               `json:"copies" validate:"omitempty,min=1,max=50"`
+}
+
+// BookLabelRequest prints labels for the books, in order, encoding their
+// barcodes or ids. Fields are the lines of text next to the barcode.
+type BookLabelRequest struct {
+	IDs    []uuid.UUID `json:"ids" validate:"required,min=1,max=1000"`
+	Value  string      `json:"value" validate:"omitempty,oneof=barcode id"`
+	Fields []string    `json:"fields" validate:"omitempty,max=4,dive,oneof=title barcode isbn id"`
+	LabelSheet
+}
+
+// StudentLabelRequest prints card labels for the students, in order,
+// encoding their card ids.
+type StudentLabelRequest struct {
+	IDs    []uuid.UUID `json:"ids" validate:"required,min=1,max=1000"`
+	Fields []string    `json:"fields" validate:"omitempty,max=3,dive,oneof=name card_id major"`
+	LabelSheet
+}
+
+// Label is the encoded barcode of one label with its lines of text.
+type Label struct {
+	Symbol *barcode.Symbol
+	Lines  []string
+}
@@ -1,24 +1,44 @@
 package dto
 
-import "BRSBackend/pkg/models"
+import (
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pagination"
+)
 
 type PaginationParams struct {
 	Limit  int    `json:"limit"`
 	Offset int    `json:"offset"`
 	Query  string `json:"query,omitempty"`
+	Sort   string `json:"sort,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
 }
 
 type PaginationInfo struct {
-	Offset      int  `json:"offset"`
-	Limit       int  `json:"limit"`
-	Total       int  `json:"total"`
-	HasNext     bool `json:"has_next"`
-	HasPrevious bool `json:"has_previous"`
+	Offset      int    `json:"offset"`
+	Limit       int    `json:"limit"`
+	Total       int    `json:"total"`
+	HasNext     bool   `json:"has_next"`
+	HasPrevious bool   `json:"has_previous"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	PrevCursor  string `json:"prev_cursor,omitempty"`
+}
+
+func NewPaginationInfo(offset, limit int, total int64, links pagination.Links) PaginationInfo {
+	return PaginationInfo{
+		Offset:      offset,
+		Limit:       limit,
+		Total:       int(total),
+		HasNext:     links.Next != "",
+		HasPrevious: links.Prev != "",
+		NextCursor:  links.Next,
+		PrevCursor:  links.Prev,
+	}
 }
 
 type BooksResponse struct {
 	Results    []*models.Book `json:"results"`
 	Pagination PaginationInfo `json:"pagination"`
+	Facets     BookFacets     `json:"facets"`
 }
 
 type StudentsResponse struct {
@@ -0,0 +1,37 @@
+package dto
+
+import "github.com/google/uuid"
+
+type PortalLoginRequest struct {
+	CardId string `json:"card_id" validate:"required"`
+	Pin    string `json:"pin" validate:"required"`
+}
+
+type PortalLinkRequest struct {
+	CardId string `json:"card_id" validate:"required"`
+}
+
+type PortalTokenRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type PortalLoginResponse struct {
+	Message   string    `json:"message"`
+	StudentId uuid.UUID `json:"student_id"`
+}
+
+// StudentPinRequest sets the portal PIN of a student.
+type StudentPinRequest struct {
+	Pin string `json:"pin" validate:"required,numeric,min=4,max=12"`
+}
+
+// StudentContactRequest replaces the contact details a student may change
+// from the portal. An empty email removes it.
+type StudentContactRequest struct {
+	Phone string `json:"phone" validate:"required,max=255"`
+	Email string `json:"email" validate:"omitempty,email,max=255"`
+}
+
+type StudentLoansResponse struct {
+	Results []*StudentRental `json:"results"`
+}
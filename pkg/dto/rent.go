@@ -20,16 +20,24 @@ type RentFilters struct {
 	Date        *time.Time
 	Limit       int
 	Offset      int
+	Sort        string
+	Cursor      string
 }
 
+// CreateRentRequest identifies the student by id or card id, and the books by
+// id, barcode or ISBN. Each entry of BookIDs and BookCodes rents one copy.
 type CreateRentRequest struct {
-	StudentID uuid.UUID   `json:"student_id" validate:"required"`
-	BookIDs   []uuid.UUID `json:"book_ids" validate:"required,min=1"`
+	StudentID uuid.UUID   `json:"student_id" validate:"required_without=CardID"`
+	CardID    string      `json:"card_id"`
+	BookIDs   []uuid.UUID `json:"book_ids"`
+	BookCodes []string    `json:"book_codes"`
 }
 
 type CreateRentResponse struct {
-	CartID  uuid.UUID `json:"cart_id"`
-	Message string    `json:"message"`
+	CartID    uuid.UUID   `json:"cart_id"`
+	StudentID uuid.UUID   `json:"student_id"`
+	BookIDs   []uuid.UUID `json:"book_ids"`
+	Message   string      `json:"message"`
 }
 
 type GetRentedBooksResponse struct {
@@ -37,7 +45,65 @@ type GetRentedBooksResponse struct {
 	Pagination PaginationInfo `json:"pagination"`
 }
 
+// ReturnItem sets the condition of a returned book, identified by id or by
+// barcode or ISBN. Books of the cart that are not listed are returned in good
+// condition.
+type ReturnItem struct {
+	BookID    uuid.UUID `json:"book_id" validate:"required_without=BookCode"`
+	BookCode  string    `json:"book_code"`
+	Condition string    `json:"condition" validate:"omitempty,oneof=good damaged lost"`
+	Notes     string    `json:"notes" validate:"max=500"`
+}
+
+// ReturnBooksRequest identifies the cart by id, or by the card id of the
+// student who rented it. Complete requires every book of the cart to be
+// listed, for returns that nobody checks at the desk.
+type ReturnBooksRequest struct {
+	CartID      uuid.UUID    `json:"cart_id" validate:"required_without=CardID"`
+	CardID      string       `json:"card_id"`
+	Items       []ReturnItem `json:"items" validate:"dive"`
+	LibrarianID *uuid.UUID   `json:"-"`
+	Complete    bool         `json:"-"`
+}
+
 type ReturnBooksResponse struct {
-	Message string    `json:"message"`
-	CartID  uuid.UUID `json:"cart_id"`
+	Message    string    `json:"message"`
+	CartID     uuid.UUID `json:"cart_id"`
+	ReturnedAt time.Time `json:"returned_at"`
+}
+
+// ReturnFilters narrows the return history. From and To are inclusive
+// calendar days.
+type ReturnFilters struct {
+	From        *time.Time
+	To          *time.Time
+	StudentID   *uuid.UUID
+	BookID      *uuid.UUID
+	LibrarianID *uuid.UUID
+	Condition   string
+	Limit       int
+	Offset      int
+	Sort        string
+	Cursor      string
+}
+
+type ReturnRecord struct {
+	ReturnID    uuid.UUID  `json:"return_id"`
+	CartID      uuid.UUID  `json:"cart_id"`
+	RentID      uuid.UUID  `json:"rent_id"`
+	BookID      uuid.UUID  `json:"book_id"`
+	BookTitle   string     `json:"book_title"`
+	StudentID   uuid.UUID  `json:"student_id"`
+	StudentName string     `json:"student_name"`
+	CardID      string     `json:"card_id"`
+	LibrarianID *uuid.UUID `json:"librarian_id,omitempty"`
+	Librarian   string     `json:"librarian,omitempty"`
+	Condition   string     `json:"condition"`
+	Notes       string     `json:"notes,omitempty"`
+	ReturnedAt  time.Time  `json:"returned_at"`
+}
+
+type ReturnHistoryResponse struct {
+	Results    []*ReturnRecord `json:"results"`
+	Pagination PaginationInfo  `json:"pagination"`
 }
@@ -2,16 +2,42 @@ package dto
 
 import "time"
 
+// OverdueUser is a student with overdue books. DateRented is the checkout of
+// the oldest overdue book and DaysOverdue its days past due.
 type OverdueUser struct {
-	CartId      string    `json:"cartId"`
-	StudentName string    `json:"student_name"`
-	CardId      string    `json:"card_id"`
-	Phone       string    `json:"phone"`
-	TotalBooks  int       `json:"total_books"`
-	DateRented  time.Time `json:"date_rented"`
+	StudentId   string        `json:"student_id"`
+	StudentName string        `json:"student_name"`
+	CardId      string        `json:"card_id"`
+	Phone       string        `json:"phone"`
+	TotalBooks  int           `json:"total_books"`
+	DateRented  time.Time     `json:"date_rented"`
+	DaysOverdue int           `json:"days_overdue"`
+	Items       []OverdueItem `json:"items"`
+}
+
+// OverdueItem is one overdue book; DueDate and DaysOverdue are filled in by
+// the service.
+type OverdueItem struct {
+	RentId      string    `json:"rent_id"`
+	CartId      string    `json:"cart_id"`
+	StudentId   string    `json:"-"`
+	BookId      string    `json:"book_id"`
+	Title       string    `json:"title"`
+	RentedAt    time.Time `json:"rented_at"`
+	DueDate     time.Time `json:"due_date"`
 	DaysOverdue int       `json:"days_overdue"`
 }
 
+// AgingBucket counts the overdue books, and the students holding them, that
+// are MinDays to MaxDays (or more, without MaxDays) days past due.
+type AgingBucket struct {
+	Label    string `json:"label"`
+	MinDays  int    `json:"min_days"`
+	MaxDays  *int   `json:"max_days,omitempty"`
+	Items    int64  `json:"items"`
+	Students int64  `json:"students"`
+}
+
 type BookRentStats struct {
 	BookTitle   string `json:"book_title"`
 	RentedCount int    `json:"rented_count"`
@@ -26,5 +52,117 @@ type RentReport struct {
 
 type OverdueResponse struct {
 	Results    []OverdueUser  `json:"results"`
+	Aging      []AgingBucket  `json:"aging"`
 	Pagination PaginationInfo `json:"pagination"`
 }
+
+type CirculationStats struct {
+	ActiveRentals   int64 `json:"active_rentals"`
+	OverdueCarts    int64 `json:"overdue_carts"`
+	BooksOutOfStock int64 `json:"books_out_of_stock"`
+	ActiveSessions  int64 `json:"active_sessions"`
+}
+
+const (
+	IntervalDay   = "day"
+	IntervalWeek  = "week"
+	IntervalMonth = "month"
+
+	GroupByMajor = "major"
+	GroupByBook  = "book"
+)
+
+// AnalyticsParams selects the circulation analytics. From and To are
+// inclusive calendar days; From is moved back to the start of its bucket.
+type AnalyticsParams struct {
+	From     *time.Time
+	To       *time.Time
+	Interval string
+	GroupBy  string
+	Limit    int
+}
+
+type AnalyticsCounts struct {
+	Checkouts   int64 `json:"checkouts"`
+	Returns     int64 `json:"returns"`
+	NewStudents int64 `json:"new_students"`
+	Overdue     int64 `json:"overdue"`
+}
+
+type AnalyticsBucket struct {
+	Start string `json:"start"`
+	AnalyticsCounts
+}
+
+type AnalyticsGroup struct {
+	Key     string            `json:"key"`
+	Label   string            `json:"label"`
+	Totals  AnalyticsCounts   `json:"totals"`
+	Buckets []AnalyticsBucket `json:"buckets"`
+}
+
+type AnalyticsResponse struct {
+	From     string            `json:"from"`
+	To       string            `json:"to"`
+	Interval string            `json:"interval"`
+	GroupBy  string            `json:"group_by,omitempty"`
+	Totals   AnalyticsCounts   `json:"totals"`
+	Buckets  []AnalyticsBucket `json:"buckets"`
+	Groups   []AnalyticsGroup  `json:"groups,omitempty"`
+}
+
+// AnalyticsRow holds the counts of one bucket, of one group when GroupKey is
+// set and of all rows otherwise.
+type AnalyticsRow struct {
+	Bucket     string
+	GroupKey   string
+	GroupLabel string
+	AnalyticsCounts
+}
+
+// CollectionFilters select the titles of the collection report. From and To
+// bound the period, To exclusive; the service fills them in.
+type CollectionFilters struct {
+	From                time.Time
+	To                  time.Time
+	ZeroCirculation     *bool
+	DemandExceedsSupply *bool
+	Limit               int
+	Offset              int
+	Sort                string
+	Cursor              string
+}
+
+// CollectionItem is the use of one book in the report period. Copies counts
+// the copies on the shelf and on loan now; lost copies are gone.
+// AvgLoanDays covers the loans that ended in the period and is nil without
+// any.
+type CollectionItem struct {
+	BookId              string   `json:"book_id"`
+	Title               string   `json:"title"`
+	Isbn                *string  `json:"isbn,omitempty"`
+	Barcode             *string  `json:"barcode,omitempty"`
+	Copies              int      `json:"copies"`
+	Available           int      `json:"available"`
+	Rentals             int64    `json:"rentals"`
+	AvgLoanDays         *float64 `json:"avg_loan_days"`
+	UtilizationPct      float64  `json:"utilization_pct"`
+	ZeroCirculation     bool     `json:"zero_circulation"`
+	DemandExceedsSupply bool     `json:"demand_exceeds_supply"`
+}
+
+type CollectionSummary struct {
+	Titles              int64 `json:"titles"`
+	Copies              int64 `json:"copies"`
+	Rentals             int64 `json:"rentals"`
+	ZeroCirculation     int64 `json:"zero_circulation"`
+	DemandExceedsSupply int64 `json:"demand_exceeds_supply"`
+}
+
+type CollectionReport struct {
+	From       string            `json:"from"`
+	To         string            `json:"to"`
+	Summary    CollectionSummary `json:"summary"`
+	Results    []CollectionItem  `json:"results"`
+	Pagination PaginationInfo    `json:"pagination"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import "BRSBackend/pkg/models"
+
+// ReportScheduleRequest creates or replaces a saved report. The rules that
+// span fields, such as the weekday of a weekly schedule, are checked by the
+// service.
+type ReportScheduleRequest struct {
+	Name       string              `json:"name" validate:"required,max=255"`
+	Report     string              `json:"report" validate:"required,oneof=overdue rental collection"`
+	Params     models.ReportParams `json:"params"`
+	Frequency  string              `json:"frequency" validate:"required,oneof=daily weekly monthly"`
+	Weekday    *int                `json:"weekday" validate:"omitempty,min=0,max=6"`
+	DayOfMonth *int                `json:"day_of_month" validate:"omitempty,min=1,max=28"`
+	Hour       int                 `json:"hour" validate:"min=0,max=23"`
+	Formats    []string            `json:"formats" validate:"required,min=1,unique,dive,oneof=csv html"`
+	Delivery   string              `json:"delivery" validate:"required,oneof=email directory"`
+	Recipients []string            `json:"recipients" validate:"omitempty,unique,dive,email"`
+	Enabled    *bool               `json:"enabled"`
+}
+
+type ReportRunsResponse struct {
+	Results    []*models.ReportRun `json:"results"`
+	Pagination PaginationInfo      `json:"pagination"`
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/models"
+)
+
+// StudentFilters narrows a student listing. Nil flags leave a filter unset;
+// OverdueDays is set by the service from the configured overdue period.
+type StudentFilters struct {
+	Major            string
+	HasActiveRentals *bool
+	HasOverdue       *bool
+	OverdueDays      int
+}
+
+// StudentRental is one book a student borrowed. ReturnedDate is nil while the
+// book is still out; DueDate and OverdueDays are filled in by the service.
+type StudentRental struct {
+	RentID       uuid.UUID  `json:"rent_id"`
+	CartID       uuid.UUID  `json:"cart_id"`
+	BookID       uuid.UUID  `json:"book_id"`
+	BookTitle    string     `json:"book_title"`
+	Status       string     `json:"status"`
+	RentedDate   time.Time  `json:"rented_date"`
+	DueDate      time.Time  `json:"due_date"`
+	ReturnedDate *time.Time `json:"returned_date,omitempty"`
+	Condition    string     `json:"condition,omitempty"`
+	OverdueDays  int        `json:"overdue_days"`
+}
+
+type StudentHistoryResponse struct {
+	Results    []*StudentRental `json:"results"`
+	Pagination PaginationInfo   `json:"pagination"`
+}
+
+type BorrowedTitle struct {
+	BookID    uuid.UUID `json:"book_id"`
+	BookTitle string    `json:"book_title"`
+	Count     int       `json:"count"`
+}
+
+// StudentStats counts a student's rentals by book, not by cart.
+type StudentStats struct {
+	TotalRentals      int64           `json:"total_rentals"`
+	CurrentlyBorrowed int64           `json:"currently_borrowed"`
+	OverdueCount      int64           `json:"overdue_count"`
+	LastRentedAt      *time.Time      `json:"last_rented_at,omitempty"`
+	MostBorrowed      []BorrowedTitle `json:"most_borrowed"`
+}
+
+type StudentSummary struct {
+	Student *models.Student `json:"student"`
+	StudentStats
+}
@@ -0,0 +1,92 @@
+package export
+
+import (
+	"encoding/csv"
+	"html/template"
+	"io"
+	"strings"
+)
+
+// Table is a report flattened into rows of text, ready to be written in any
+// of the export formats.
+type Table struct {
+	Name    string
+	Title   string
+	Summary []Field
+	Columns []string
+	Rows    [][]string
+}
+
+// Field is a labelled value summarizing the table, such as a total.
+type Field struct {
+	Label string
+	Value string
+}
+
+// WriteCSV writes the table as CSV with a header row. The title and summary
+// are left out so that the file loads as a single table.
+func WriteCSV(w io.Writer, table *Table) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(escapeCells(table.Columns)); err != nil {
+		return err
+	}
+	for _, row := range table.Rows {
+		if err := writer.Write(escapeCells(row)); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// escapeCells quotes the cells that a spreadsheet would run as formulas, such
+// as a book titled "=HYPERLINK(...)", with a leading apostrophe.
+func escapeCells(cells []string) []string {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+			cell = "'" + cell
+		}
+		escaped[i] = cell
+	}
+	return escaped
+}
+
+var htmlTemplate = template.Must(template.New("table").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; font-size: 14px; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+th { background: #f0f0f0; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{- if .Summary}}
+<dl>
+{{- range .Summary}}
+<dt>{{.Label}}</dt><dd>{{.Value}}</dd>
+{{- end}}
+</dl>
+{{- end}}
+<table>
+<thead><tr>{{range .Columns}}<th>{{.}}</th>{{end}}</tr></thead>
+<tbody>
+{{- range .Rows}}
+<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+</body>
+</html>
+`))
+
+// WriteHTML writes the table as a standalone HTML page, headed by its title
+// and summary.
+func WriteHTML(w io.Writer, table *Table) error {
+	return htmlTemplate.Execute(w, table)
+}
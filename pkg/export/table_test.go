@@ -0,0 +1,31 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteCSV(t *testing.T) {
+	table := &Table{
+		Columns: []string{"title", "copies"},
+		Rows: [][]string{
+			{"Dune", "2"},
+			{"=HYPERLINK(\"http://example.com\")", "1"},
+			{"+1", "-3"},
+			{"@SUM(A1)", "\t0"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, table); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "title,copies\n" +
+		"Dune,2\n" +
+		"\"'=HYPERLINK(\"\"http://example.com\"\")\",1\n" +
+		"'+1,'-3\n" +
+		"'@SUM(A1),'\t0\n"
+	if buf.String() != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, buf.String())
+	}
+}
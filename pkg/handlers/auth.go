@@ -2,58 +2,146 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
+	"BRSBackend/pkg/api"
 	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/services"
 	"BRSBackend/pkg/validation"
 )
 
+const oidcStateCookie = "oidc_state"
+
 func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 	var loginReq dto.LoginRequest
 	if err := json.NewDecoder(r.Body).Decode(&loginReq); err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	if validationErrors := validation.ValidateStruct(loginReq); validationErrors != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, validation.FormatErrors(validationErrors))
+		h.writeValidationErrors(w, r, validationErrors)
 		return
 	}
 
 	response, sessionId, err := h.authService.Login(r.Context(), loginReq)
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusUnauthorized, err.Error())
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.setSessionCookie(w, sessionId)
+	h.writeResponse(w, http.StatusOK, response)
+}
+
+func (h *Handler) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+	if h.oidcService == nil {
+		h.writeErrorResponse(w, r, http.StatusNotFound, "Single sign-on is not configured")
+		return
+	}
+
+	authURL, state, err := h.oidcService.BeginLogin(r.Context())
+	if err != nil {
+		h.writeErrorResponse(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state,
+		Path:     "/login/oidc",
+		Domain:   h.cookie.Domain,
+		HttpOnly: true,
+		Secure:   h.cookie.Secure,
+		SameSite: http.SameSiteLaxMode,
+		Expires:  time.Now().Add(10 * time.Minute),
+	})
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+func (h *Handler) OIDCCallback(w http.ResponseWriter, r *http.Request, params api.OIDCCallbackParams) {
+	if h.oidcService == nil {
+		h.writeErrorResponse(w, r, http.StatusNotFound, "Single sign-on is not configured")
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    "",
+		Path:     "/login/oidc",
+		Domain:   h.cookie.Domain,
+		HttpOnly: true,
+		Secure:   h.cookie.Secure,
+		SameSite: http.SameSiteLaxMode,
+		Expires:  time.Now().Add(-1 * time.Hour),
+	})
+
+	if params.Error != nil {
+		message := *params.Error
+		if params.ErrorDescription != nil {
+			message = fmt.Sprintf("%s: %s", message, *params.ErrorDescription)
+		}
+		h.writeErrorResponse(w, r, http.StatusUnauthorized, message)
+		return
+	}
+
+	if params.Code == nil || params.State == nil {
+		h.writeErrorResponse(w, r, http.StatusUnauthorized, "Missing authorization code or state")
+		return
+	}
+
+	cookie, err := r.Cookie(oidcStateCookie)
+	if err != nil || cookie.Value != *params.State {
+		h.writeErrorResponse(w, r, http.StatusUnauthorized, services.ErrOIDCInvalidState.Error())
+		return
+	}
+
+	result, err := h.oidcService.CompleteLogin(r.Context(), *params.State, *params.Code)
+	if err != nil {
+		if errors.Is(err, services.ErrOIDCAccessDenied) {
+			h.writeErrorResponse(w, r, http.StatusForbidden, err.Error())
+			return
+		}
+		h.writeErrorResponse(w, r, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	h.setSessionCookie(w, result.SessionId)
+	http.Redirect(w, r, result.Redirect, http.StatusFound)
+}
+
+func (h *Handler) setSessionCookie(w http.ResponseWriter, sessionId string) {
 	cookie := &http.Cookie{
-		Name:     "session_id",
+		Name:     h.cookie.Name,
 		Value:    sessionId,
 		Path:     "/",
+		Domain:   h.cookie.Domain,
 		HttpOnly: true,
-		Secure:   false,
-		SameSite: http.SameSiteLaxMode,
+		Secure:   h.cookie.Secure,
+		SameSite: h.cookie.SameSite,
 		Expires:  time.Now().Add(24 * time.Hour),
 	}
 
 	http.SetCookie(w, cookie)
-	h.writeResponse(w, http.StatusOK, response)
 }
 
 func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
-	cookie, err := r.Cookie("session_id")
+	cookie, err := r.Cookie(h.cookie.Name)
 	if err == nil {
 		h.authService.Logout(r.Context(), cookie.Value)
 	}
 
 	clearCookie := &http.Cookie{
-		Name:     "session_id",
+		Name:     h.cookie.Name,
 		Value:    "",
 		Path:     "/",
+		Domain:   h.cookie.Domain,
 		HttpOnly: true,
-		Secure:   false,
-		SameSite: http.SameSiteLaxMode,
+		Secure:   h.cookie.Secure,
+		SameSite: h.cookie.SameSite,
 		Expires:  time.Now().Add(-1 * time.Hour),
 	}
 
@@ -62,15 +150,15 @@ func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) Librarian(w http.ResponseWriter, r *http.Request) {
-	cookie, err := r.Cookie("session_id")
+	cookie, err := r.Cookie(h.cookie.Name)
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusUnauthorized, "invalid session or expired session")
+		h.writeErrorResponse(w, r, http.StatusUnauthorized, "invalid session or expired session")
 		return
 	}
 
 	response, err := h.authService.GetLibrarian(r.Context(), cookie.Value)
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusUnauthorized, "invalid session or expired session")
+		h.writeErrorResponse(w, r, http.StatusUnauthorized, "invalid session or expired session")
 		return
 	}
 
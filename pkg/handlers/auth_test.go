@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"BRSBackend/pkg/api"
 	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/services"
 )
@@ -125,3 +126,143 @@ func TestLibrarian(t *testing.T) {
 		}
 	})
 }
+
+func TestOIDCLogin(t *testing.T) {
+	t.Run("redirects to identity provider", func(t *testing.T) {
+		mockOIDCService := &services.MockOIDCService{
+			BeginLoginFunc: func(ctx context.Context) (string, string, error) {
+				return "https://idp.example.com/authorize?state=abc", "abc", nil
+			},
+		}
+
+		h := NewHandler(&services.Service{OIDC: mockOIDCService})
+
+		req := httptest.NewRequest(http.MethodGet, "/login/oidc", nil)
+		w := httptest.NewRecorder()
+
+		h.OIDCLogin(w, req)
+
+		if w.Code != http.StatusFound {
+			t.Errorf("expected status code %d, got %d", http.StatusFound, w.Code)
+		}
+
+		if location := w.Header().Get("Location"); location != "https://idp.example.com/authorize?state=abc" {
+			t.Errorf("unexpected redirect location '%s'", location)
+		}
+	})
+
+	t.Run("single sign-on not configured", func(t *testing.T) {
+		h := NewHandler(&services.Service{})
+
+		req := httptest.NewRequest(http.MethodGet, "/login/oidc", nil)
+		w := httptest.NewRecorder()
+
+		h.OIDCLogin(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status code %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
+
+func TestOIDCCallback(t *testing.T) {
+	code := "code"
+	state := "abc"
+
+	t.Run("successful callback sets session cookie", func(t *testing.T) {
+		mockOIDCService := &services.MockOIDCService{
+			CompleteLoginFunc: func(ctx context.Context, state, code string) (*dto.OIDCLoginResult, error) {
+				return &dto.OIDCLoginResult{SessionId: "session-id", Redirect: "/"}, nil
+			},
+		}
+
+		h := NewHandler(&services.Service{OIDC: mockOIDCService})
+
+		req := httptest.NewRequest(http.MethodGet, "/login/oidc/callback?code=code&state=abc", nil)
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: "abc"})
+		w := httptest.NewRecorder()
+
+		h.OIDCCallback(w, req, api.OIDCCallbackParams{Code: &code, State: &state})
+
+		if w.Code != http.StatusFound {
+			t.Errorf("expected status code %d, got %d", http.StatusFound, w.Code)
+		}
+
+		found := false
+		for _, cookie := range w.Result().Cookies() {
+			if cookie.Name == "session_id" && cookie.Value == "session-id" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected session_id cookie to be set")
+		}
+	})
+
+	t.Run("state cookie mismatch", func(t *testing.T) {
+		h := NewHandler(&services.Service{OIDC: &services.MockOIDCService{}})
+
+		req := httptest.NewRequest(http.MethodGet, "/login/oidc/callback?code=code&state=abc", nil)
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: "other"})
+		w := httptest.NewRecorder()
+
+		h.OIDCCallback(w, req, api.OIDCCallbackParams{Code: &code, State: &state})
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("expected status code %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+
+	t.Run("account without librarian role", func(t *testing.T) {
+		mockOIDCService := &services.MockOIDCService{
+			CompleteLoginFunc: func(ctx context.Context, state, code string) (*dto.OIDCLoginResult, error) {
+				return nil, services.ErrOIDCAccessDenied
+			},
+		}
+
+		h := NewHandler(&services.Service{OIDC: mockOIDCService})
+
+		req := httptest.NewRequest(http.MethodGet, "/login/oidc/callback?code=code&state=abc", nil)
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: "abc"})
+		w := httptest.NewRecorder()
+
+		h.OIDCCallback(w, req, api.OIDCCallbackParams{Code: &code, State: &state})
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("expected status code %d, got %d", http.StatusForbidden, w.Code)
+		}
+	})
+}
+
+func TestLoginCookieOptions(t *testing.T) {
+	mockAuthService := &services.MockAuthService{
+		LoginFunc: func(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, string, error) {
+			return &dto.LoginResponse{Message: "Login successful"}, "session-id", nil
+		},
+	}
+
+	h := NewHandler(&services.Service{Auth: mockAuthService}, WithCookieOptions(CookieOptions{
+		Name:     "brs_session",
+		Domain:   "library.example.edu",
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	}))
+
+	body := dto.LoginRequest{User: "test", Pass: "password"}
+	bodyBytes, _ := json.Marshal(body)
+
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(bodyBytes))
+	w := httptest.NewRecorder()
+
+	h.Login(w, req)
+
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("expected 1 cookie, got %d", len(cookies))
+	}
+
+	cookie := cookies[0]
+	if cookie.Name != "brs_session" || !cookie.Secure || cookie.SameSite != http.SameSiteStrictMode || cookie.Domain != "library.example.edu" {
+		t.Errorf("unexpected cookie attributes: %+v", cookie)
+	}
+}
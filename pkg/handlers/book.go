@@ -19,17 +19,17 @@ func (h *Handler) AddBook(w http.ResponseWriter, r *http.Request) {
 	var book models.Book
 
 	if err := json.NewDecoder(r.Body).Decode(&book); err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	if validationErrors := validation.ValidateStruct(book); validationErrors != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, validation.FormatErrors(validationErrors))
+		h.writeValidationErrors(w, r, validationErrors)
 		return
 	}
 
 	if err := h.bookService.CreateBook(r.Context(), &book); err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, err.Error())
+		h.writeError(w, r, err)
 		return
 	}
 
@@ -49,7 +49,7 @@ func (h *Handler) ListOrSearchBooks(w http.ResponseWriter, r *http.Request, para
 		if params.Limit != nil && int(*params.Limit) > 0 {
 			paginationParams.Limit = int(*params.Limit)
 		} else {
-			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid limit parameter")
+			h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid limit parameter")
 			return
 		}
 	}
@@ -58,13 +58,21 @@ func (h *Handler) ListOrSearchBooks(w http.ResponseWriter, r *http.Request, para
 		if params.Offset != nil && int(*params.Limit) > 0 {
 			paginationParams.Offset = int(*params.Offset)
 		} else {
-			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid offset parameter")
+			h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid offset parameter")
+			return
 		}
 	}
 
-	allBooks, err := h.bookService.GetAllBooks(r.Context(), paginationParams)
+	if params.Sort != nil {
+		paginationParams.Sort = string(*params.Sort)
+	}
+	if params.Cursor != nil {
+		paginationParams.Cursor = *params.Cursor
+	}
+
+	allBooks, err := h.bookService.GetAllBooks(r.Context(), paginationParams, bookFiltersFromParams(params))
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, "Failed to retrieve books")
+		h.writeError(w, r, err)
 		return
 	}
 
@@ -73,26 +81,79 @@ func (h *Handler) ListOrSearchBooks(w http.ResponseWriter, r *http.Request, para
 		books[i] = *book
 	}
 
-	apiPagination := &api.PaginationInfo{
-		Offset:      &allBooks.Pagination.Offset,
-		Limit:       &allBooks.Pagination.Limit,
-		Total:       &allBooks.Pagination.Total,
-		HasNext:     &allBooks.Pagination.HasNext,
-		HasPrevious: &allBooks.Pagination.HasPrevious,
-	}
+	h.writeResponse(w, http.StatusOK, api.ListOrSearchBooks200JSONResponse{
+		Results:    &books,
+		Pagination: toAPIPagination(allBooks.Pagination),
+		Facets: &api.BookFacets{
+			Available:  &allBooks.Facets.Available,
+			OutOfStock: &allBooks.Facets.OutOfStock,
+		},
+	})
+}
 
-	h.writeResponse(w, http.StatusOK, api.ListOrSearchBooks200JSONResponse{Results: &books, Pagination: apiPagination})
+func bookFiltersFromParams(params api.ListOrSearchBooksParams) dto.BookFilters {
+	var filters dto.BookFilters
+	if params.Availability != nil {
+		filters.Availability = string(*params.Availability)
+	}
+	if params.MinCount != nil {
+		minCount := int(*params.MinCount)
+		filters.MinCount = &minCount
+	}
+	if params.MaxCount != nil {
+		maxCount := int(*params.MaxCount)
+		filters.MaxCount = &maxCount
+	}
+	if params.AddedFrom != nil {
+		filters.AddedFrom = &params.AddedFrom.Time
+	}
+	if params.AddedTo != nil {
+		filters.AddedTo = &params.AddedTo.Time
+	}
+	filters.CategoryID = params.CategoryId
+	if params.Tag != nil {
+		filters.Tags = *params.Tag
+	}
+	return filters
 }
 
 func (h *Handler) DeleteBookById(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
 	if id == uuid.Nil || id.String() == "" {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Book ID is required")
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Book ID is required")
 		return
 	}
 	if err := h.bookService.DeleteBook(r.Context(), id.String()); err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, err.Error())
+		h.writeError(w, r, err)
 		return
 	}
 
 	h.writeResponse(w, http.StatusCreated, "ok")
 }
+
+func (h *Handler) ClassifyBook(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
+	var req dto.BookClassification
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	book, err := h.bookService.ClassifyBook(r.Context(), id.String(), req)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, book)
+}
+
+func (h *Handler) ListBookTags(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.bookService.ListTags(r.Context())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if tags == nil {
+		tags = []dto.TagCount{}
+	}
+
+	h.writeResponse(w, http.StatusOK, map[string]any{"results": tags})
+}
@@ -7,11 +7,18 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	oapiTypes "github.com/oapi-codegen/runtime/types"
 
+	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/problem"
 	"BRSBackend/pkg/services"
 )
 
@@ -70,6 +77,87 @@ func TestAddBook(t *testing.T) {
 	})
 }
 
+func TestListOrSearchBooks(t *testing.T) {
+	t.Run("passes filters and returns facets", func(t *testing.T) {
+		var gotFilters dto.BookFilters
+		mockBookService := &services.MockBookService{
+			GetAllBooksFunc: func(ctx context.Context, params dto.PaginationParams, filters dto.BookFilters) (*dto.BooksResponse, error) {
+				gotFilters = filters
+				return &dto.BooksResponse{
+					Results:    []*models.Book{{Id: uuid.New(), Title: "Dune", Count: 2}},
+					Pagination: dto.PaginationInfo{Limit: params.Limit, Total: 1},
+					Facets:     dto.BookFacets{Available: 1, OutOfStock: 3},
+				}, nil
+			},
+		}
+
+		h := NewHandler(&services.Service{Book: mockBookService})
+
+		availability := api.Available
+		minCount, maxCount := int32(1), int32(5)
+		addedFrom := oapiTypes.Date{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+		req := httptest.NewRequest(http.MethodGet, "/books?availability=available&min_count=1&max_count=5&added_from=2026-01-01", nil)
+		w := httptest.NewRecorder()
+
+		h.ListOrSearchBooks(w, req, api.ListOrSearchBooksParams{
+			Availability: &availability,
+			MinCount:     &minCount,
+			MaxCount:     &maxCount,
+			AddedFrom:    &addedFrom,
+		})
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		if gotFilters.Availability != dto.AvailabilityAvailable {
+			t.Errorf("expected availability %q, got %q", dto.AvailabilityAvailable, gotFilters.Availability)
+		}
+		if gotFilters.MinCount == nil || *gotFilters.MinCount != 1 || gotFilters.MaxCount == nil || *gotFilters.MaxCount != 5 {
+			t.Errorf("unexpected count range %v..%v", gotFilters.MinCount, gotFilters.MaxCount)
+		}
+		if gotFilters.AddedFrom == nil || !gotFilters.AddedFrom.Equal(addedFrom.Time) || gotFilters.AddedTo != nil {
+			t.Errorf("unexpected date range %v..%v", gotFilters.AddedFrom, gotFilters.AddedTo)
+		}
+
+		var body api.ListOrSearchBooks200JSONResponse
+		if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if body.Facets == nil || *body.Facets.Available != 1 || *body.Facets.OutOfStock != 3 {
+			t.Errorf("unexpected facets %+v", body.Facets)
+		}
+	})
+
+	t.Run("passes category and tags", func(t *testing.T) {
+		var gotFilters dto.BookFilters
+		mockBookService := &services.MockBookService{
+			GetAllBooksFunc: func(ctx context.Context, params dto.PaginationParams, filters dto.BookFilters) (*dto.BooksResponse, error) {
+				gotFilters = filters
+				return &dto.BooksResponse{}, nil
+			},
+		}
+
+		h := NewHandler(&services.Service{Book: mockBookService})
+
+		categoryID := uuid.New()
+		tags := []string{"classic", "space opera"}
+		req := httptest.NewRequest(http.MethodGet, "/books?category_id="+categoryID.String()+"&tag=classic&tag=space+opera", nil)
+		w := httptest.NewRecorder()
+
+		h.ListOrSearchBooks(w, req, api.ListOrSearchBooksParams{CategoryId: &categoryID, Tag: &tags})
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		if gotFilters.CategoryID == nil || *gotFilters.CategoryID != categoryID {
+			t.Errorf("expected category %s, got %v", categoryID, gotFilters.CategoryID)
+		}
+		if !slices.Equal(gotFilters.Tags, tags) {
+			t.Errorf("expected tags %v, got %v", tags, gotFilters.Tags)
+		}
+	})
+}
+
 func TestDeleteBook(t *testing.T) {
 	t.Run("successful delete book", func(t *testing.T) {
 		mockBookService := &services.MockBookService{
@@ -120,8 +208,39 @@ func TestDeleteBook(t *testing.T) {
 
 		h.DeleteBookById(w, req, id)
 
-		if w.Code != http.StatusUnprocessableEntity {
-			t.Errorf("expected status code %d, got %d", http.StatusUnprocessableEntity, w.Code)
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("expected status code %d, got %d", http.StatusInternalServerError, w.Code)
+		}
+	})
+
+	t.Run("book not found", func(t *testing.T) {
+		mockBookService := &services.MockBookService{
+			DeleteBookFunc: func(ctx context.Context, id string) error {
+				return apperrors.NotFound("book_not_found", "book not found")
+			},
+		}
+
+		h := NewHandler(&services.Service{Book: mockBookService})
+
+		id := uuid.New()
+		req := httptest.NewRequest(http.MethodDelete, "/books/"+id.String(), nil)
+		w := httptest.NewRecorder()
+
+		h.DeleteBookById(w, req, id)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status code %d, got %d", http.StatusNotFound, w.Code)
+		}
+		if ct := w.Header().Get("Content-Type"); ct != problem.ContentType {
+			t.Errorf("expected content type %q, got %q", problem.ContentType, ct)
+		}
+
+		var body problem.Details
+		if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if body.Code != "book_not_found" {
+			t.Errorf("expected code %q, got %q", "book_not_found", body.Code)
 		}
 	})
 }
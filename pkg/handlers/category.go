@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	oapiTypes "github.com/oapi-codegen/runtime/types"
+
+	"BRSBackend/pkg/dto"
+)
+
+func (h *Handler) ListCategories(w http.ResponseWriter, r *http.Request) {
+	categories, err := h.categoryService.ListCategories(r.Context())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if categories == nil {
+		categories = []*dto.CategoryNode{}
+	}
+
+	h.writeResponse(w, http.StatusOK, map[string]any{"results": categories})
+}
+
+func (h *Handler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	var req dto.CategoryRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	category, err := h.categoryService.CreateCategory(r.Context(), req)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/categories/%s", category.Id))
+	h.writeResponse(w, http.StatusCreated, category)
+}
+
+func (h *Handler) GetCategory(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
+	category, err := h.categoryService.GetCategory(r.Context(), id.String())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, category)
+}
+
+func (h *Handler) UpdateCategory(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
+	var req dto.CategoryRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	category, err := h.categoryService.UpdateCategory(r.Context(), id.String(), req)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, category)
+}
+
+func (h *Handler) DeleteCategory(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
+	if err := h.categoryService.DeleteCategory(r.Context(), id.String()); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
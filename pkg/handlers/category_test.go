@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/services"
+)
+
+func TestListCategories(t *testing.T) {
+	t.Run("empty tree", func(t *testing.T) {
+		mockCategoryService := &services.MockCategoryService{
+			ListCategoriesFunc: func(ctx context.Context) ([]*dto.CategoryNode, error) {
+				return nil, nil
+			},
+		}
+		h := NewHandler(&services.Service{Category: mockCategoryService})
+
+		req := httptest.NewRequest(http.MethodGet, "/categories", nil)
+		w := httptest.NewRecorder()
+
+		h.ListCategories(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		if body := strings.TrimSpace(w.Body.String()); body != `{"results":[]}` {
+			t.Errorf("expected an empty list, got %s", body)
+		}
+	})
+}
+
+func TestCreateCategory(t *testing.T) {
+	t.Run("successful create", func(t *testing.T) {
+		id, parentID := uuid.New(), uuid.New()
+		mockCategoryService := &services.MockCategoryService{
+			CreateCategoryFunc: func(ctx context.Context, req dto.CategoryRequest) (*models.Category, error) {
+				if req.Name != "Science Fiction" || req.ParentID == nil || *req.ParentID != parentID {
+					t.Errorf("unexpected request %+v", req)
+				}
+				return &models.Category{Id: id, Name: req.Name, ParentId: req.ParentID}, nil
+			},
+		}
+		h := NewHandler(&services.Service{Category: mockCategoryService})
+
+		body := `{"name":"Science Fiction","parent_id":"` + parentID.String() + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/categories", strings.NewReader(body))
+		w := httptest.NewRecorder()
+
+		h.CreateCategory(w, req)
+
+		if w.Code != http.StatusCreated {
+			t.Errorf("expected status code %d, got %d", http.StatusCreated, w.Code)
+		}
+		if want := "/categories/" + id.String(); w.Header().Get("Location") != want {
+			t.Errorf("expected location %s, got %s", want, w.Header().Get("Location"))
+		}
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		h := NewHandler(&services.Service{Category: &services.MockCategoryService{}})
+
+		req := httptest.NewRequest(http.MethodPost, "/categories", strings.NewReader(`{}`))
+		w := httptest.NewRecorder()
+
+		h.CreateCategory(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status code %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+}
+
+func TestDeleteCategory(t *testing.T) {
+	t.Run("category not empty", func(t *testing.T) {
+		mockCategoryService := &services.MockCategoryService{
+			DeleteCategoryFunc: func(ctx context.Context, id string) error {
+				return apperrors.Conflict("category_not_empty", "category has %d subcategories and %d books", 1, 0)
+			},
+		}
+		h := NewHandler(&services.Service{Category: mockCategoryService})
+
+		id := uuid.New()
+		req := httptest.NewRequest(http.MethodDelete, "/categories/"+id.String(), nil)
+		w := httptest.NewRecorder()
+
+		h.DeleteCategory(w, req, id)
+
+		if w.Code != http.StatusConflict {
+			t.Errorf("expected status code %d, got %d", http.StatusConflict, w.Code)
+		}
+	})
+}
+
+func TestClassifyBook(t *testing.T) {
+	t.Run("successful classification", func(t *testing.T) {
+		id, categoryID := uuid.New(), uuid.New()
+		mockBookService := &services.MockBookService{
+			ClassifyBookFunc: func(ctx context.Context, bookID string, c dto.BookClassification) (*models.Book, error) {
+				if bookID != id.String() || c.CategoryID == nil || *c.CategoryID != categoryID || len(c.Tags) != 2 {
+					t.Errorf("unexpected classification of %s: %+v", bookID, c)
+				}
+				return &models.Book{Id: id, Title: "Dune", CategoryId: c.CategoryID, CallNumber: c.CallNumber, Tags: c.Tags}, nil
+			},
+		}
+		h := NewHandler(&services.Service{Book: mockBookService})
+
+		body := `{"category_id":"` + categoryID.String() + `","call_number":"813.54 HER","tags":["classic","space opera"]}`
+		req := httptest.NewRequest(http.MethodPut, "/books/"+id.String()+"/classification", strings.NewReader(body))
+		w := httptest.NewRecorder()
+
+		h.ClassifyBook(w, req, id)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		var book models.Book
+		if err := json.NewDecoder(w.Body).Decode(&book); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if book.CallNumber == nil || *book.CallNumber != "813.54 HER" {
+			t.Errorf("unexpected call number %v", book.CallNumber)
+		}
+	})
+
+	t.Run("too many tags", func(t *testing.T) {
+		h := NewHandler(&services.Service{Book: &services.MockBookService{}})
+
+		tags := make([]string, 21)
+		for i := range tags {
+			tags[i] = `"t"`
+		}
+		body := `{"tags":[` + strings.Join(tags, ",") + `]}`
+		id := uuid.New()
+		req := httptest.NewRequest(http.MethodPut, "/books/"+id.String()+"/classification", strings.NewReader(body))
+		w := httptest.NewRecorder()
+
+		h.ClassifyBook(w, req, id)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status code %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+}
@@ -3,42 +3,152 @@ package handlers
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 
 	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/export"
+	"BRSBackend/pkg/middleware"
+	"BRSBackend/pkg/pdf"
+	"BRSBackend/pkg/printing"
+	"BRSBackend/pkg/problem"
 	"BRSBackend/pkg/services"
+	"BRSBackend/pkg/validation"
 )
 
 type Handler struct {
-	bookService    services.BookService
-	authService    services.AuthService
-	studentService services.StudentService
-	rentService    services.RentService
-	reportService  services.ReportService
+	bookService     services.BookService
+	authService     services.AuthService
+	studentService  services.StudentService
+	rentService     services.RentService
+	reportService   services.ReportService
+	scheduleService services.ReportScheduleService
+	oidcService     services.OIDCService
+	healthService   services.HealthService
+	portalService   services.PortalService
+	kioskService    services.KioskService
+	receiptService  services.ReceiptService
+	labelService    services.LabelService
+	categoryService services.CategoryService
+	cookie          CookieOptions
+	portalCookie    PortalCookieOptions
+	receiptTemplate printing.ReceiptTemplate
+	buildInfo       dto.BuildInfo
 }
 
-func NewHandler(svc *services.Service) *Handler {
-	return &Handler{
-		bookService:    svc.Book,
-		authService:    svc.Auth,
-		studentService: svc.Student,
-		rentService:    svc.Rent,
-		reportService:  svc.Report,
+type CookieOptions struct {
+	Name     string
+	Domain   string
+	Secure   bool
+	SameSite http.SameSite
+}
+
+// PortalCookieOptions name the student session cookie. It shares the domain,
+// Secure and SameSite settings of the librarian cookie.
+type PortalCookieOptions struct {
+	Name   string
+	MaxAge time.Duration
+}
+
+type Option func(*Handler)
+
+func WithCookieOptions(opts CookieOptions) Option {
+	return func(h *Handler) {
+		h.cookie = opts
 	}
 }
 
-type ErrorResponse struct {
-	Message string `json:"message"`
-	Code    int    `json:"code"`
+func WithPortalCookieOptions(opts PortalCookieOptions) Option {
+	return func(h *Handler) {
+		h.portalCookie = opts
+	}
 }
 
-func (h *Handler) writeErrorResponse(w http.ResponseWriter, statusCode int, message string) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(ErrorResponse{Message: message, Code: statusCode})
+func WithReceiptTemplate(template printing.ReceiptTemplate) Option {
+	return func(h *Handler) {
+		h.receiptTemplate = template
+	}
+}
+
+func WithBuildInfo(info dto.BuildInfo) Option {
+	return func(h *Handler) {
+		h.buildInfo = info
+	}
+}
+
+func NewHandler(svc *services.Service, opts ...Option) *Handler {
+	h := &Handler{
+		bookService:     svc.Book,
+		authService:     svc.Auth,
+		studentService:  svc.Student,
+		rentService:     svc.Rent,
+		reportService:   svc.Report,
+		scheduleService: svc.Schedule,
+		oidcService:     svc.OIDC,
+		healthService:   svc.Health,
+		portalService:   svc.Portal,
+		kioskService:    svc.Kiosk,
+		receiptService:  svc.Receipt,
+		labelService:    svc.Label,
+		categoryService: svc.Category,
+		cookie: CookieOptions{
+			Name:     "session_id",
+			SameSite: http.SameSiteLaxMode,
+		},
+		portalCookie: PortalCookieOptions{
+			Name:   "student_session",
+			MaxAge: 12 * time.Hour,
+		},
+		receiptTemplate: printing.ReceiptTemplate{
+			LibraryName: "Library",
+			Paper:       pdf.A4,
+			TextWidth:   42,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+func (h *Handler) writeErrorResponse(w http.ResponseWriter, r *http.Request, statusCode int, message string) {
+	problem.Write(w, r, problem.FromStatus(statusCode, message))
+}
+
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	p := problem.FromError(err)
+	if p.Status >= http.StatusInternalServerError {
+		slog.ErrorContext(r.Context(), "Request failed", "error", err)
+	}
+	problem.Write(w, r, p)
+}
+
+func (h *Handler) writeValidationErrors(w http.ResponseWriter, r *http.Request, errors []*validation.ErrorResponse) {
+	h.writeError(w, r, validation.AsError(errors))
+}
+
+func toAPIPagination(p dto.PaginationInfo) *api.PaginationInfo {
+	info := &api.PaginationInfo{
+		Offset:      &p.Offset,
+		Limit:       &p.Limit,
+		Total:       &p.Total,
+		HasNext:     &p.HasNext,
+		HasPrevious: &p.HasPrevious,
+	}
+	if p.NextCursor != "" {
+		info.NextCursor = &p.NextCursor
+	}
+	if p.PrevCursor != "" {
+		info.PrevCursor = &p.PrevCursor
+	}
+	return info
 }
 
 func (h *Handler) writeResponse(w http.ResponseWriter, statusCode int, response any) {
@@ -47,12 +157,28 @@ func (h *Handler) writeResponse(w http.ResponseWriter, statusCode int, response
 	json.NewEncoder(w).Encode(response)
 }
 
+// writeCSV sends the table as a CSV attachment named after it.
+func (h *Handler) writeCSV(w http.ResponseWriter, r *http.Request, table *export.Table) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", table.Name+".csv"))
+	w.WriteHeader(http.StatusOK)
+	if err := export.WriteCSV(w, table); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write CSV", "error", err)
+	}
+}
+
 func (h *Handler) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
 	swagger, err := api.GetSwagger()
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to get swagger: %v", err))
+		h.writeErrorResponse(w, r, http.StatusBadRequest, fmt.Sprintf("failed to get swagger: %v", err))
 		return
 	}
+	if scheme, ok := swagger.Components.SecuritySchemes[middleware.LibrarianSecurityScheme]; ok && scheme.Value != nil {
+		scheme.Value.Name = h.cookie.Name
+	}
+	if scheme, ok := swagger.Components.SecuritySchemes[middleware.StudentSecurityScheme]; ok && scheme.Value != nil {
+		scheme.Value.Name = h.portalCookie.Name
+	}
 	headers := r.Header
 
 	serverUrl := &url.URL{}
@@ -77,3 +203,13 @@ func (h *Handler) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
 
 	h.writeResponse(w, http.StatusOK, swagger)
 }
+
+func (h *Handler) GetCSRFToken(w http.ResponseWriter, r *http.Request) {
+	token := middleware.CSRFToken(r.Context())
+	if token == "" {
+		h.writeErrorResponse(w, r, http.StatusNotFound, "CSRF protection is not enabled")
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, map[string]string{"csrf_token": token})
+}
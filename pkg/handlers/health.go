@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"net/http"
+
+	"BRSBackend/pkg/dto"
+)
+
+func (h *Handler) GetHealthz(w http.ResponseWriter, r *http.Request) {
+	h.writeResponse(w, http.StatusOK, dto.HealthResponse{Status: dto.HealthStatusOK})
+}
+
+func (h *Handler) GetReadyz(w http.ResponseWriter, r *http.Request) {
+	readiness := h.healthService.Readiness(r.Context())
+
+	statusCode := http.StatusOK
+	if readiness.Status != dto.HealthStatusOK {
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	h.writeResponse(w, statusCode, readiness)
+}
+
+func (h *Handler) GetVersion(w http.ResponseWriter, r *http.Request) {
+	h.writeResponse(w, http.StatusOK, h.buildInfo)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/services"
+)
+
+func TestGetReadyz(t *testing.T) {
+	t.Run("ready", func(t *testing.T) {
+		mockHealthService := &services.MockHealthService{
+			ReadinessFunc: func(ctx context.Context) *dto.HealthResponse {
+				return &dto.HealthResponse{
+					Status: dto.HealthStatusOK,
+					Checks: map[string]dto.HealthCheck{"database": {Status: dto.HealthStatusOK}},
+				}
+			},
+		}
+
+		h := NewHandler(&services.Service{Health: mockHealthService})
+
+		req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+		w := httptest.NewRecorder()
+
+		h.GetReadyz(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		mockHealthService := &services.MockHealthService{
+			ReadinessFunc: func(ctx context.Context) *dto.HealthResponse {
+				return &dto.HealthResponse{
+					Status: dto.HealthStatusFail,
+					Checks: map[string]dto.HealthCheck{
+						"database": {Status: dto.HealthStatusOK},
+						"schema":   {Status: dto.HealthStatusFail, Message: "schema version 0, expected 1"},
+					},
+				}
+			},
+		}
+
+		h := NewHandler(&services.Service{Health: mockHealthService})
+
+		req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+		w := httptest.NewRecorder()
+
+		h.GetReadyz(w, req)
+
+		if w.Code != http.StatusServiceUnavailable {
+			t.Errorf("expected status code %d, got %d", http.StatusServiceUnavailable, w.Code)
+		}
+
+		var response dto.HealthResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Errorf("failed to decode response body: %v", err)
+		}
+
+		if response.Checks["schema"].Status != dto.HealthStatusFail {
+			t.Errorf("expected schema check to fail, got %+v", response.Checks["schema"])
+		}
+	})
+}
+
+func TestGetVersion(t *testing.T) {
+	info := dto.BuildInfo{Ref: "abc123", Date: "2026-01-01", GoVersion: "go1.24.3", SchemaVersion: 1}
+	h := NewHandler(&services.Service{}, WithBuildInfo(info))
+
+	req := httptest.NewRequest(http.MethodGet, "/version", nil)
+	w := httptest.NewRecorder()
+
+	h.GetVersion(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response dto.BuildInfo
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Errorf("failed to decode response body: %v", err)
+	}
+
+	if response != info {
+		t.Errorf("expected %+v, got %+v", info, response)
+	}
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	oapiTypes "github.com/oapi-codegen/runtime/types"
+
+	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/middleware"
+	"BRSBackend/pkg/models"
+)
+
+func (h *Handler) ListKiosks(w http.ResponseWriter, r *http.Request) {
+	kiosks, err := h.kioskService.ListKiosks(r.Context())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if kiosks == nil {
+		kiosks = []*models.KioskDevice{}
+	}
+
+	h.writeResponse(w, http.StatusOK, map[string]any{"results": kiosks})
+}
+
+func (h *Handler) RegisterKiosk(w http.ResponseWriter, r *http.Request) {
+	var req dto.KioskRegistrationRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	var librarianID *uuid.UUID
+	if librarian := middleware.Librarian(r.Context()); librarian != nil {
+		librarianID = &librarian.Id
+	}
+
+	registration, err := h.kioskService.RegisterKiosk(r.Context(), req, librarianID)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusCreated, registration)
+}
+
+func (h *Handler) RevokeKiosk(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
+	if err := h.kioskService.RevokeKiosk(r.Context(), id.String()); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ListKioskTransactions(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID, params api.ListKioskTransactionsParams) {
+	paginationParams := dto.PaginationParams{Limit: 10}
+	if params.Limit != nil && int(*params.Limit) > 0 {
+		paginationParams.Limit = int(*params.Limit)
+	}
+	if params.Offset != nil && int(*params.Offset) > 0 {
+		paginationParams.Offset = int(*params.Offset)
+	}
+	if params.Cursor != nil {
+		paginationParams.Cursor = *params.Cursor
+	}
+
+	transactions, err := h.kioskService.GetTransactions(r.Context(), id.String(), paginationParams)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, transactions)
+}
+
+// kiosk returns the kiosk making the request. The request validator only
+// lets kiosk requests through with a valid key, so a missing kiosk is
+// answered as unauthenticated rather than trusted.
+func (h *Handler) kiosk(w http.ResponseWriter, r *http.Request) (*models.KioskDevice, bool) {
+	kiosk := middleware.Kiosk(r.Context())
+	if kiosk == nil {
+		h.writeErrorResponse(w, r, http.StatusUnauthorized, "invalid or revoked kiosk key")
+		return nil, false
+	}
+	return kiosk, true
+}
+
+func (h *Handler) KioskIdentify(w http.ResponseWriter, r *http.Request) {
+	if _, ok := h.kiosk(w, r); !ok {
+		return
+	}
+
+	var req dto.KioskIdentifyRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	student, err := h.kioskService.Identify(r.Context(), req.CardId)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, student)
+}
+
+func (h *Handler) KioskCheckout(w http.ResponseWriter, r *http.Request) {
+	kiosk, ok := h.kiosk(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.KioskRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	receipt, err := h.kioskService.Checkout(r.Context(), kiosk, req)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusCreated, receipt)
+}
+
+func (h *Handler) KioskReturn(w http.ResponseWriter, r *http.Request) {
+	kiosk, ok := h.kiosk(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.KioskRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	receipt, err := h.kioskService.Return(r.Context(), kiosk, req)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, receipt)
+}
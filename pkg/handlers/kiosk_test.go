@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/middleware"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/services"
+)
+
+func TestRegisterKiosk(t *testing.T) {
+	librarian := &models.Librarian{Id: uuid.New()}
+	mockKioskService := &services.MockKioskService{
+		RegisterKioskFunc: func(ctx context.Context, req dto.KioskRegistrationRequest, librarianID *uuid.UUID) (*dto.KioskRegistration, error) {
+			if librarianID == nil || *librarianID != librarian.Id {
+				t.Errorf("expected the kiosk to be registered by %s", librarian.Id)
+			}
+			return &dto.KioskRegistration{KioskDevice: &models.KioskDevice{Id: uuid.New(), Name: req.Name}, Key: "kiosk-key"}, nil
+		},
+	}
+	h := NewHandler(&services.Service{Kiosk: mockKioskService})
+
+	bodyBytes, _ := json.Marshal(dto.KioskRegistrationRequest{Name: "Lobby"})
+	req := httptest.NewRequest(http.MethodPost, "/kiosks", bytes.NewReader(bodyBytes))
+	req = req.WithContext(context.WithValue(req.Context(), middleware.LibrarianContextKey, librarian))
+	w := httptest.NewRecorder()
+
+	h.RegisterKiosk(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status code %d, got %d", http.StatusCreated, w.Code)
+	}
+	var body map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if body["key"] != "kiosk-key" || body["name"] != "Lobby" {
+		t.Errorf("unexpected response %v", body)
+	}
+	if _, ok := body["key_hash"]; ok {
+		t.Errorf("expected the key hash to stay hidden")
+	}
+}
+
+func TestKioskCheckout(t *testing.T) {
+	t.Run("checkout at a kiosk", func(t *testing.T) {
+		kiosk := &models.KioskDevice{Id: uuid.New(), Name: "Lobby"}
+		mockKioskService := &services.MockKioskService{
+			CheckoutFunc: func(ctx context.Context, k *models.KioskDevice, req dto.KioskRequest) (*dto.Receipt, error) {
+				if k.Id != kiosk.Id {
+					t.Errorf("expected kiosk %s, got %s", kiosk.Id, k.Id)
+				}
+				return &dto.Receipt{Kind: "checkout", CartID: uuid.New(), Kiosk: k.Name}, nil
+			},
+		}
+		h := NewHandler(&services.Service{Kiosk: mockKioskService})
+
+		bodyBytes, _ := json.Marshal(dto.KioskRequest{CardId: "C-100", BookCodes: []string{"B-1"}})
+		req := httptest.NewRequest(http.MethodPost, "/kiosk/checkout", bytes.NewReader(bodyBytes))
+		req = req.WithContext(context.WithValue(req.Context(), middleware.KioskContextKey, kiosk))
+		w := httptest.NewRecorder()
+
+		h.KioskCheckout(w, req)
+
+		if w.Code != http.StatusCreated {
+			t.Errorf("expected status code %d, got %d", http.StatusCreated, w.Code)
+		}
+	})
+
+	t.Run("librarian session is not a kiosk", func(t *testing.T) {
+		h := NewHandler(&services.Service{Kiosk: &services.MockKioskService{}})
+
+		bodyBytes, _ := json.Marshal(dto.KioskRequest{CardId: "C-100", BookCodes: []string{"B-1"}})
+		req := httptest.NewRequest(http.MethodPost, "/kiosk/checkout", bytes.NewReader(bodyBytes))
+		req = req.WithContext(context.WithValue(req.Context(), middleware.LibrarianContextKey, &models.Librarian{}))
+		w := httptest.NewRecorder()
+
+		h.KioskCheckout(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("expected status code %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+}
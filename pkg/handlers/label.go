@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"log/slog"
+	"net/http"
+
+	oapiTypes "github.com/oapi-codegen/runtime/types"
+
+	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/barcode"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/printing"
+)
+
+// code128Height is the height of Code 128 bars in modules, about a quarter of
+// the width of a short barcode.
+const code128Height = 40
+
+func (h *Handler) GetBookBarcode(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID, params api.GetBookBarcodeParams) {
+	var symbology barcode.Symbology
+	if params.Type != nil {
+		symbology = barcode.Symbology(*params.Type)
+	}
+	var value string
+	if params.Value != nil {
+		value = string(*params.Value)
+	}
+
+	symbol, err := h.labelService.GetBookBarcode(r.Context(), id.String(), symbology, value)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.writeBarcode(w, r, symbol, params.Scale, fmt.Sprintf("book-%s.png", id))
+}
+
+func (h *Handler) GetStudentBarcode(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID, params api.GetStudentBarcodeParams) {
+	var symbology barcode.Symbology
+	if params.Type != nil {
+		symbology = barcode.Symbology(*params.Type)
+	}
+
+	symbol, err := h.labelService.GetStudentBarcode(r.Context(), id.String(), symbology)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.writeBarcode(w, r, symbol, params.Scale, fmt.Sprintf("student-%s.png", id))
+}
+
+func (h *Handler) writeBarcode(w http.ResponseWriter, r *http.Request, symbol *barcode.Symbol, scale *int, filename string) {
+	size := 3
+	if scale != nil {
+		size = *scale
+	}
+	if size < 1 || size > 20 {
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid scale parameter")
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, symbol.Image(size, code128Height*size)); err != nil {
+		h.writeError(w, r, fmt.Errorf("failed to encode barcode: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	if _, err := buf.WriteTo(w); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write barcode", "error", err)
+	}
+}
+
+func (h *Handler) PrintBookLabels(w http.ResponseWriter, r *http.Request) {
+	var req dto.BookLabelRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+	template, ok := h.labelTemplate(w, r, req.LabelSheet)
+	if !ok {
+		return
+	}
+
+	labels, err := h.labelService.GetBookLabels(r.Context(), req)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.writeLabels(w, r, template, labels, req.LabelSheet, "book-labels.pdf")
+}
+
+func (h *Handler) PrintStudentLabels(w http.ResponseWriter, r *http.Request) {
+	var req dto.StudentLabelRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+	template, ok := h.labelTemplate(w, r, req.LabelSheet)
+	if !ok {
+		return
+	}
+
+	labels, err := h.labelService.GetStudentLabels(r.Context(), req)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.writeLabels(w, r, template, labels, req.LabelSheet, "student-labels.pdf")
+}
+
+// labelTemplate looks up the template of the sheet, 5160 by default, and
+// checks that the start position is on it.
+func (h *Handler) labelTemplate(w http.ResponseWriter, r *http.Request, sheet dto.LabelSheet) (printing.LabelTemplate, bool) {
+	name := sheet.Template
+	if name == "" {
+		name = "5160"
+	}
+	template, ok := printing.LabelTemplates[name]
+	if !ok {
+		h.writeError(w, r, apperrors.Validation("unknown_template", fmt.Sprintf("unknown label template %q", name),
+			apperrors.FieldError{Field: "template", Code: "unknown_template", Message: fmt.Sprintf("unknown label template %q", name)}))
+		return template, false
+	}
+	if sheet.Start > template.PerSheet() {
+		message := fmt.Sprintf("template %s has %d labels per sheet", name, template.PerSheet())
+		h.writeError(w, r, apperrors.Validation("invalid_start", message,
+			apperrors.FieldError{Field: "start", Code: "invalid_start", Message: message}))
+		return template, false
+	}
+	return template, true
+}
+
+func (h *Handler) writeLabels(w http.ResponseWriter, r *http.Request, template printing.LabelTemplate, labels []dto.Label, sheet dto.LabelSheet, filename string) {
+	start, copies := max(sheet.Start, 1), max(sheet.Copies, 1)
+
+	// As with receipts, the sheets are rendered in full before anything is
+	// sent.
+	var buf bytes.Buffer
+	if err := template.WritePDF(&buf, labels, start, copies); err != nil {
+		h.writeError(w, r, fmt.Errorf("failed to render labels: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	if _, err := buf.WriteTo(w); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write labels", "error", err)
+	}
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/barcode"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/services"
+)
+
+func TestGetBookBarcode(t *testing.T) {
+	bookID := uuid.New()
+	mockLabelService := &services.MockLabelService{
+		GetBookBarcodeFunc: func(ctx context.Context, id string, symbology barcode.Symbology, value string) (*barcode.Symbol, error) {
+			if id != bookID.String() {
+				return nil, apperrors.NotFound("book_not_found", "book not found")
+			}
+			if symbology != barcode.QR || value != "id" {
+				t.Errorf("expected a QR code of the id, got %q of %q", symbology, value)
+			}
+			return barcode.Encode(symbology, id)
+		},
+	}
+	h := NewHandler(&services.Service{Label: mockLabelService})
+	symbology, value := api.GetBookBarcodeParamsTypeQr, api.Id
+
+	t.Run("PNG image", func(t *testing.T) {
+		scale := 2
+		req := httptest.NewRequest(http.MethodGet, "/books/"+bookID.String()+"/barcode?type=qr&value=id&scale=2", nil)
+		w := httptest.NewRecorder()
+
+		h.GetBookBarcode(w, req, bookID, api.GetBookBarcodeParams{Type: &symbology, Value: &value, Scale: &scale})
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		img, err := png.Decode(w.Body)
+		if err != nil {
+			t.Fatalf("expected a PNG image: %v", err)
+		}
+		// A 36 byte id takes a version 3 code of 29 modules, plus the quiet zone.
+		if size := (29 + 8) * 2; img.Bounds().Dx() != size {
+			t.Errorf("expected an image %d pixels wide, got %d", size, img.Bounds().Dx())
+		}
+	})
+
+	t.Run("invalid scale", func(t *testing.T) {
+		scale := 0
+		req := httptest.NewRequest(http.MethodGet, "/books/"+bookID.String()+"/barcode?scale=0", nil)
+		w := httptest.NewRecorder()
+
+		h.GetBookBarcode(w, req, bookID, api.GetBookBarcodeParams{Type: &symbology, Value: &value, Scale: &scale})
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status code %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+}
+
+func TestPrintBookLabels(t *testing.T) {
+	mockLabelService := &services.MockLabelService{
+		GetBookLabelsFunc: func(ctx context.Context, req dto.BookLabelRequest) ([]dto.Label, error) {
+			labels := make([]dto.Label, len(req.IDs))
+			for i := range req.IDs {
+				symbol, err := barcode.Encode(barcode.Code128, "00000042")
+				if err != nil {
+					return nil, err
+				}
+				labels[i] = dto.Label{Symbol: symbol, Lines: []string{"Dune"}}
+			}
+			return labels, nil
+		},
+	}
+	h := NewHandler(&services.Service{Label: mockLabelService})
+
+	post := func(body dto.BookLabelRequest) *httptest.ResponseRecorder {
+		bodyBytes, _ := json.Marshal(body)
+		req := httptest.NewRequest(http.MethodPost, "/books/labels", bytes.NewReader(bodyBytes))
+		w := httptest.NewRecorder()
+		h.PrintBookLabels(w, req)
+		return w
+	}
+
+	t.Run("label sheets", func(t *testing.T) {
+		// 32 labels from the last position take three sheets of 5160.
+		w := post(dto.BookLabelRequest{IDs: []uuid.UUID{uuid.New()}, LabelSheet: dto.LabelSheet{Start: 30, Copies: 32}})
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		if got := w.Header().Get("Content-Type"); got != "application/pdf" {
+			t.Errorf("expected a PDF, got %q", got)
+		}
+		if body := w.Body.String(); !strings.Contains(body, "/Count 3") || !strings.Contains(body, "(Dune) Tj") {
+			t.Errorf("expected three pages of labels")
+		}
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		w := post(dto.BookLabelRequest{IDs: []uuid.UUID{uuid.New()}, LabelSheet: dto.LabelSheet{Template: "1234"}})
+
+		if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "unknown_template") {
+			t.Errorf("expected an unknown template, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("start past the sheet", func(t *testing.T) {
+		w := post(dto.BookLabelRequest{IDs: []uuid.UUID{uuid.New()}, LabelSheet: dto.LabelSheet{Template: "L7163", Start: 15}})
+
+		if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "invalid_start") {
+			t.Errorf("expected an invalid start, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("no ids", func(t *testing.T) {
+		if w := post(dto.BookLabelRequest{}); w.Code != http.StatusBadRequest {
+			t.Errorf("expected status code %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/middleware"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/validation"
+)
+
+func (h *Handler) PortalLogin(w http.ResponseWriter, r *http.Request) {
+	var req dto.PortalLoginRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	response, sessionID, err := h.portalService.Login(r.Context(), req)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.setPortalCookie(w, sessionID, time.Now().Add(h.portalCookie.MaxAge))
+	h.writeResponse(w, http.StatusOK, response)
+}
+
+func (h *Handler) SendPortalLoginLink(w http.ResponseWriter, r *http.Request) {
+	var req dto.PortalLinkRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	if err := h.portalService.SendLoginLink(r.Context(), req.CardId); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusAccepted, map[string]string{"message": "If the card has an email address, a sign-in link was sent to it"})
+}
+
+func (h *Handler) PortalLoginWithToken(w http.ResponseWriter, r *http.Request) {
+	var req dto.PortalTokenRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	response, sessionID, err := h.portalService.LoginWithToken(r.Context(), req.Token)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.setPortalCookie(w, sessionID, time.Now().Add(h.portalCookie.MaxAge))
+	h.writeResponse(w, http.StatusOK, response)
+}
+
+func (h *Handler) PortalLogout(w http.ResponseWriter, r *http.Request) {
+	if cookie, err := r.Cookie(h.portalCookie.Name); err == nil {
+		h.portalService.Logout(r.Context(), cookie.Value)
+	}
+
+	h.setPortalCookie(w, "", time.Now().Add(-1*time.Hour))
+	h.writeResponse(w, http.StatusOK, map[string]string{"message": "Logout successful"})
+}
+
+func (h *Handler) decodeRequest(w http.ResponseWriter, r *http.Request, req any) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid request body")
+		return false
+	}
+
+	if validationErrors := validation.ValidateStruct(req); validationErrors != nil {
+		h.writeValidationErrors(w, r, validationErrors)
+		return false
+	}
+
+	return true
+}
+
+func (h *Handler) setPortalCookie(w http.ResponseWriter, sessionID string, expires time.Time) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     h.portalCookie.Name,
+		Value:    sessionID,
+		Path:     "/",
+		Domain:   h.cookie.Domain,
+		HttpOnly: true,
+		Secure:   h.cookie.Secure,
+		SameSite: h.cookie.SameSite,
+		Expires:  expires,
+	})
+}
+
+// portalStudent returns the student signed in to the portal. The request
+// validator only lets portal requests through with a student session, so
+// a missing student is answered as unauthenticated rather than trusted.
+func (h *Handler) portalStudent(w http.ResponseWriter, r *http.Request) (*models.Student, bool) {
+	student := middleware.Student(r.Context())
+	if student == nil {
+		h.writeErrorResponse(w, r, http.StatusUnauthorized, "invalid session or expired session")
+		return nil, false
+	}
+	return student, true
+}
+
+func (h *Handler) GetPortalStudent(w http.ResponseWriter, r *http.Request) {
+	student, ok := h.portalStudent(w, r)
+	if !ok {
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, student)
+}
+
+func (h *Handler) UpdatePortalContact(w http.ResponseWriter, r *http.Request) {
+	student, ok := h.portalStudent(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.StudentContactRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	updated, err := h.portalService.UpdateContact(r.Context(), student, req)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, updated)
+}
+
+func (h *Handler) ListPortalLoans(w http.ResponseWriter, r *http.Request) {
+	student, ok := h.portalStudent(w, r)
+	if !ok {
+		return
+	}
+
+	loans, err := h.portalService.GetLoans(r.Context(), student)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, loans)
+}
+
+func (h *Handler) GetPortalHistory(w http.ResponseWriter, r *http.Request, params api.GetPortalHistoryParams) {
+	student, ok := h.portalStudent(w, r)
+	if !ok {
+		return
+	}
+
+	paginationParams := dto.PaginationParams{Limit: 10}
+	if params.Limit != nil && int(*params.Limit) > 0 {
+		paginationParams.Limit = int(*params.Limit)
+	}
+	if params.Offset != nil && int(*params.Offset) > 0 {
+		paginationParams.Offset = int(*params.Offset)
+	}
+	if params.Sort != nil {
+		paginationParams.Sort = string(*params.Sort)
+	}
+	if params.Cursor != nil {
+		paginationParams.Cursor = *params.Cursor
+	}
+
+	history, err := h.portalService.GetHistory(r.Context(), student, paginationParams)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, history)
+}
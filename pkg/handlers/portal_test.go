@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/middleware"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/services"
+)
+
+func TestPortalLogin(t *testing.T) {
+	t.Run("successful login sets the student cookie", func(t *testing.T) {
+		mockPortalService := &services.MockPortalService{
+			LoginFunc: func(ctx context.Context, req dto.PortalLoginRequest) (*dto.PortalLoginResponse, string, error) {
+				return &dto.PortalLoginResponse{Message: "Login successful", StudentId: uuid.New()}, "student-session", nil
+			},
+		}
+		h := NewHandler(&services.Service{Portal: mockPortalService})
+
+		bodyBytes, _ := json.Marshal(dto.PortalLoginRequest{CardId: "C-100", Pin: "1234"})
+		req := httptest.NewRequest(http.MethodPost, "/portal/login", bytes.NewReader(bodyBytes))
+		w := httptest.NewRecorder()
+
+		h.PortalLogin(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		var found bool
+		for _, cookie := range w.Result().Cookies() {
+			if cookie.Name == "session_id" {
+				t.Errorf("expected the librarian cookie to be left alone")
+			}
+			if cookie.Name == "student_session" && cookie.Value == "student-session" && cookie.HttpOnly {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected an HttpOnly student_session cookie")
+		}
+	})
+
+	t.Run("locked card", func(t *testing.T) {
+		mockPortalService := &services.MockPortalService{
+			LoginFunc: func(ctx context.Context, req dto.PortalLoginRequest) (*dto.PortalLoginResponse, string, error) {
+				return nil, "", apperrors.TooManyRequests("pin_locked", "too many wrong PINs")
+			},
+		}
+		h := NewHandler(&services.Service{Portal: mockPortalService})
+
+		bodyBytes, _ := json.Marshal(dto.PortalLoginRequest{CardId: "C-100", Pin: "1234"})
+		req := httptest.NewRequest(http.MethodPost, "/portal/login", bytes.NewReader(bodyBytes))
+		w := httptest.NewRecorder()
+
+		h.PortalLogin(w, req)
+
+		if w.Code != http.StatusTooManyRequests {
+			t.Errorf("expected status code %d, got %d", http.StatusTooManyRequests, w.Code)
+		}
+	})
+
+	t.Run("missing card or PIN", func(t *testing.T) {
+		h := NewHandler(&services.Service{Portal: &services.MockPortalService{}})
+
+		bodyBytes, _ := json.Marshal(dto.PortalLoginRequest{})
+		req := httptest.NewRequest(http.MethodPost, "/portal/login", bytes.NewReader(bodyBytes))
+		w := httptest.NewRecorder()
+
+		h.PortalLogin(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status code %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+}
+
+func TestListPortalLoans(t *testing.T) {
+	t.Run("loans of the signed in student", func(t *testing.T) {
+		student := &models.Student{Id: uuid.New()}
+		mockPortalService := &services.MockPortalService{
+			GetLoansFunc: func(ctx context.Context, s *models.Student) (*dto.StudentLoansResponse, error) {
+				if s.Id != student.Id {
+					t.Errorf("expected loans of %s, got %s", student.Id, s.Id)
+				}
+				return &dto.StudentLoansResponse{Results: []*dto.StudentRental{}}, nil
+			},
+		}
+		h := NewHandler(&services.Service{Portal: mockPortalService})
+
+		req := httptest.NewRequest(http.MethodGet, "/portal/loans", nil)
+		req = req.WithContext(context.WithValue(req.Context(), middleware.StudentContextKey, student))
+		w := httptest.NewRecorder()
+
+		h.ListPortalLoans(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+	})
+
+	t.Run("no student session", func(t *testing.T) {
+		h := NewHandler(&services.Service{Portal: &services.MockPortalService{}})
+
+		req := httptest.NewRequest(http.MethodGet, "/portal/loans", nil)
+		req = req.WithContext(context.WithValue(req.Context(), middleware.LibrarianContextKey, &models.Librarian{}))
+		w := httptest.NewRecorder()
+
+		h.ListPortalLoans(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("expected status code %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+}
@@ -1,15 +1,18 @@
 package handlers
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
-	"time"
 
-	"github.com/google/uuid"
+	oapiTypes "github.com/oapi-codegen/runtime/types"
 
 	"BRSBackend/pkg/api"
 	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/middleware"
 	"BRSBackend/pkg/validation"
 )
 
@@ -17,18 +20,18 @@ func (h *Handler) CreateRentTransaction(w http.ResponseWriter, r *http.Request)
 	var req dto.CreateRentRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	if validationErrors := validation.ValidateStruct(req); validationErrors != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, validation.FormatErrors(validationErrors))
+		h.writeValidationErrors(w, r, validationErrors)
 		return
 	}
 
 	response, err := h.rentService.CreateRentTransaction(r.Context(), req)
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, "Failed to create rent transaction")
+		h.writeError(w, r, err)
 		return
 	}
 
@@ -38,11 +41,9 @@ func (h *Handler) CreateRentTransaction(w http.ResponseWriter, r *http.Request)
 func (h *Handler) ListRents(w http.ResponseWriter, r *http.Request, params api.ListRentsParams) {
 	defaultBook := ""
 	defaultStudent := ""
-	defaultDate := time.Now()
 	filter := dto.RentFilters{
 		BookName:    &defaultBook,
 		StudentName: &defaultStudent,
-		Date:        &defaultDate,
 		Limit:       10,
 		Offset:      0,
 	}
@@ -66,9 +67,17 @@ func (h *Handler) ListRents(w http.ResponseWriter, r *http.Request, params api.L
 		filter.Offset = int(*params.Offset)
 	}
 
+	if params.Sort != nil {
+		filter.Sort = string(*params.Sort)
+	}
+
+	if params.Cursor != nil {
+		filter.Cursor = *params.Cursor
+	}
+
 	rents, err := h.rentService.GetRents(r.Context(), filter)
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, "Failed to get rents")
+		h.writeError(w, r, err)
 		return
 	}
 
@@ -78,13 +87,13 @@ func (h *Handler) ListRents(w http.ResponseWriter, r *http.Request, params api.L
 func (h *Handler) GetRentedBooksByStudent(w http.ResponseWriter, r *http.Request, params api.GetRentedBooksByStudentParams) {
 
 	if params.StudentCardId == nil || strings.TrimSpace(*params.StudentCardId) == "" {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Student card ID is required")
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Student card ID is required")
 		return
 	}
 
 	response, err := h.rentService.GetRentedBooksByStudent(r.Context(), params.StudentCardId)
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, "Failed to get rented books")
+		h.writeError(w, r, err)
 		return
 	}
 
@@ -93,25 +102,106 @@ func (h *Handler) GetRentedBooksByStudent(w http.ResponseWriter, r *http.Request
 
 func (h *Handler) ReturnBooks(w http.ResponseWriter, r *http.Request) {
 
-	var req struct {
-		CartID uuid.UUID `json:"cart_id" validate:"required"`
-	}
+	var req dto.ReturnBooksRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	if validationErrors := validation.ValidateStruct(req); validationErrors != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, validation.FormatErrors(validationErrors))
+		h.writeValidationErrors(w, r, validationErrors)
 		return
 	}
 
-	response, err := h.rentService.ReturnBooks(r.Context(), req.CartID)
+	if librarian := middleware.Librarian(r.Context()); librarian != nil {
+		req.LibrarianID = &librarian.Id
+	}
+
+	response, err := h.rentService.ReturnBooks(r.Context(), req)
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, "Failed to return books")
+		h.writeError(w, r, err)
 		return
 	}
 
 	h.writeResponse(w, http.StatusOK, response)
 }
+
+func (h *Handler) ListReturnHistory(w http.ResponseWriter, r *http.Request, params api.ListReturnHistoryParams) {
+	filters := dto.ReturnFilters{
+		StudentID:   params.StudentId,
+		BookID:      params.BookId,
+		LibrarianID: params.LibrarianId,
+		Limit:       10,
+		Offset:      0,
+	}
+
+	if params.From != nil {
+		filters.From = &params.From.Time
+	}
+	if params.To != nil {
+		filters.To = &params.To.Time
+	}
+	if params.Condition != nil {
+		filters.Condition = string(*params.Condition)
+	}
+
+	if params.Limit != nil && int(*params.Limit) > 0 {
+		filters.Limit = int(*params.Limit)
+	}
+
+	if params.Offset != nil && int(*params.Offset) > 0 {
+		filters.Offset = int(*params.Offset)
+	}
+
+	if params.Sort != nil {
+		filters.Sort = string(*params.Sort)
+	}
+
+	if params.Cursor != nil {
+		filters.Cursor = *params.Cursor
+	}
+
+	history, err := h.rentService.GetReturnHistory(r.Context(), filters)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, history)
+}
+
+func (h *Handler) GetRentReceipt(w http.ResponseWriter, r *http.Request, cartId oapiTypes.UUID, params api.GetRentReceiptParams) {
+	receipt, err := h.receiptService.GetReceipt(r.Context(), cartId.String())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	format := api.Pdf
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	// The receipt is rendered in full before anything is sent, so that a
+	// failure is still answered with a problem document.
+	var buf bytes.Buffer
+	contentType := "application/pdf"
+	if format == api.Txt {
+		contentType = "text/plain; charset=utf-8"
+		err = h.receiptTemplate.WriteText(&buf, receipt)
+	} else {
+		err = h.receiptTemplate.WritePDF(&buf, receipt)
+	}
+	if err != nil {
+		h.writeError(w, r, fmt.Errorf("failed to render receipt: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", fmt.Sprintf("receipt-%s.%s", cartId, format)))
+	w.WriteHeader(http.StatusOK)
+	if _, err := buf.WriteTo(w); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write receipt", "error", err)
+	}
+}
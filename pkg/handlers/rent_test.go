@@ -6,11 +6,19 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	oapiTypes "github.com/oapi-codegen/runtime/types"
 
+	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/apperrors"
 	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/middleware"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/printing"
 	"BRSBackend/pkg/services"
 )
 
@@ -51,6 +59,32 @@ func TestCreateRentTransaction(t *testing.T) {
 		}
 	})
 
+	t.Run("student card and book codes", func(t *testing.T) {
+		var got dto.CreateRentRequest
+		mockRentService := &services.MockRentService{
+			CreateRentTransactionFunc: func(ctx context.Context, req dto.CreateRentRequest) (*dto.CreateRentResponse, error) {
+				got = req
+				return &dto.CreateRentResponse{Message: "Books rented successfully"}, nil
+			},
+		}
+
+		h := NewHandler(&services.Service{Rent: mockRentService})
+
+		bodyBytes := []byte(`{"card_id":"S-1024","book_codes":["978-0-441-17271-9","0004417"]}`)
+
+		req := httptest.NewRequest(http.MethodPost, "/rents", bytes.NewReader(bodyBytes))
+		w := httptest.NewRecorder()
+
+		h.CreateRentTransaction(w, req)
+
+		if w.Code != http.StatusCreated {
+			t.Fatalf("expected status code %d, got %d", http.StatusCreated, w.Code)
+		}
+		if got.CardID != "S-1024" || len(got.BookCodes) != 2 {
+			t.Errorf("unexpected request %+v", got)
+		}
+	})
+
 	t.Run("missing student id", func(t *testing.T) {
 		mockRentService := &services.MockRentService{}
 		h := NewHandler(&services.Service{Rent: mockRentService})
@@ -68,3 +102,152 @@ func TestCreateRentTransaction(t *testing.T) {
 		}
 	})
 }
+
+func TestReturnBooks(t *testing.T) {
+	t.Run("records items and librarian", func(t *testing.T) {
+		var got dto.ReturnBooksRequest
+		mockRentService := &services.MockRentService{
+			ReturnBooksFunc: func(ctx context.Context, req dto.ReturnBooksRequest) (*dto.ReturnBooksResponse, error) {
+				got = req
+				return &dto.ReturnBooksResponse{Message: "Cart marked as returned", CartID: req.CartID}, nil
+			},
+		}
+
+		h := NewHandler(&services.Service{Rent: mockRentService})
+
+		cartID, bookID := uuid.New(), uuid.New()
+		body := dto.ReturnBooksRequest{
+			CartID: cartID,
+			Items:  []dto.ReturnItem{{BookID: bookID, Condition: models.ConditionDamaged, Notes: "torn cover"}},
+		}
+		bodyBytes, _ := json.Marshal(body)
+
+		librarian := &models.Librarian{Id: uuid.New()}
+		req := httptest.NewRequest(http.MethodPut, "/returns", bytes.NewReader(bodyBytes))
+		req = req.WithContext(context.WithValue(req.Context(), middleware.LibrarianContextKey, librarian))
+		w := httptest.NewRecorder()
+
+		h.ReturnBooks(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		if got.CartID != cartID || len(got.Items) != 1 || got.Items[0].Condition != models.ConditionDamaged {
+			t.Errorf("unexpected request %+v", got)
+		}
+		if got.LibrarianID == nil || *got.LibrarianID != librarian.Id {
+			t.Errorf("expected librarian %s, got %v", librarian.Id, got.LibrarianID)
+		}
+	})
+
+	t.Run("invalid condition", func(t *testing.T) {
+		h := NewHandler(&services.Service{Rent: &services.MockRentService{}})
+
+		body := dto.ReturnBooksRequest{CartID: uuid.New(), Items: []dto.ReturnItem{{BookID: uuid.New(), Condition: "soggy"}}}
+		bodyBytes, _ := json.Marshal(body)
+
+		req := httptest.NewRequest(http.MethodPut, "/returns", bytes.NewReader(bodyBytes))
+		w := httptest.NewRecorder()
+
+		h.ReturnBooks(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status code %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+}
+
+func TestListReturnHistory(t *testing.T) {
+	var got dto.ReturnFilters
+	mockRentService := &services.MockRentService{
+		GetReturnHistoryFunc: func(ctx context.Context, filters dto.ReturnFilters) (*dto.ReturnHistoryResponse, error) {
+			got = filters
+			return &dto.ReturnHistoryResponse{Results: []*dto.ReturnRecord{}}, nil
+		},
+	}
+
+	h := NewHandler(&services.Service{Rent: mockRentService})
+
+	librarianID := uuid.New()
+	from := oapiTypes.Date{Time: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)}
+	condition := api.Lost
+	req := httptest.NewRequest(http.MethodGet, "/returns/history", nil)
+	w := httptest.NewRecorder()
+
+	h.ListReturnHistory(w, req, api.ListReturnHistoryParams{From: &from, LibrarianId: &librarianID, Condition: &condition})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if got.From == nil || !got.From.Equal(from.Time) || got.To != nil {
+		t.Errorf("unexpected date range %v..%v", got.From, got.To)
+	}
+	if got.LibrarianID == nil || *got.LibrarianID != librarianID || got.Condition != models.ConditionLost || got.Limit != 10 {
+		t.Errorf("unexpected filters %+v", got)
+	}
+}
+
+func TestGetRentReceipt(t *testing.T) {
+	cartID := uuid.New()
+	mockReceiptService := &services.MockReceiptService{
+		GetReceiptFunc: func(ctx context.Context, id string) (*dto.Receipt, error) {
+			if id != cartID.String() {
+				return nil, apperrors.NotFound("cart_not_found", "cart not found")
+			}
+			return &dto.Receipt{
+				Kind:   dto.ReceiptCheckout,
+				CartID: cartID,
+				Items:  []dto.ReceiptItem{{Title: "Dune", DueDate: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)}},
+			}, nil
+		},
+	}
+	h := NewHandler(&services.Service{Receipt: mockReceiptService},
+		WithReceiptTemplate(printing.ReceiptTemplate{LibraryName: "Springfield Library", TextWidth: 32}))
+
+	t.Run("text receipt", func(t *testing.T) {
+		format := api.Txt
+		req := httptest.NewRequest(http.MethodGet, "/rents/"+cartID.String()+"/receipt?format=txt", nil)
+		w := httptest.NewRecorder()
+
+		h.GetRentReceipt(w, req, cartID, api.GetRentReceiptParams{Format: &format})
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		if got := w.Header().Get("Content-Type"); got != "text/plain; charset=utf-8" {
+			t.Errorf("expected a text receipt, got %q", got)
+		}
+		if body := w.Body.String(); !strings.Contains(body, "Springfield Library") || !strings.Contains(body, "Due: 2025-03-15") {
+			t.Errorf("unexpected receipt:\n%s", body)
+		}
+	})
+
+	t.Run("PDF by default", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/rents/"+cartID.String()+"/receipt", nil)
+		w := httptest.NewRecorder()
+
+		h.GetRentReceipt(w, req, cartID, api.GetRentReceiptParams{})
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		if got := w.Header().Get("Content-Type"); got != "application/pdf" {
+			t.Errorf("expected a PDF receipt, got %q", got)
+		}
+		if !strings.HasPrefix(w.Body.String(), "%PDF-") {
+			t.Errorf("expected a PDF document")
+		}
+	})
+
+	t.Run("unknown cart", func(t *testing.T) {
+		id := uuid.New()
+		req := httptest.NewRequest(http.MethodGet, "/rents/"+id.String()+"/receipt", nil)
+		w := httptest.NewRecorder()
+
+		h.GetRentReceipt(w, req, id, api.GetRentReceiptParams{})
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status code %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
@@ -2,23 +2,41 @@ package handlers
 
 import (
 	"net/http"
+	"time"
 
 	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/dto"
 )
 
 func (h *Handler) ListOverdueRentals(w http.ResponseWriter, r *http.Request, params api.ListOverdueRentalsParams) {
 
-	if params.Limit == nil || int(*params.Limit) < 0 {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid limit parameter")
+	paginationParams := dto.PaginationParams{Limit: 10}
+
+	if params.Limit != nil {
+		if int(*params.Limit) < 0 {
+			h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid limit parameter")
+			return
+		}
+		paginationParams.Limit = int(*params.Limit)
 	}
 
-	if params.Offset == nil || int(*params.Offset) < 0 {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid offset parameter")
+	if params.Offset != nil {
+		if int(*params.Offset) < 0 {
+			h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid offset parameter")
+			return
+		}
+		paginationParams.Offset = int(*params.Offset)
+	}
+	if params.Sort != nil {
+		paginationParams.Sort = string(*params.Sort)
+	}
+	if params.Cursor != nil {
+		paginationParams.Cursor = *params.Cursor
 	}
 
-	overdueRentals, err := h.reportService.GetOverdueRentals(r.Context(), params.StudentCardId, int(*params.Limit), int(*params.Offset))
+	overdueRentals, err := h.reportService.GetOverdueRentals(r.Context(), params.StudentCardId, paginationParams)
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, err.Error())
+		h.writeError(w, r, err)
 		return
 	}
 
@@ -38,7 +56,81 @@ func (h *Handler) GetRentalReports(w http.ResponseWriter, r *http.Request, param
 
 	report, err := h.reportService.GetRentalReport(r.Context(), limit, offset)
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, err.Error())
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, report)
+}
+
+func (h *Handler) GetCirculationAnalytics(w http.ResponseWriter, r *http.Request, params api.GetCirculationAnalyticsParams) {
+	analyticsParams := dto.AnalyticsParams{}
+
+	if params.From != nil {
+		analyticsParams.From = &params.From.Time
+	}
+	if params.To != nil {
+		analyticsParams.To = &params.To.Time
+	}
+	if params.Interval != nil {
+		analyticsParams.Interval = string(*params.Interval)
+	}
+	if params.GroupBy != nil {
+		analyticsParams.GroupBy = string(*params.GroupBy)
+	}
+	if params.Limit != nil {
+		analyticsParams.Limit = int(*params.Limit)
+	}
+
+	analytics, err := h.reportService.GetCirculationAnalytics(r.Context(), analyticsParams)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, analytics)
+}
+
+func (h *Handler) GetCollectionReport(w http.ResponseWriter, r *http.Request, params api.GetCollectionReportParams) {
+	filters := dto.CollectionFilters{
+		ZeroCirculation:     params.ZeroCirculation,
+		DemandExceedsSupply: params.DemandExceedsSupply,
+		Limit:               10,
+	}
+	if params.Limit != nil && int(*params.Limit) > 0 {
+		filters.Limit = int(*params.Limit)
+	}
+	if params.Offset != nil && int(*params.Offset) > 0 {
+		filters.Offset = int(*params.Offset)
+	}
+	if params.Sort != nil {
+		filters.Sort = string(*params.Sort)
+	}
+	if params.Cursor != nil {
+		filters.Cursor = *params.Cursor
+	}
+
+	var from, to *time.Time
+	if params.From != nil {
+		from = &params.From.Time
+	}
+	if params.To != nil {
+		to = &params.To.Time
+	}
+
+	if params.Format != nil && *params.Format == api.GetCollectionReportParamsFormatCsv {
+		table, err := h.reportService.ExportCollectionReport(r.Context(), filters, from, to)
+		if err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+		h.writeCSV(w, r, table)
+		return
+	}
+
+	report, err := h.reportService.GetCollectionReport(r.Context(), filters, from, to)
+	if err != nil {
+		h.writeError(w, r, err)
 		return
 	}
 
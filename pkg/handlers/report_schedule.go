@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	oapiTypes "github.com/oapi-codegen/runtime/types"
+
+	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/validation"
+)
+
+func (h *Handler) ListReportSchedules(w http.ResponseWriter, r *http.Request) {
+	schedules, err := h.scheduleService.ListSchedules(r.Context())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if schedules == nil {
+		schedules = []*models.ReportSchedule{}
+	}
+
+	h.writeResponse(w, http.StatusOK, map[string]any{"results": schedules})
+}
+
+func (h *Handler) CreateReportSchedule(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.decodeReportSchedule(w, r)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.scheduleService.CreateSchedule(r.Context(), req)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/reports/schedules/%s", schedule.Id))
+	h.writeResponse(w, http.StatusCreated, schedule)
+}
+
+func (h *Handler) GetReportSchedule(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
+	schedule, err := h.scheduleService.GetSchedule(r.Context(), id.String())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, schedule)
+}
+
+func (h *Handler) UpdateReportSchedule(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
+	req, ok := h.decodeReportSchedule(w, r)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.scheduleService.UpdateSchedule(r.Context(), id.String(), req)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, schedule)
+}
+
+func (h *Handler) DeleteReportSchedule(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
+	if err := h.scheduleService.DeleteSchedule(r.Context(), id.String()); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) decodeReportSchedule(w http.ResponseWriter, r *http.Request) (dto.ReportScheduleRequest, bool) {
+	var req dto.ReportScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid request body")
+		return req, false
+	}
+
+	if validationErrors := validation.ValidateStruct(req); validationErrors != nil {
+		h.writeValidationErrors(w, r, validationErrors)
+		return req, false
+	}
+
+	return req, true
+}
+
+func (h *Handler) RunReportSchedule(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
+	run, err := h.scheduleService.RunSchedule(r.Context(), id.String())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusCreated, run)
+}
+
+func (h *Handler) ListReportRuns(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID, params api.ListReportRunsParams) {
+	paginationParams := dto.PaginationParams{Limit: 10}
+	if params.Limit != nil && int(*params.Limit) > 0 {
+		paginationParams.Limit = int(*params.Limit)
+	}
+	if params.Offset != nil && int(*params.Offset) > 0 {
+		paginationParams.Offset = int(*params.Offset)
+	}
+	if params.Cursor != nil {
+		paginationParams.Cursor = *params.Cursor
+	}
+
+	runs, err := h.scheduleService.GetRuns(r.Context(), id.String(), paginationParams)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, runs)
+}
+
+func (h *Handler) DownloadReportFile(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID, format api.DownloadReportFileParamsFormat) {
+	file, err := h.scheduleService.GetRunFile(r.Context(), id.String(), string(format))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Name))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(file.Content); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write report file", "error", err)
+	}
+}
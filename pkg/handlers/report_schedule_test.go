@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/services"
+)
+
+func TestCreateReportSchedule(t *testing.T) {
+	t.Run("successful create", func(t *testing.T) {
+		id := uuid.New()
+		mockScheduleService := &services.MockReportScheduleService{
+			CreateScheduleFunc: func(ctx context.Context, req dto.ReportScheduleRequest) (*models.ReportSchedule, error) {
+				if req.Report != models.ReportOverdue || len(req.Recipients) != 1 {
+					t.Errorf("unexpected request %+v", req)
+				}
+				return &models.ReportSchedule{Id: id, Name: req.Name, Report: req.Report}, nil
+			},
+		}
+
+		h := NewHandler(&services.Service{Schedule: mockScheduleService})
+
+		body := `{"name":"Weekly overdue","report":"overdue","frequency":"weekly","weekday":1,"hour":7,
+			"formats":["csv","html"],"delivery":"email","recipients":["head@example.com"]}`
+		req := httptest.NewRequest(http.MethodPost, "/reports/schedules", strings.NewReader(body))
+		w := httptest.NewRecorder()
+
+		h.CreateReportSchedule(w, req)
+
+		if w.Code != http.StatusCreated {
+			t.Errorf("expected status code %d, got %d", http.StatusCreated, w.Code)
+		}
+		if want := "/reports/schedules/" + id.String(); w.Header().Get("Location") != want {
+			t.Errorf("expected location %s, got %s", want, w.Header().Get("Location"))
+		}
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		h := NewHandler(&services.Service{Schedule: &services.MockReportScheduleService{}})
+
+		body := `{"name":"Overdue","report":"overdue","frequency":"daily","hour":7,"formats":["pdf"],"delivery":"directory"}`
+		req := httptest.NewRequest(http.MethodPost, "/reports/schedules", strings.NewReader(body))
+		w := httptest.NewRecorder()
+
+		h.CreateReportSchedule(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status code %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+
+	t.Run("delivery not configured", func(t *testing.T) {
+		mockScheduleService := &services.MockReportScheduleService{
+			CreateScheduleFunc: func(ctx context.Context, req dto.ReportScheduleRequest) (*models.ReportSchedule, error) {
+				return nil, apperrors.Validation("validation_failed", "report schedule is invalid",
+					apperrors.FieldError{Field: "delivery", Code: "unavailable", Message: "directory delivery is not configured on this server"})
+			},
+		}
+		h := NewHandler(&services.Service{Schedule: mockScheduleService})
+
+		body := `{"name":"Overdue","report":"overdue","frequency":"daily","hour":7,"formats":["csv"],"delivery":"directory"}`
+		req := httptest.NewRequest(http.MethodPost, "/reports/schedules", strings.NewReader(body))
+		w := httptest.NewRecorder()
+
+		h.CreateReportSchedule(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status code %d, got %d", http.StatusBadRequest, w.Code)
+		}
+		var problem map[string]any
+		if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+			t.Fatalf("failed to decode response body: %v", err)
+		}
+		if problem["code"] != "validation_failed" {
+			t.Errorf("expected code validation_failed, got %v", problem["code"])
+		}
+	})
+}
+
+func TestDownloadReportFile(t *testing.T) {
+	t.Run("successful download", func(t *testing.T) {
+		runID := uuid.New()
+		mockScheduleService := &services.MockReportScheduleService{
+			GetRunFileFunc: func(ctx context.Context, id, format string) (*models.ReportFile, error) {
+				if id != runID.String() || format != "csv" {
+					t.Errorf("unexpected arguments %s, %s", id, format)
+				}
+				return &models.ReportFile{Name: "overdue-2026-10-14.csv", ContentType: "text/csv; charset=utf-8", Content: []byte("title\nDune\n")}, nil
+			},
+		}
+		h := NewHandler(&services.Service{Schedule: mockScheduleService})
+
+		req := httptest.NewRequest(http.MethodGet, "/reports/runs/"+runID.String()+"/files/csv", nil)
+		w := httptest.NewRecorder()
+
+		h.DownloadReportFile(w, req, runID, api.Csv)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="overdue-2026-10-14.csv"` {
+			t.Errorf("unexpected content disposition %q", got)
+		}
+		if w.Body.String() != "title\nDune\n" {
+			t.Errorf("unexpected body %q", w.Body.String())
+		}
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		mockScheduleService := &services.MockReportScheduleService{
+			GetRunFileFunc: func(ctx context.Context, id, format string) (*models.ReportFile, error) {
+				return nil, apperrors.NotFound("report_file_not_found", "report file not found")
+			},
+		}
+		h := NewHandler(&services.Service{Schedule: mockScheduleService})
+
+		req := httptest.NewRequest(http.MethodGet, "/reports/runs/x/files/html", nil)
+		w := httptest.NewRecorder()
+
+		h.DownloadReportFile(w, req, uuid.New(), api.Html)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status code %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
@@ -8,8 +8,11 @@ import (
 	"testing"
 	"time"
 
+	oapiTypes "github.com/oapi-codegen/runtime/types"
+
 	"BRSBackend/pkg/api"
 	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/export"
 	"BRSBackend/pkg/services"
 )
 
@@ -103,3 +106,104 @@ func TestGetRentalReports(t *testing.T) {
 		}
 	})
 }
+
+func TestGetCirculationAnalytics(t *testing.T) {
+	var got dto.AnalyticsParams
+	mockReportService := &services.MockReportService{
+		GetCirculationAnalyticsFunc: func(ctx context.Context, params dto.AnalyticsParams) (*dto.AnalyticsResponse, error) {
+			got = params
+			return &dto.AnalyticsResponse{
+				From:     "2026-09-28",
+				To:       "2026-10-04",
+				Interval: dto.IntervalWeek,
+				Totals:   dto.AnalyticsCounts{Checkouts: 4},
+				Buckets:  []dto.AnalyticsBucket{{Start: "2026-09-28", AnalyticsCounts: dto.AnalyticsCounts{Checkouts: 4}}},
+			}, nil
+		},
+	}
+
+	h := NewHandler(&services.Service{Report: mockReportService})
+
+	from := oapiTypes.Date{Time: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)}
+	interval := api.GetCirculationAnalyticsParamsInterval(dto.IntervalWeek)
+	groupBy := api.GetCirculationAnalyticsParamsGroupBy(dto.GroupByBook)
+	limit := int32(5)
+
+	req := httptest.NewRequest(http.MethodGet, "/reports/analytics", nil)
+	w := httptest.NewRecorder()
+
+	h.GetCirculationAnalytics(w, req, api.GetCirculationAnalyticsParams{From: &from, Interval: &interval, GroupBy: &groupBy, Limit: &limit})
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if got.From == nil || !got.From.Equal(from.Time) || got.To != nil || got.Interval != dto.IntervalWeek || got.GroupBy != dto.GroupByBook || got.Limit != 5 {
+		t.Errorf("unexpected analytics params %+v", got)
+	}
+
+	var analytics dto.AnalyticsResponse
+	if err := json.NewDecoder(w.Body).Decode(&analytics); err != nil {
+		t.Errorf("failed to decode response body: %v", err)
+	}
+	if analytics.Totals.Checkouts != 4 || len(analytics.Buckets) != 1 {
+		t.Errorf("unexpected analytics %+v", analytics)
+	}
+}
+
+func TestGetCollectionReport(t *testing.T) {
+	mockReportService := &services.MockReportService{
+		GetCollectionReportFunc: func(ctx context.Context, filters dto.CollectionFilters, from, to *time.Time) (*dto.CollectionReport, error) {
+			return &dto.CollectionReport{
+				Summary: dto.CollectionSummary{Titles: 1},
+				Results: []dto.CollectionItem{{BookId: "b1", Title: "Dune", Rentals: 4}},
+			}, nil
+		},
+		ExportCollectionReportFunc: func(ctx context.Context, filters dto.CollectionFilters, from, to *time.Time) (*export.Table, error) {
+			if filters.ZeroCirculation == nil || !*filters.ZeroCirculation {
+				t.Errorf("expected the zero circulation filter, got %+v", filters)
+			}
+			return &export.Table{
+				Name:    "collection-2026-07-17-2026-10-14",
+				Columns: []string{"book_id", "title"},
+				Rows:    [][]string{{"b1", "Dune, Messiah"}},
+			}, nil
+		},
+	}
+
+	h := NewHandler(&services.Service{Report: mockReportService})
+
+	t.Run("json", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/reports/collection", nil)
+		w := httptest.NewRecorder()
+
+		h.GetCollectionReport(w, req, api.GetCollectionReportParams{})
+
+		var report dto.CollectionReport
+		if err := json.NewDecoder(w.Body).Decode(&report); err != nil {
+			t.Fatalf("failed to decode response body: %v", err)
+		}
+		if w.Code != http.StatusOK || len(report.Results) != 1 || report.Results[0].Rentals != 4 {
+			t.Errorf("unexpected response %d %+v", w.Code, report)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		format := api.GetCollectionReportParamsFormatCsv
+		zero := true
+
+		req := httptest.NewRequest(http.MethodGet, "/reports/collection?format=csv", nil)
+		w := httptest.NewRecorder()
+
+		h.GetCollectionReport(w, req, api.GetCollectionReportParams{Format: &format, ZeroCirculation: &zero})
+
+		if got := w.Header().Get("Content-Type"); got != "text/csv; charset=utf-8" {
+			t.Errorf("expected a CSV content type, got %q", got)
+		}
+		if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="collection-2026-07-17-2026-10-14.csv"` {
+			t.Errorf("unexpected content disposition %q", got)
+		}
+		if want := "book_id,title\nb1,\"Dune, Messiah\"\n"; w.Body.String() != want {
+			t.Errorf("expected body %q, got %q", want, w.Body.String())
+		}
+	})
+}
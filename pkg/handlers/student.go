@@ -25,7 +25,7 @@ func (h *Handler) ListAllStudents(w http.ResponseWriter, r *http.Request, params
 		if params.Limit != nil && int(*params.Limit) > 0 {
 			paginationParams.Limit = int(*params.Limit)
 		} else {
-			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid limit parameter")
+			h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid limit parameter")
 			return
 		}
 	}
@@ -34,7 +34,8 @@ func (h *Handler) ListAllStudents(w http.ResponseWriter, r *http.Request, params
 		if params.Offset != nil && int(*params.Limit) > 0 {
 			paginationParams.Offset = int(*params.Offset)
 		} else {
-			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid offset parameter")
+			h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid offset parameter")
+			return
 		}
 	}
 
@@ -42,18 +43,38 @@ func (h *Handler) ListAllStudents(w http.ResponseWriter, r *http.Request, params
 		if params.CardId != nil && strings.TrimSpace(*params.CardId) != "" {
 			response, err := h.studentService.GetStudentByCardNumber(r.Context(), cardId)
 			if err != nil {
-				h.writeErrorResponse(w, http.StatusNotFound, "Failed to get student")
+				h.writeError(w, r, err)
 				return
 			}
 			h.writeResponse(w, http.StatusOK, response)
 			return
 		} else {
-			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid offset parameter")
+			h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid card_id parameter")
+			return
 		}
 	}
 
-	allStudents, err := h.studentService.GetAllStudents(r.Context(), paginationParams)
+	if params.Sort != nil {
+		paginationParams.Sort = string(*params.Sort)
+	}
+	if params.Cursor != nil {
+		paginationParams.Cursor = *params.Cursor
+	}
+	if params.Query != nil {
+		paginationParams.Query = *params.Query
+	}
+
+	filters := dto.StudentFilters{
+		HasActiveRentals: params.HasActiveRentals,
+		HasOverdue:       params.HasOverdue,
+	}
+	if params.Major != nil {
+		filters.Major = *params.Major
+	}
+
+	allStudents, err := h.studentService.GetAllStudents(r.Context(), paginationParams, filters)
 	if err != nil {
+		h.writeError(w, r, err)
 		return
 	}
 
@@ -62,15 +83,7 @@ func (h *Handler) ListAllStudents(w http.ResponseWriter, r *http.Request, params
 		students[i] = *student
 	}
 
-	apiPagination := &api.PaginationInfo{
-		Offset:      &allStudents.Pagination.Offset,
-		Limit:       &allStudents.Pagination.Limit,
-		Total:       &allStudents.Pagination.Total,
-		HasNext:     &allStudents.Pagination.HasNext,
-		HasPrevious: &allStudents.Pagination.HasPrevious,
-	}
-
-	h.writeResponse(w, http.StatusOK, api.ListAllStudents200JSONResponse{Results: &students, Pagination: apiPagination})
+	h.writeResponse(w, http.StatusOK, api.ListAllStudents200JSONResponse{Results: &students, Pagination: toAPIPagination(allStudents.Pagination)})
 }
 
 func (h *Handler) AddStudent(w http.ResponseWriter, r *http.Request) {
@@ -78,17 +91,17 @@ func (h *Handler) AddStudent(w http.ResponseWriter, r *http.Request) {
 	var student models.Student
 
 	if err := json.NewDecoder(r.Body).Decode(&student); err != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	if validationErrors := validation.ValidateStruct(student); validationErrors != nil {
-		h.writeErrorResponse(w, http.StatusBadRequest, validation.FormatErrors(validationErrors))
+		h.writeValidationErrors(w, r, validationErrors)
 		return
 	}
 
 	if err := h.studentService.CreateStudent(r.Context(), &student); err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, err.Error())
+		h.writeError(w, r, err)
 		return
 	}
 
@@ -98,12 +111,12 @@ func (h *Handler) AddStudent(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) GetStudentById(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
 
 	if id == uuid.Nil || id.String() == "" {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Student ID is required")
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Student ID is required")
 		return
 	}
 	student, err := h.studentService.GetStudentByID(r.Context(), id.String())
 	if err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, err.Error())
+		h.writeError(w, r, err)
 		return
 	}
 
@@ -112,13 +125,74 @@ func (h *Handler) GetStudentById(w http.ResponseWriter, r *http.Request, id oapi
 
 func (h *Handler) DeleteStudentById(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
 	if id == uuid.Nil || id.String() == "" {
-		h.writeErrorResponse(w, http.StatusBadRequest, "Student ID is required")
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Student ID is required")
 		return
 	}
 	if err := h.studentService.DeleteStudent(r.Context(), id.String()); err != nil {
-		h.writeErrorResponse(w, http.StatusUnprocessableEntity, err.Error())
+		h.writeError(w, r, err)
 		return
 	}
 
 	h.writeResponse(w, http.StatusCreated, "ok")
 }
+
+func (h *Handler) GetStudentHistory(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID, params api.GetStudentHistoryParams) {
+	if id == uuid.Nil {
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Student ID is required")
+		return
+	}
+
+	paginationParams := dto.PaginationParams{
+		Limit:  10,
+		Offset: 0,
+	}
+	if params.Limit != nil && int(*params.Limit) > 0 {
+		paginationParams.Limit = int(*params.Limit)
+	}
+	if params.Offset != nil && int(*params.Offset) > 0 {
+		paginationParams.Offset = int(*params.Offset)
+	}
+	if params.Sort != nil {
+		paginationParams.Sort = string(*params.Sort)
+	}
+	if params.Cursor != nil {
+		paginationParams.Cursor = *params.Cursor
+	}
+
+	history, err := h.studentService.GetStudentHistory(r.Context(), id.String(), paginationParams)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, history)
+}
+
+func (h *Handler) GetStudentSummary(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
+	if id == uuid.Nil {
+		h.writeErrorResponse(w, r, http.StatusBadRequest, "Student ID is required")
+		return
+	}
+
+	summary, err := h.studentService.GetStudentSummary(r.Context(), id.String())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, http.StatusOK, summary)
+}
+
+func (h *Handler) SetStudentPin(w http.ResponseWriter, r *http.Request, id oapiTypes.UUID) {
+	var req dto.StudentPinRequest
+	if !h.decodeRequest(w, r, &req) {
+		return
+	}
+
+	if err := h.portalService.SetPin(r.Context(), id.String(), req.Pin); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -11,7 +11,11 @@ import (
 
 	"github.com/google/uuid"
 
+	"BRSBackend/pkg/api"
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/problem"
 	"BRSBackend/pkg/services"
 )
 
@@ -70,6 +74,40 @@ func TestAddStudent(t *testing.T) {
 	})
 }
 
+func TestListAllStudents(t *testing.T) {
+	t.Run("passes search and filters", func(t *testing.T) {
+		var gotParams dto.PaginationParams
+		var gotFilters dto.StudentFilters
+		mockStudentService := &services.MockStudentService{
+			GetAllStudentsFunc: func(ctx context.Context, params dto.PaginationParams, filters dto.StudentFilters) (*dto.StudentsResponse, error) {
+				gotParams, gotFilters = params, filters
+				return &dto.StudentsResponse{
+					Results:    []*models.Student{{Id: uuid.New(), FirstName: "María", LastName: "García"}},
+					Pagination: dto.PaginationInfo{Limit: params.Limit, Total: 1},
+				}, nil
+			},
+		}
+
+		h := NewHandler(&services.Service{Student: mockStudentService})
+
+		query, major, overdue := "garcia", "Physics", true
+		req := httptest.NewRequest(http.MethodGet, "/students?query=garcia&major=Physics&has_overdue=true", nil)
+		w := httptest.NewRecorder()
+
+		h.ListAllStudents(w, req, api.ListAllStudentsParams{Query: &query, Major: &major, HasOverdue: &overdue})
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		if gotParams.Query != query {
+			t.Errorf("expected query %q, got %q", query, gotParams.Query)
+		}
+		if gotFilters.Major != major || gotFilters.HasOverdue == nil || !*gotFilters.HasOverdue || gotFilters.HasActiveRentals != nil {
+			t.Errorf("unexpected filters %+v", gotFilters)
+		}
+	})
+}
+
 func TestDeleteStudent(t *testing.T) {
 	t.Run("successful delete student", func(t *testing.T) {
 		mockStudentService := &services.MockStudentService{
@@ -120,8 +158,153 @@ func TestDeleteStudent(t *testing.T) {
 
 		h.DeleteStudentById(w, req, id)
 
-		if w.Code != http.StatusUnprocessableEntity {
-			t.Errorf("expected status code %d, got %d", http.StatusUnprocessableEntity, w.Code)
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("expected status code %d, got %d", http.StatusInternalServerError, w.Code)
+		}
+	})
+
+	t.Run("student not found", func(t *testing.T) {
+		mockStudentService := &services.MockStudentService{
+			DeleteStudentFunc: func(ctx context.Context, id string) error {
+				return apperrors.NotFound("student_not_found", "student not found")
+			},
+		}
+
+		h := NewHandler(&services.Service{Student: mockStudentService})
+
+		id := uuid.New()
+		req := httptest.NewRequest(http.MethodDelete, "/students/"+id.String(), nil)
+		w := httptest.NewRecorder()
+
+		h.DeleteStudentById(w, req, id)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status code %d, got %d", http.StatusNotFound, w.Code)
+		}
+		if ct := w.Header().Get("Content-Type"); ct != problem.ContentType {
+			t.Errorf("expected content type %q, got %q", problem.ContentType, ct)
+		}
+
+		var body problem.Details
+		if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if body.Code != "student_not_found" {
+			t.Errorf("expected code %q, got %q", "student_not_found", body.Code)
+		}
+	})
+}
+
+func TestGetStudentHistory(t *testing.T) {
+	t.Run("passes pagination", func(t *testing.T) {
+		var gotParams dto.PaginationParams
+		mockStudentService := &services.MockStudentService{
+			GetStudentHistoryFunc: func(ctx context.Context, id string, params dto.PaginationParams) (*dto.StudentHistoryResponse, error) {
+				gotParams = params
+				return &dto.StudentHistoryResponse{
+					Results:    []*dto.StudentRental{{RentID: uuid.New(), BookTitle: "Dune", Status: "RETURNED", OverdueDays: 2}},
+					Pagination: dto.PaginationInfo{Limit: params.Limit, Total: 1},
+				}, nil
+			},
+		}
+
+		h := NewHandler(&services.Service{Student: mockStudentService})
+
+		id := uuid.New()
+		limit := api.LimitParam(5)
+		sort := api.GetStudentHistoryParamsSort("title")
+		req := httptest.NewRequest(http.MethodGet, "/students/"+id.String()+"/history?limit=5&sort=title", nil)
+		w := httptest.NewRecorder()
+
+		h.GetStudentHistory(w, req, id, api.GetStudentHistoryParams{Limit: &limit, Sort: &sort})
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+		if gotParams.Limit != 5 || gotParams.Sort != "title" {
+			t.Errorf("unexpected params %+v", gotParams)
+		}
+
+		var body dto.StudentHistoryResponse
+		if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(body.Results) != 1 || body.Results[0].OverdueDays != 2 {
+			t.Errorf("unexpected results %+v", body.Results)
+		}
+	})
+
+	t.Run("student not found", func(t *testing.T) {
+		mockStudentService := &services.MockStudentService{
+			GetStudentHistoryFunc: func(ctx context.Context, id string, params dto.PaginationParams) (*dto.StudentHistoryResponse, error) {
+				return nil, apperrors.NotFound("student_not_found", "student not found")
+			},
+		}
+
+		h := NewHandler(&services.Service{Student: mockStudentService})
+
+		id := uuid.New()
+		req := httptest.NewRequest(http.MethodGet, "/students/"+id.String()+"/history", nil)
+		w := httptest.NewRecorder()
+
+		h.GetStudentHistory(w, req, id, api.GetStudentHistoryParams{})
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status code %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
+
+func TestGetStudentSummary(t *testing.T) {
+	t.Run("successful summary", func(t *testing.T) {
+		id := uuid.New()
+		mockStudentService := &services.MockStudentService{
+			GetStudentSummaryFunc: func(ctx context.Context, got string) (*dto.StudentSummary, error) {
+				if got != id.String() {
+					t.Errorf("expected id %s, got %s", id, got)
+				}
+				return &dto.StudentSummary{
+					Student: &models.Student{Id: id, FirstName: "Test"},
+					StudentStats: dto.StudentStats{
+						TotalRentals:      4,
+						CurrentlyBorrowed: 2,
+						OverdueCount:      1,
+						MostBorrowed:      []dto.BorrowedTitle{{BookTitle: "Dune", Count: 3}},
+					},
+				}, nil
+			},
+		}
+
+		h := NewHandler(&services.Service{Student: mockStudentService})
+
+		req := httptest.NewRequest(http.MethodGet, "/students/"+id.String()+"/summary", nil)
+		w := httptest.NewRecorder()
+
+		h.GetStudentSummary(w, req, id)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+
+		var body api.StudentSummary
+		if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if *body.TotalRentals != 4 || *body.OverdueCount != 1 || len(*body.MostBorrowed) != 1 || body.Student.Id != id {
+			t.Errorf("unexpected summary %+v", body)
+		}
+	})
+
+	t.Run("missing student id", func(t *testing.T) {
+		h := NewHandler(&services.Service{Student: &services.MockStudentService{}})
+
+		req := httptest.NewRequest(http.MethodGet, "/students//summary", nil)
+		w := httptest.NewRecorder()
+
+		h.GetStudentSummary(w, req, uuid.Nil)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status code %d, got %d", http.StatusBadRequest, w.Code)
 		}
 	})
 }
@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const startTimeKey = "metrics:start_time"
+
+type gormPlugin struct {
+	metrics *Metrics
+}
+
+func (m *Metrics) GormPlugin() gorm.Plugin {
+	return &gormPlugin{metrics: m}
+}
+
+func (p *gormPlugin) Name() string {
+	return "metrics"
+}
+
+func (p *gormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	register := func(operation string, before, after func(string, func(*gorm.DB)) error) error {
+		if err := before("metrics:before_"+operation, p.before); err != nil {
+			return err
+		}
+		return after("metrics:after_"+operation, p.after(operation))
+	}
+
+	return errors.Join(
+		register("create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register),
+		register("query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register),
+		register("update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register),
+		register("delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register),
+		register("row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register),
+		register("raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register),
+	)
+}
+
+func (p *gormPlugin) before(db *gorm.DB) {
+	db.InstanceSet(startTimeKey, time.Now())
+}
+
+func (p *gormPlugin) after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(startTimeKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+
+		p.metrics.dbDuration.WithLabelValues(operation, table).Observe(time.Since(start).Seconds())
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			p.metrics.dbErrors.WithLabelValues(operation, table).Inc()
+		}
+	}
+}
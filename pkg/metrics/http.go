@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+const unmatchedRoute = "unmatched"
+
+func Operations(swagger *openapi3.T) map[string]string {
+	operations := make(map[string]string)
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			if op.OperationID != "" {
+				operations[method+" "+path] = op.OperationID
+			}
+		}
+	}
+	return operations
+}
+
+func (m *Metrics) Middleware(operations map[string]string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			operation := unmatchedRoute
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				pattern := rctx.RoutePattern()
+				if id, ok := operations[r.Method+" "+pattern]; ok {
+					operation = id
+				} else {
+					operation = pattern
+				}
+			}
+
+			m.httpRequests.WithLabelValues(operation, r.Method, strconv.Itoa(status)).Inc()
+			m.httpDuration.WithLabelValues(operation, r.Method).Observe(time.Since(start).Seconds())
+		})
+	}
+}
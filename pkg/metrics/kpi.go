@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"context"
+
+	"BRSBackend/pkg/dto"
+)
+
+type StatsSource interface {
+	GetCirculationStats(ctx context.Context) (*dto.CirculationStats, error)
+}
+
+func (m *Metrics) RefreshKPIs(ctx context.Context, source StatsSource) error {
+	stats, err := source.GetCirculationStats(ctx)
+	if err != nil {
+		m.kpiRefreshError.Set(1)
+		return err
+	}
+
+	m.activeRentals.Set(float64(stats.ActiveRentals))
+	m.overdueCarts.Set(float64(stats.OverdueCarts))
+	m.booksOutOfStock.Set(float64(stats.BooksOutOfStock))
+	m.activeSessions.Set(float64(stats.ActiveSessions))
+	m.kpiRefreshError.Set(0)
+	return nil
+}
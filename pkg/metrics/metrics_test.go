@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"BRSBackend/pkg/dto"
+)
+
+type statsSourceFunc func(ctx context.Context) (*dto.CirculationStats, error)
+
+func (f statsSourceFunc) GetCirculationStats(ctx context.Context) (*dto.CirculationStats, error) {
+	return f(ctx)
+}
+
+func TestMiddleware(t *testing.T) {
+	m := New()
+
+	r := chi.NewRouter()
+	r.Use(m.Middleware(map[string]string{"DELETE /books/{id}": "DeleteBook"}))
+	r.Delete("/books/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	r.Get("/swagger/*", func(w http.ResponseWriter, r *http.Request) {})
+
+	for _, path := range []string{"/books/1", "/books/2"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodDelete, path, nil))
+	}
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/swagger/index.html", nil))
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/missing", nil))
+
+	tests := []struct {
+		labels []string
+		want   float64
+	}{
+		{[]string{"DeleteBook", "DELETE", "204"}, 2},
+		{[]string{"/swagger/*", "GET", "200"}, 1},
+		{[]string{unmatchedRoute, "GET", "404"}, 1},
+	}
+	for _, tt := range tests {
+		if got := testutil.ToFloat64(m.httpRequests.WithLabelValues(tt.labels...)); got != tt.want {
+			t.Errorf("expected %v requests for %v, got %v", tt.want, tt.labels, got)
+		}
+	}
+}
+
+func TestRefreshKPIs(t *testing.T) {
+	m := New()
+
+	err := m.RefreshKPIs(context.Background(), statsSourceFunc(func(ctx context.Context) (*dto.CirculationStats, error) {
+		return &dto.CirculationStats{ActiveRentals: 12, OverdueCarts: 3, BooksOutOfStock: 2, ActiveSessions: 1}, nil
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := testutil.ToFloat64(m.activeRentals); got != 12 {
+		t.Errorf("expected 12 active rentals, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.overdueCarts); got != 3 {
+		t.Errorf("expected 3 overdue carts, got %v", got)
+	}
+
+	err = m.RefreshKPIs(context.Background(), statsSourceFunc(func(ctx context.Context) (*dto.CirculationStats, error) {
+		return nil, errors.New("database is locked")
+	}))
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if got := testutil.ToFloat64(m.kpiRefreshError); got != 1 {
+		t.Errorf("expected refresh failure to be flagged, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.activeRentals); got != 12 {
+		t.Errorf("expected previous value to be kept, got %v", got)
+	}
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+const accessLogContextKey contextKey = "access_log"
+
+type accessLogEntry struct {
+	librarianId uuid.UUID
+	studentId   uuid.UUID
+	kioskId     uuid.UUID
+}
+
+func AccessLog(logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			entry := &accessLogEntry{}
+			ctx := context.WithValue(r.Context(), accessLogContextKey, entry)
+
+			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(ctx))
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			route := r.URL.Path
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				route = rctx.RoutePattern()
+			}
+
+			attrs := []any{
+				slog.String("method", r.Method),
+				slog.String("route", route),
+				slog.Int("status", status),
+				slog.Duration("latency", time.Since(start)),
+				slog.Int("bytes", ww.BytesWritten()),
+			}
+			if entry.librarianId != uuid.Nil {
+				attrs = append(attrs, slog.String("librarian_id", entry.librarianId.String()))
+			}
+			if entry.studentId != uuid.Nil {
+				attrs = append(attrs, slog.String("student_id", entry.studentId.String()))
+			}
+			if entry.kioskId != uuid.Nil {
+				attrs = append(attrs, slog.String("kiosk_id", entry.kioskId.String()))
+			}
+
+			level := slog.LevelInfo
+			if status >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+			logger.Log(ctx, level, "request", attrs...)
+		})
+	}
+}
+
+func setAccessLogLibrarian(ctx context.Context, librarianId uuid.UUID) {
+	if entry, ok := ctx.Value(accessLogContextKey).(*accessLogEntry); ok {
+		entry.librarianId = librarianId
+	}
+}
+
+func setAccessLogStudent(ctx context.Context, studentId uuid.UUID) {
+	if entry, ok := ctx.Value(accessLogContextKey).(*accessLogEntry); ok {
+		entry.studentId = studentId
+	}
+}
+
+func setAccessLogKiosk(ctx context.Context, kioskId uuid.UUID) {
+	if entry, ok := ctx.Value(accessLogContextKey).(*accessLogEntry); ok {
+		entry.kioskId = kioskId
+	}
+}
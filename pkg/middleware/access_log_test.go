@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/logging"
+)
+
+func TestRequestID(t *testing.T) {
+	var seen string
+	handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = logging.RequestID(r.Context())
+	}))
+
+	t.Run("generates an id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/books", nil))
+
+		if seen == "" || w.Header().Get(RequestIDHeader) != seen {
+			t.Errorf("expected generated id in context and header, got %q and %q", seen, w.Header().Get(RequestIDHeader))
+		}
+	})
+
+	t.Run("keeps a valid incoming id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/books", nil)
+		req.Header.Set(RequestIDHeader, "abc-123")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		if seen != "abc-123" {
+			t.Errorf("expected incoming id to be kept, got %q", seen)
+		}
+	})
+
+	t.Run("replaces an invalid incoming id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/books", nil)
+		req.Header.Set(RequestIDHeader, "bad id\n")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		if seen == "bad id\n" {
+			t.Errorf("expected invalid id to be replaced")
+		}
+	})
+}
+
+func TestAccessLog(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := logging.New(&buf, "info", "json")
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+
+	librarianId := uuid.New()
+	r := chi.NewRouter()
+	r.Use(RequestID)
+	r.Use(AccessLog(logger))
+	r.Get("/books/{id}", func(w http.ResponseWriter, r *http.Request) {
+		setAccessLogLibrarian(r.Context(), librarianId)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/books/42", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("failed to decode log entry %q: %v", buf.String(), err)
+	}
+
+	expected := map[string]any{
+		"msg":          "request",
+		"method":       "GET",
+		"route":        "/books/{id}",
+		"status":       float64(http.StatusNotFound),
+		"librarian_id": librarianId.String(),
+		"request_id":   "req-1",
+		"level":        slog.LevelInfo.String(),
+	}
+	for key, want := range expected {
+		if entry[key] != want {
+			t.Errorf("expected %s to be %v, got %v", key, want, entry[key])
+		}
+	}
+	if _, ok := entry["latency"]; !ok {
+		t.Errorf("expected latency in log entry")
+	}
+}
@@ -3,9 +3,11 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
 
+	"BRSBackend/pkg/models"
 	"BRSBackend/pkg/services"
 )
 
@@ -13,22 +15,137 @@ type contextKey string
 
 const LibrarianContextKey contextKey = "librarian"
 
-func NewOApiAuthenticationFunc(authService services.AuthService) openapi3filter.AuthenticationFunc {
+const StudentContextKey contextKey = "student"
+
+const KioskContextKey contextKey = "kiosk"
+
+const authHolderContextKey contextKey = "auth_holder"
+
+// Names of the security schemes in the OpenAPI spec.
+const (
+	LibrarianSecurityScheme = "cookieAuth"
+	StudentSecurityScheme   = "studentAuth"
+	KioskSecurityScheme     = "kioskAuth"
+)
+
+// KioskKeyHeader carries the API key of a kiosk.
+const KioskKeyHeader = "X-Kiosk-Key"
+
+type authHolder struct {
+	librarian *models.Librarian
+	student   *models.Student
+	kiosk     *models.KioskDevice
+}
+
+// Authenticated lets handlers read the librarian, student or kiosk
+// authenticated by the request validator through Librarian, Student and
+// Kiosk. The validator authenticates a copy of the request, so they are
+// passed back through a holder in the context.
+func Authenticated(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), authHolderContextKey, &authHolder{})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Librarian returns the librarian authenticated for the request, or nil.
+func Librarian(ctx context.Context) *models.Librarian {
+	if librarian, ok := ctx.Value(LibrarianContextKey).(*models.Librarian); ok {
+		return librarian
+	}
+	if holder, ok := ctx.Value(authHolderContextKey).(*authHolder); ok {
+		return holder.librarian
+	}
+	return nil
+}
+
+// Student returns the student authenticated for a portal request, or nil.
+func Student(ctx context.Context) *models.Student {
+	if student, ok := ctx.Value(StudentContextKey).(*models.Student); ok {
+		return student
+	}
+	if holder, ok := ctx.Value(authHolderContextKey).(*authHolder); ok {
+		return holder.student
+	}
+	return nil
+}
+
+// Kiosk returns the kiosk authenticated for a kiosk request, or nil.
+func Kiosk(ctx context.Context) *models.KioskDevice {
+	if kiosk, ok := ctx.Value(KioskContextKey).(*models.KioskDevice); ok {
+		return kiosk
+	}
+	if holder, ok := ctx.Value(authHolderContextKey).(*authHolder); ok {
+		return holder.kiosk
+	}
+	return nil
+}
+
+type AuthOptions struct {
+	SessionCookie string
+	StudentCookie string
+}
+
+// NewOApiAuthenticationFunc authenticates librarians by their session cookie,
+// students by their portal session cookie and kiosks by their API key, each
+// only for the operations of its own security scheme.
+func NewOApiAuthenticationFunc(authService services.AuthService, portalService services.PortalService, kioskService services.KioskService, opts AuthOptions) openapi3filter.AuthenticationFunc {
 	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
 		req := input.RequestValidationInput.Request
+		holder, _ := ctx.Value(authHolderContextKey).(*authHolder)
 
-		cookie, err := req.Cookie("session_id")
-		if err != nil {
-			return fmt.Errorf("auth failed: %w", err)
-		}
+		switch input.SecuritySchemeName {
+		case KioskSecurityScheme:
+			kiosk, err := kioskService.Authenticate(ctx, req.Header.Get(KioskKeyHeader))
+			if err != nil {
+				return fmt.Errorf("kiosk authentication failed: %w", err)
+			}
 
-		librarian, err := authService.ValidateSession(ctx, cookie.Value)
-		if err != nil {
-			return fmt.Errorf("session validation failed: %w", err)
-		}
+			setAccessLogKiosk(ctx, kiosk.Id)
+			if holder != nil {
+				holder.kiosk = kiosk
+			}
+			input.RequestValidationInput.Request = req.WithContext(context.WithValue(req.Context(), KioskContextKey, kiosk))
+			return nil
+
+		case StudentSecurityScheme:
+			cookie, err := req.Cookie(opts.StudentCookie)
+			if err != nil {
+				return fmt.Errorf("auth failed: %w", err)
+			}
+
+			student, err := portalService.ValidateSession(ctx, cookie.Value)
+			if err != nil {
+				return fmt.Errorf("session validation failed: %w", err)
+			}
+
+			setAccessLogStudent(ctx, student.Id)
+			if holder != nil {
+				holder.student = student
+			}
+			input.RequestValidationInput.Request = req.WithContext(context.WithValue(req.Context(), StudentContextKey, student))
+			return nil
 
-		newCtx := context.WithValue(req.Context(), LibrarianContextKey, librarian)
-		input.RequestValidationInput.Request = req.WithContext(newCtx)
-		return nil
+		case LibrarianSecurityScheme:
+			cookie, err := req.Cookie(opts.SessionCookie)
+			if err != nil {
+				return fmt.Errorf("auth failed: %w", err)
+			}
+
+			librarian, err := authService.ValidateSession(ctx, cookie.Value)
+			if err != nil {
+				return fmt.Errorf("session validation failed: %w", err)
+			}
+
+			setAccessLogLibrarian(ctx, librarian.Id)
+			if holder != nil {
+				holder.librarian = librarian
+			}
+			input.RequestValidationInput.Request = req.WithContext(context.WithValue(req.Context(), LibrarianContextKey, librarian))
+			return nil
+
+		default:
+			return fmt.Errorf("unknown security scheme %q", input.SecuritySchemeName)
+		}
 	}
 }
@@ -2,16 +2,24 @@ package middleware
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/cors"
 )
 
-func Cors() func(http.Handler) http.Handler {
+func Cors(allowedOrigins []string, csrfHeader string) func(http.Handler) http.Handler {
+	allowedHeaders := []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", KioskKeyHeader, RequestIDHeader, "traceparent", "tracestate"}
+	exposedHeaders := []string{"Link", "X-CSRF-Token", RequestIDHeader}
+	if csrfHeader != "" && !strings.EqualFold(csrfHeader, "X-CSRF-Token") {
+		allowedHeaders = append(allowedHeaders, csrfHeader)
+		exposedHeaders = append(exposedHeaders, csrfHeader)
+	}
+
 	return cors.New(cors.Options{
-		AllowedOrigins:   []string{"http://localhost:5173", "https://*.onrender.com"},
+		AllowedOrigins:   allowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   allowedHeaders,
+		ExposedHeaders:   exposedHeaders,
 		AllowCredentials: true,
 		MaxAge:           300,
 	}).Handler
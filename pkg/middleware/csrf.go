@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"slices"
+
+	"BRSBackend/pkg/problem"
+)
+
+const CSRFTokenContextKey contextKey = "csrf_token"
+
+// CSRFOptions configure the CSRF check. Unsafe requests carrying any of the
+// SessionCookies must send the token.
+type CSRFOptions struct {
+	SessionCookies []string
+	CookieName     string
+	HeaderName     string
+	Domain         string
+	Secure         bool
+	SameSite       http.SameSite
+	ExemptPaths    []string
+}
+
+func CSRF(opts CSRFOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := ""
+			if cookie, err := r.Cookie(opts.CookieName); err == nil && cookie.Value != "" {
+				token = cookie.Value
+			} else {
+				token, err = generateCSRFToken()
+				if err != nil {
+					problem.Write(w, r, problem.FromStatus(http.StatusInternalServerError, "failed to generate CSRF token"))
+					return
+				}
+				http.SetCookie(w, &http.Cookie{
+					Name:     opts.CookieName,
+					Value:    token,
+					Path:     "/",
+					Domain:   opts.Domain,
+					HttpOnly: false,
+					Secure:   opts.Secure,
+					SameSite: opts.SameSite,
+				})
+			}
+			w.Header().Set(opts.HeaderName, token)
+
+			if requiresCSRFCheck(r, opts) {
+				header := r.Header.Get(opts.HeaderName)
+				if header == "" || subtle.ConstantTimeCompare([]byte(header), []byte(token)) != 1 {
+					problem.Write(w, r, problem.New(http.StatusForbidden, "csrf_token_invalid", "invalid or missing CSRF token"))
+					return
+				}
+			}
+
+			ctx := context.WithValue(r.Context(), CSRFTokenContextKey, token)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func CSRFToken(ctx context.Context) string {
+	token, _ := ctx.Value(CSRFTokenContextKey).(string)
+	return token
+}
+
+func requiresCSRFCheck(r *http.Request, opts CSRFOptions) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return false
+	}
+
+	if slices.Contains(opts.ExemptPaths, r.URL.Path) {
+		return false
+	}
+
+	for _, name := range opts.SessionCookies {
+		if _, err := r.Cookie(name); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func generateCSRFToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newCSRFTestHandler() http.Handler {
+	return CSRF(CSRFOptions{
+		SessionCookies: []string{"session_id", "student_session"},
+		CookieName:     "csrf_token",
+		HeaderName:     "X-CSRF-Token",
+		SameSite:       http.SameSiteLaxMode,
+		ExemptPaths:    []string{"/login"},
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestCSRF(t *testing.T) {
+	t.Run("safe request issues token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/books", nil)
+		w := httptest.NewRecorder()
+
+		newCSRFTestHandler().ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+
+		token := w.Header().Get("X-CSRF-Token")
+		if token == "" {
+			t.Fatalf("expected X-CSRF-Token header to be set")
+		}
+
+		found := false
+		for _, cookie := range w.Result().Cookies() {
+			if cookie.Name == "csrf_token" && cookie.Value == token {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected csrf_token cookie matching the header")
+		}
+	})
+
+	t.Run("cookie authenticated post without token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/books", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
+		req.AddCookie(&http.Cookie{Name: "csrf_token", Value: "token"})
+		w := httptest.NewRecorder()
+
+		newCSRFTestHandler().ServeHTTP(w, req)
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("expected status code %d, got %d", http.StatusForbidden, w.Code)
+		}
+	})
+
+	t.Run("student cookie put without token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/portal/me/contact", nil)
+		req.AddCookie(&http.Cookie{Name: "student_session", Value: "session"})
+		req.AddCookie(&http.Cookie{Name: "csrf_token", Value: "token"})
+		w := httptest.NewRecorder()
+
+		newCSRFTestHandler().ServeHTTP(w, req)
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("expected status code %d, got %d", http.StatusForbidden, w.Code)
+		}
+	})
+
+	t.Run("cookie authenticated delete with wrong token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/books/1", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
+		req.AddCookie(&http.Cookie{Name: "csrf_token", Value: "token"})
+		req.Header.Set("X-CSRF-Token", "other")
+		w := httptest.NewRecorder()
+
+		newCSRFTestHandler().ServeHTTP(w, req)
+
+		if w.Code != http.StatusForbidden {
+			t.Errorf("expected status code %d, got %d", http.StatusForbidden, w.Code)
+		}
+	})
+
+	t.Run("cookie authenticated put with matching token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/returns", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
+		req.AddCookie(&http.Cookie{Name: "csrf_token", Value: "token"})
+		req.Header.Set("X-CSRF-Token", "token")
+		w := httptest.NewRecorder()
+
+		newCSRFTestHandler().ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+	})
+
+	t.Run("login is exempt", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/login", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "stale"})
+		w := httptest.NewRecorder()
+
+		newCSRFTestHandler().ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status code %d, got %d", http.StatusOK, w.Code)
+		}
+	})
+}
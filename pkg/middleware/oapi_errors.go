@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	middlewareoapi "github.com/oapi-codegen/nethttp-middleware"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/problem"
+)
+
+// OApiErrorHandler renders request validation failures from the OpenAPI
+// middleware as problem details.
+func OApiErrorHandler(_ context.Context, err error, w http.ResponseWriter, r *http.Request, opts middlewareoapi.ErrorHandlerOpts) {
+	var (
+		securityErr *openapi3filter.SecurityRequirementsError
+		requestErr  *openapi3filter.RequestError
+	)
+
+	switch {
+	case errors.As(err, &securityErr):
+		problem.Write(w, r, problem.New(http.StatusUnauthorized, problem.CodeUnauthorized, "invalid session or expired session"))
+	case errors.As(err, &requestErr):
+		p := problem.New(http.StatusBadRequest, problem.CodeValidation, "request failed validation")
+		p.Errors = []apperrors.FieldError{requestFieldError(requestErr)}
+		problem.Write(w, r, p)
+	case errors.Is(err, routers.ErrMethodNotAllowed):
+		problem.Write(w, r, problem.FromStatus(http.StatusMethodNotAllowed, "method not allowed"))
+	case errors.Is(err, routers.ErrPathNotFound):
+		problem.Write(w, r, problem.FromStatus(http.StatusNotFound, "route not found"))
+	default:
+		problem.Write(w, r, problem.FromStatus(opts.StatusCode, err.Error()))
+	}
+}
+
+func requestFieldError(err *openapi3filter.RequestError) apperrors.FieldError {
+	field := apperrors.FieldError{Field: "body", Code: "invalid", Message: err.Reason}
+	if err.Parameter != nil {
+		field.Field = err.Parameter.Name
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err.Err, &schemaErr) {
+		if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+			field.Field = strings.Join(pointer, ".")
+		}
+		field.Code = schemaErr.SchemaField
+		field.Message = schemaErr.Reason
+	} else if field.Message == "" && err.Err != nil {
+		field.Message = err.Err.Error()
+	}
+
+	return field
+}
@@ -1,14 +1,112 @@
 package models
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type Book struct {
 	gorm.Model  `json:"-"`
-	Id          uuid.UUID `json:"id" gorm:"primaryKey;type:uuid;default:(gen_random_uuid())"`
-	Title       string    `json:"title" validate:"required" gorm:"type:varchar(255);not null"`
-	Description string    `json:"description" gorm:"type:text;not null"`
-	Count       int       `json:"count" validate:"min=0" gorm:"type:int;not null"`
+	Id          uuid.UUID  `json:"id" gorm:"primaryKey;type:uuid;default:(gen_random_uuid())"`
+	Title       string     `json:"title" validate:"required" gorm:"type:varchar(255);not null"`
+	Description string     `json:"description" gorm:"type:text;not null"`
+	Count       int        `json:"count" validate:"min=0" gorm:"type:int;not null"`
+	Barcode     *string    `json:"barcode,omitempty" validate:"omitempty,max=64" gorm:"type:varchar(64);uniqueIndex:idx_books_barcode,where:deleted_at IS NULL"`
+	Isbn        *string    `json:"isbn,omitempty" validate:"omitempty,max=17" gorm:"type:varchar(17);uniqueIndex:idx_books_isbn,where:deleted_at IS NULL"`
+	CategoryId  *uuid.UUID `json:"category_id,omitempty" gorm:"type:uuid;index"`
+	CallNumber  *string    `json:"call_number,omitempty" validate:"omitempty,max=64" gorm:"type:varchar(64);index"`
+	Tags        []string   `json:"tags" validate:"max=20,dive,max=50" gorm:"type:text;not null;default:'[]';serializer:json"`
+}
+
+// BeforeSave stores the barcode trimmed and the ISBN normalized, and empty
+// identifiers as NULL so that they do not collide in the unique indexes.
+func (b *Book) BeforeSave(tx *gorm.DB) error {
+	b.Barcode = nonEmpty(strings.TrimSpace(deref(b.Barcode)))
+	b.Isbn = nonEmpty(NormalizeISBN(deref(b.Isbn)))
+	b.CallNumber = nonEmpty(strings.TrimSpace(deref(b.CallNumber)))
+	b.Tags = NormalizeTags(b.Tags)
+	return nil
+}
+
+// NormalizeTags trims and lower-cases tags and drops empty and repeated
+// ones, keeping the order of the rest.
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.Join(strings.Fields(tag), " "))
+		if tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
+
+// BookBarcodePrefix starts every generated barcode.
+const BookBarcodePrefix = "BRS"
+
+// BookBarcode formats the nth generated barcode. Books added without one are
+// numbered in sequence after the prefix, with at least eight digits.
+func BookBarcode(n int) string {
+	return fmt.Sprintf("%s%08d", BookBarcodePrefix, n)
+}
+
+// IsGeneratedBarcode reports whether the barcode starts with the prefix of
+// generated ones. Such barcodes cannot be given by hand, or the sequence
+// would later generate them again.
+func IsGeneratedBarcode(barcode string) bool {
+	return len(barcode) >= len(BookBarcodePrefix) && strings.EqualFold(barcode[:len(BookBarcodePrefix)], BookBarcodePrefix)
+}
+
+// BookBarcodeSequence names the sequence of generated book barcodes.
+const BookBarcodeSequence = "book_barcode"
+
+// NextBookBarcode takes the next number of the generated barcode sequence.
+// Its row is updated before anything is read, so the transaction holds the
+// write lock and concurrent creates cannot take the same number.
+func NextBookBarcode(tx *gorm.DB) (int, error) {
+	var next int
+	result := tx.Raw("UPDATE sequences SET value = value + 1 WHERE name = ? RETURNING value", BookBarcodeSequence).Scan(&next)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return 0, fmt.Errorf("sequence %s does not exist", BookBarcodeSequence)
+	}
+	return next, nil
+}
+
+// LastBookBarcode returns the number of the highest generated barcode,
+// including those of deleted books so that their labels are not reused, or 0
+// when there is none.
+func LastBookBarcode(tx *gorm.DB) (int, error) {
+	var last int
+	err := tx.Unscoped().Model(&Book{}).
+		Where("barcode GLOB ? AND barcode NOT GLOB ?", BookBarcodePrefix+"[0-9]*", BookBarcodePrefix+"*[^0-9]*").
+		Select("COALESCE(MAX(CAST(SUBSTR(barcode, ?) AS INTEGER)), 0)", len(BookBarcodePrefix)+1).
+		Scan(&last).Error
+	return last, err
+}
+
+// NormalizeISBN strips hyphens and spaces from an ISBN and upper-cases the
+// ISBN-10 check character.
+func NormalizeISBN(isbn string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(isbn))
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
 }
@@ -8,6 +8,6 @@ import (
 type Cart struct {
 	gorm.Model `json:"-"`
 	Id         uuid.UUID `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())" json:"id"`
-	StudentId  uuid.UUID `gorm:"type:uuid;" json:"student_id"`
-	Status     string    `gorm:"type:text;default:'RENTED'" json:"status"`
+	StudentId  uuid.UUID `gorm:"type:uuid;index:idx_carts_student_status" json:"student_id"`
+	Status     string    `gorm:"type:text;default:'RENTED';index:idx_carts_student_status" json:"status"`
 }
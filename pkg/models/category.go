@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Category classifies books in a tree, such as Fiction > Science Fiction.
+// Names are unique among the children of a parent.
+type Category struct {
+	Id        uuid.UUID  `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())" json:"id"`
+	Name      string     `gorm:"type:varchar(255);not null" json:"name"`
+	ParentId  *uuid.UUID `gorm:"type:uuid;index" json:"parent_id,omitempty"`
+	CreatedAt time.Time  `gorm:"not null" json:"created_at"`
+	UpdatedAt time.Time  `gorm:"not null" json:"updated_at"`
+}
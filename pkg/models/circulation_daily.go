@@ -0,0 +1,15 @@
+package models
+
+// CirculationDaily holds the circulation counts of one closed day, in total
+// (empty Dimension) or for one major or book. Days are rolled up once and not
+// revised afterwards.
+type CirculationDaily struct {
+	Dimension   string `gorm:"type:varchar(10);primaryKey;index:idx_circulation_dailies_group,priority:1"`
+	Day         string `gorm:"type:varchar(10);primaryKey;index:idx_circulation_dailies_group,priority:3"`
+	GroupKey    string `gorm:"type:varchar(255);primaryKey;index:idx_circulation_dailies_group,priority:2"`
+	GroupLabel  string `gorm:"type:varchar(255);not null"`
+	Checkouts   int64  `gorm:"not null"`
+	Returns     int64  `gorm:"not null"`
+	NewStudents int64  `gorm:"not null"`
+	Overdue     int64  `gorm:"not null"`
+}
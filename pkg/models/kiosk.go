@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	KioskCheckout = "checkout"
+	KioskReturn   = "return"
+
+	KioskSucceeded = "succeeded"
+	KioskFailed    = "failed"
+)
+
+// KioskDevice is a self-checkout kiosk. It authenticates with an API key of
+// which only the hash is stored; a revoked kiosk keeps its transactions.
+type KioskDevice struct {
+	Id           uuid.UUID  `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())" json:"id"`
+	Name         string     `gorm:"type:varchar(255);not null" json:"name"`
+	KeyHash      string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	RegisteredBy *uuid.UUID `gorm:"type:uuid" json:"registered_by,omitempty"`
+	CreatedAt    time.Time  `gorm:"not null" json:"created_at"`
+	LastSeenAt   *time.Time `json:"last_seen_at,omitempty"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+}
+
+// KioskTransaction records a checkout or return attempted at a kiosk, with
+// the card and book identifiers as scanned and, when it failed, the error
+// code.
+type KioskTransaction struct {
+	Id        uuid.UUID  `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())" json:"id"`
+	KioskId   uuid.UUID  `gorm:"type:uuid;not null;index:idx_kiosk_transactions_kiosk_created,priority:1" json:"kiosk_id"`
+	Action    string     `gorm:"type:varchar(10);not null" json:"action"`
+	CardId    string     `gorm:"type:varchar(255);not null" json:"card_id"`
+	Books     []string   `gorm:"type:text;not null;serializer:json" json:"books"`
+	StudentId *uuid.UUID `gorm:"type:uuid" json:"student_id,omitempty"`
+	CartId    *uuid.UUID `gorm:"type:uuid" json:"cart_id,omitempty"`
+	Status    string     `gorm:"type:varchar(10);not null" json:"status"`
+	ErrorCode string     `gorm:"type:varchar(50);not null;default:''" json:"error_code,omitempty"`
+	CreatedAt time.Time  `gorm:"not null;index:idx_kiosk_transactions_kiosk_created,priority:2" json:"created_at"`
+}
@@ -5,9 +5,16 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	RoleLibrarian = "librarian"
+	RoleAdmin     = "admin"
+)
+
 type Librarian struct {
-	gorm.Model `json:"-"`
-	Id         uuid.UUID `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())" json:"id"`
-	User       string    `gorm:"<-:create;uniqueIndex;type:varchar(255);not null" json:"user"`
-	Pass       []byte    `gorm:"type:text;not null" json:"-"`
+	gorm.Model  `json:"-"`
+	Id          uuid.UUID `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())" json:"id"`
+	User        string    `gorm:"<-:create;uniqueIndex;type:varchar(255);not null" json:"user"`
+	Pass        []byte    `gorm:"type:text;not null" json:"-"`
+	Role        string    `gorm:"type:varchar(50);not null;default:'librarian'" json:"role"`
+	OIDCSubject *string   `gorm:"column:oidc_subject;uniqueIndex:idx_librarians_oidc_subject;type:varchar(255)" json:"-"`
 }
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	ReportOverdue    = "overdue"
+	ReportRental     = "rental"
+	ReportCollection = "collection"
+
+	FrequencyDaily   = "daily"
+	FrequencyWeekly  = "weekly"
+	FrequencyMonthly = "monthly"
+
+	FormatCSV  = "csv"
+	FormatHTML = "html"
+
+	DeliveryEmail     = "email"
+	DeliveryDirectory = "directory"
+
+	TriggerSchedule = "schedule"
+	TriggerManual   = "manual"
+
+	RunRunning   = "running"
+	RunSucceeded = "succeeded"
+	RunFailed    = "failed"
+)
+
+// ReportSchedule is a saved report definition, delivered every day, week or
+// month at Hour UTC. Weekday (0 is Sunday) applies to weekly and DayOfMonth
+// to monthly schedules.
+type ReportSchedule struct {
+	gorm.Model `json:"-"`
+	Id         uuid.UUID    `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())" json:"id"`
+	Name       string       `gorm:"type:varchar(255);not null" json:"name"`
+	Report     string       `gorm:"type:varchar(20);not null" json:"report"`
+	Params     ReportParams `gorm:"type:text;not null;serializer:json" json:"params"`
+	Frequency  string       `gorm:"type:varchar(10);not null" json:"frequency"`
+	Weekday    *int         `json:"weekday,omitempty"`
+	DayOfMonth *int         `json:"day_of_month,omitempty"`
+	Hour       int          `gorm:"not null" json:"hour"`
+	Formats    []string     `gorm:"type:text;not null;serializer:json" json:"formats"`
+	Delivery   string       `gorm:"type:varchar(20);not null" json:"delivery"`
+	Recipients []string     `gorm:"type:text;not null;serializer:json" json:"recipients"`
+	Enabled    bool         `gorm:"not null" json:"enabled"`
+	NextRunAt  time.Time    `gorm:"not null;index" json:"next_run_at"`
+	LastRunAt  *time.Time   `json:"last_run_at,omitempty"`
+}
+
+// ReportParams are the parameters of a saved report. Each applies to one
+// kind of report only: StudentCardId to the overdue list, Limit to the rental
+// report and the rest to the collection report.
+type ReportParams struct {
+	StudentCardId       *string `json:"student_card_id,omitempty"`
+	Limit               *int    `json:"limit,omitempty"`
+	PeriodDays          *int    `json:"period_days,omitempty"`
+	ZeroCirculation     *bool   `json:"zero_circulation,omitempty"`
+	DemandExceedsSupply *bool   `json:"demand_exceeds_supply,omitempty"`
+}
+
+// ReportRun is one delivery of a saved report. Its files are kept, for
+// download, until the run is older than the configured retention.
+type ReportRun struct {
+	Id         uuid.UUID    `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())" json:"id"`
+	ScheduleId uuid.UUID    `gorm:"type:uuid;not null;index:idx_report_runs_schedule_started,priority:1" json:"schedule_id"`
+	Trigger    string       `gorm:"type:varchar(10);not null" json:"trigger"`
+	Status     string       `gorm:"type:varchar(10);not null" json:"status"`
+	Error      string       `gorm:"type:text;not null;default:''" json:"error,omitempty"`
+	StartedAt  time.Time    `gorm:"not null;index;index:idx_report_runs_schedule_started,priority:2" json:"started_at"`
+	FinishedAt *time.Time   `json:"finished_at,omitempty"`
+	Files      []ReportFile `gorm:"foreignKey:RunId;references:Id" json:"files"`
+}
+
+// ReportFile is one rendered output of a run.
+type ReportFile struct {
+	Id          uuid.UUID `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())" json:"-"`
+	RunId       uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_report_files_run_format" json:"-"`
+	Format      string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_report_files_run_format" json:"format"`
+	Name        string    `gorm:"type:varchar(255);not null" json:"name"`
+	ContentType string    `gorm:"type:varchar(100);not null" json:"content_type"`
+	Size        int       `gorm:"not null" json:"size"`
+	Content     []byte    `gorm:"not null" json:"-"`
+	URL         string    `gorm:"-" json:"url"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	ConditionGood    = "good"
+	ConditionDamaged = "damaged"
+	ConditionLost    = "lost"
+)
+
+// ReturnEvent records one book of a cart coming back. LibrarianId is nil when
+// the return was not processed by a signed-in librarian.
+type ReturnEvent struct {
+	gorm.Model  `json:"-"`
+	Id          uuid.UUID  `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())" json:"id"`
+	CartId      uuid.UUID  `gorm:"type:uuid;not null;index" json:"cart_id"`
+	RentId      uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex" json:"rent_id"`
+	BookId      uuid.UUID  `gorm:"type:uuid;not null;index" json:"book_id"`
+	StudentId   uuid.UUID  `gorm:"type:uuid;not null;index" json:"student_id"`
+	LibrarianId *uuid.UUID `gorm:"type:uuid;index" json:"librarian_id"`
+	Condition   string     `gorm:"type:varchar(20);not null;default:'good'" json:"condition"`
+	Notes       string     `gorm:"type:text;not null;default:''" json:"notes"`
+	ReturnedAt  time.Time  `gorm:"not null;index" json:"returned_at"`
+}
@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+const SchemaVersion = 10
+
+type SchemaMigration struct {
+	Version   int       `gorm:"primaryKey;autoIncrement:false" json:"version"`
+	AppliedAt time.Time `gorm:"not null" json:"applied_at"`
+}
@@ -0,0 +1,7 @@
+package models
+
+// Sequence is a named counter that hands out increasing numbers.
+type Sequence struct {
+	Name  string `gorm:"primaryKey;type:varchar(64)"`
+	Value int    `gorm:"not null"`
+}
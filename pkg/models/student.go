@@ -3,6 +3,8 @@ package models
 import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+
+	"BRSBackend/pkg/search"
 )
 
 type Student struct {
@@ -13,4 +15,23 @@ type Student struct {
 	CardId     string    `json:"card_id" gorm:"type:varchar(255);not null"`
 	Major      string    `json:"major" validate:"required" gorm:"type:varchar(255);not null"`
 	Phone      string    `json:"phone" validate:"required" gorm:"type:varchar(255);not null"`
+	Email      string    `json:"email,omitempty" validate:"omitempty,email,max=255" gorm:"type:varchar(255);not null;default:''"`
+
+	// Folded copies of the searchable fields, kept in sync by BeforeSave.
+	SearchFirstName string `json:"-" gorm:"type:varchar(255);not null;default:'';index"`
+	SearchLastName  string `json:"-" gorm:"type:varchar(255);not null;default:'';index"`
+	SearchCardId    string `json:"-" gorm:"type:varchar(255);not null;default:'';index"`
+	SearchPhone     string `json:"-" gorm:"type:varchar(255);not null;default:'';index"`
+	SearchPhoneRev  string `json:"-" gorm:"type:varchar(255);not null;default:'';index"`
+	SearchMajor     string `json:"-" gorm:"type:varchar(255);not null;default:'';index"`
+}
+
+func (s *Student) BeforeSave(tx *gorm.DB) error {
+	s.SearchFirstName = search.Fold(s.FirstName)
+	s.SearchLastName = search.Fold(s.LastName)
+	s.SearchCardId = search.Fold(s.CardId)
+	s.SearchPhone = search.Digits(s.Phone)
+	s.SearchPhoneRev = search.Reverse(s.SearchPhone)
+	s.SearchMajor = search.Fold(s.Major)
+	return nil
 }
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StudentCredential holds the portal PIN of a student. The PIN is locked
+// for a while after too many failed attempts.
+type StudentCredential struct {
+	StudentId      uuid.UUID `gorm:"primaryKey;type:uuid"`
+	PinHash        []byte    `gorm:"type:text;not null"`
+	FailedAttempts int       `gorm:"not null;default:0"`
+	LockedUntil    *time.Time
+	UpdatedAt      time.Time
+}
+
+// StudentSession is a portal session. It is kept apart from the librarian
+// sessions so that neither can stand in for the other.
+type StudentSession struct {
+	gorm.Model `json:"-"`
+	Id         string    `gorm:"primaryKey;type:varchar(255)" json:"id"`
+	StudentId  uuid.UUID `gorm:"type:uuid;not null;index" json:"student_id"`
+	ExpiresAt  time.Time `gorm:"not null" json:"expires_at"`
+	Student    Student   `gorm:"foreignKey:StudentId;references:Id" json:"-"`
+}
+
+// StudentLoginToken is a single use magic link token. Only the SHA-256 of the
+// token is stored, since the token itself travels by email.
+type StudentLoginToken struct {
+	TokenHash string    `gorm:"primaryKey;type:varchar(64)"`
+	StudentId uuid.UUID `gorm:"type:uuid;not null;index"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
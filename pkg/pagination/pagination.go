@@ -0,0 +1,226 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"BRSBackend/pkg/apperrors"
+)
+
+type Kind int
+
+const (
+	String Kind = iota
+	Int
+	Time
+)
+
+// Field is a sortable column. Column may be any SQL expression that is
+// valid both in ORDER BY and WHERE for the query it is applied to.
+type Field struct {
+	Column string
+	Kind   Kind
+}
+
+type Fields map[string]Field
+
+type Sort struct {
+	Key  string
+	Desc bool
+}
+
+func (s Sort) String() string {
+	if s.Desc {
+		return "-" + s.Key
+	}
+	return s.Key
+}
+
+// ParseSort parses "field" or "-field" against the allowed fields.
+func ParseSort(raw string, fields Fields, def Sort) (Sort, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return def, nil
+	}
+
+	sort := Sort{Key: strings.TrimPrefix(raw, "-"), Desc: strings.HasPrefix(raw, "-")}
+	if _, ok := fields[sort.Key]; !ok {
+		keys := make([]string, 0, len(fields))
+		for key := range fields {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		return Sort{}, apperrors.Validation("invalid_sort", "unsupported sort field", apperrors.FieldError{
+			Field:   "sort",
+			Code:    "oneof",
+			Message: fmt.Sprintf("sort must be one of %s, optionally prefixed with '-'", strings.Join(keys, ", ")),
+		})
+	}
+	return sort, nil
+}
+
+// Cursor marks the row a page starts after (or, with Before, ends before).
+type Cursor struct {
+	Sort   string `json:"s"`
+	Value  any    `json:"v"`
+	ID     string `json:"id"`
+	Before bool   `json:"b,omitempty"`
+}
+
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func errInvalidCursor() error {
+	return apperrors.Validation("invalid_cursor", "cursor is malformed or does not match the requested sort", apperrors.FieldError{
+		Field:   "cursor",
+		Code:    "invalid",
+		Message: "cursor must be a value returned in next_cursor or prev_cursor for the same sort",
+	})
+}
+
+func decodeCursor(raw string, sort Sort, field Field) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, errInvalidCursor()
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" || cursor.Sort != sort.String() {
+		return nil, errInvalidCursor()
+	}
+
+	switch v := cursor.Value.(type) {
+	case string:
+		if field.Kind == Time {
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, errInvalidCursor()
+			}
+			cursor.Value = t
+		} else if field.Kind != String {
+			return nil, errInvalidCursor()
+		}
+	case float64:
+		if field.Kind != Int {
+			return nil, errInvalidCursor()
+		}
+		cursor.Value = int64(v)
+	default:
+		return nil, errInvalidCursor()
+	}
+
+	return &cursor, nil
+}
+
+type Page struct {
+	Sort   Sort
+	Cursor *Cursor
+	Limit  int
+	Offset int
+
+	field    Field
+	idColumn string
+}
+
+// New resolves the raw sort and cursor query parameters into a page. The
+// cursor, when present, takes precedence over the offset.
+func New(sort, cursor string, limit, offset int, fields Fields, def Sort, idColumn string) (*Page, error) {
+	s, err := ParseSort(sort, fields, def)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page{Sort: s, Limit: limit, Offset: offset, field: fields[s.Key], idColumn: idColumn}
+	if cursor != "" {
+		if page.Cursor, err = decodeCursor(cursor, s, page.field); err != nil {
+			return nil, err
+		}
+		page.Offset = 0
+	}
+	return page, nil
+}
+
+func (p *Page) descending() bool {
+	if p.Cursor != nil && p.Cursor.Before {
+		return !p.Sort.Desc
+	}
+	return p.Sort.Desc
+}
+
+// Scope applies the keyset condition, ordering and limit. One extra row is
+// fetched so Window can tell whether another page exists.
+func (p *Page) Scope(db *gorm.DB) *gorm.DB {
+	dir, op := "ASC", ">"
+	if p.descending() {
+		dir, op = "DESC", "<"
+	}
+
+	if p.Cursor != nil {
+		db = db.Where(
+			fmt.Sprintf("((%s) %s ? OR ((%s) = ? AND %s %s ?))", p.field.Column, op, p.field.Column, p.idColumn, op),
+			p.Cursor.Value, p.Cursor.Value, p.Cursor.ID,
+		)
+	} else if p.Offset > 0 {
+		db = db.Offset(p.Offset)
+	}
+
+	return db.
+		Order(fmt.Sprintf("%s %s, %s %s", p.field.Column, dir, p.idColumn, dir)).
+		Limit(p.Limit + 1)
+}
+
+type Links struct {
+	Next string
+	Prev string
+}
+
+// Window trims the extra row fetched by Scope, restores the requested order
+// for backward pages and builds the cursors for the neighbouring pages. key
+// returns the sort value and id of an item.
+func Window[T any](p *Page, items []T, key func(T) (any, string)) ([]T, Links) {
+	hasMore := len(items) > p.Limit
+	if hasMore {
+		items = items[:p.Limit]
+	}
+	before := p.Cursor != nil && p.Cursor.Before
+	if before {
+		slices.Reverse(items)
+	}
+
+	var links Links
+	if len(items) == 0 {
+		return items, links
+	}
+
+	cursorAt := func(item T, before bool) string {
+		value, id := key(item)
+		if t, ok := value.(time.Time); ok {
+			value = t.Format(time.RFC3339Nano)
+		}
+		return Cursor{Sort: p.Sort.String(), Value: value, ID: id, Before: before}.Encode()
+	}
+
+	if before {
+		links.Next = cursorAt(items[len(items)-1], false)
+		if hasMore {
+			links.Prev = cursorAt(items[0], true)
+		}
+		return items, links
+	}
+
+	if hasMore {
+		links.Next = cursorAt(items[len(items)-1], false)
+	}
+	if p.Cursor != nil || p.Offset > 0 {
+		links.Prev = cursorAt(items[0], true)
+	}
+	return items, links
+}
@@ -0,0 +1,214 @@
+package pagination
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"BRSBackend/pkg/apperrors"
+)
+
+var testFields = Fields{
+	"name":       {Column: "name", Kind: String},
+	"count":      {Column: "count", Kind: Int},
+	"created_at": {Column: "created_at", Kind: Time},
+}
+
+type item struct {
+	ID        string
+	Name      string
+	Count     int
+	CreatedAt time.Time
+}
+
+func itemKey(sort string) func(item) (any, string) {
+	return func(it item) (any, string) {
+		switch sort {
+		case "name":
+			return it.Name, it.ID
+		case "count":
+			return it.Count, it.ID
+		default:
+			return it.CreatedAt, it.ID
+		}
+	}
+}
+
+func TestParseSort(t *testing.T) {
+	def := Sort{Key: "created_at", Desc: true}
+
+	tests := []struct {
+		raw  string
+		want Sort
+	}{
+		{"", def},
+		{"name", Sort{Key: "name"}},
+		{"-count", Sort{Key: "count", Desc: true}},
+	}
+	for _, tt := range tests {
+		got, err := ParseSort(tt.raw, testFields, def)
+		if err != nil {
+			t.Fatalf("ParseSort(%q) returned error: %v", tt.raw, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseSort(%q) = %+v, want %+v", tt.raw, got, tt.want)
+		}
+	}
+
+	_, err := ParseSort("password", testFields, def)
+	if !errors.Is(err, apperrors.ErrValidation) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
+
+func TestNewRejectsCursorForDifferentSort(t *testing.T) {
+	cursor := Cursor{Sort: "name", Value: "b", ID: "2"}.Encode()
+
+	if _, err := New("name", cursor, 10, 0, testFields, Sort{Key: "name"}, "id"); err != nil {
+		t.Fatalf("expected cursor to be accepted, got %v", err)
+	}
+	if _, err := New("-name", cursor, 10, 0, testFields, Sort{Key: "name"}, "id"); !errors.Is(err, apperrors.ErrValidation) {
+		t.Errorf("expected validation error for mismatched sort, got %v", err)
+	}
+	if _, err := New("name", "not-a-cursor", 10, 0, testFields, Sort{Key: "name"}, "id"); !errors.Is(err, apperrors.ErrValidation) {
+		t.Errorf("expected validation error for malformed cursor, got %v", err)
+	}
+}
+
+func TestNewDecodesCursorValues(t *testing.T) {
+	created := time.Date(2026, 10, 19, 12, 0, 0, 123, time.UTC)
+	page, err := New("created_at", Cursor{Sort: "created_at", Value: created.Format(time.RFC3339Nano), ID: "1"}.Encode(),
+		10, 5, testFields, Sort{Key: "created_at"}, "id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, ok := page.Cursor.Value.(time.Time); !ok || !got.Equal(created) {
+		t.Errorf("expected cursor value %v, got %#v", created, page.Cursor.Value)
+	}
+	if page.Offset != 0 {
+		t.Errorf("expected offset to be ignored with a cursor, got %d", page.Offset)
+	}
+
+	page, err = New("count", Cursor{Sort: "count", Value: 7, ID: "1"}.Encode(), 10, 0, testFields, Sort{Key: "count"}, "id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.Cursor.Value != int64(7) {
+		t.Errorf("expected cursor value 7, got %#v", page.Cursor.Value)
+	}
+}
+
+func openTestDB(t *testing.T, n int) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	if err := db.AutoMigrate(&item{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < n; i++ {
+		it := item{
+			ID:        fmt.Sprintf("id-%02d", i),
+			Name:      fmt.Sprintf("name-%02d", (i*7)%n),
+			Count:     i % 3,
+			CreatedAt: base.Add(time.Duration(i%4) * time.Hour),
+		}
+		if err := db.Create(&it).Error; err != nil {
+			t.Fatalf("failed to insert: %v", err)
+		}
+	}
+	return db
+}
+
+func fetch(t *testing.T, db *gorm.DB, sort, cursor string, limit, offset int) ([]item, Links) {
+	t.Helper()
+
+	page, err := New(sort, cursor, limit, offset, testFields, Sort{Key: "created_at"}, "id")
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	var items []item
+	if err := db.Scopes(page.Scope).Find(&items).Error; err != nil {
+		t.Fatalf("query failed: %v", err)
+	}
+	return Window(page, items, itemKey(page.Sort.Key))
+}
+
+func ids(items []item) []string {
+	out := make([]string, len(items))
+	for i, it := range items {
+		out[i] = it.ID
+	}
+	return out
+}
+
+func TestKeysetWalk(t *testing.T) {
+	db := openTestDB(t, 11)
+
+	for _, sort := range []string{"name", "-name", "count", "-count", "created_at", "-created_at"} {
+		t.Run(sort, func(t *testing.T) {
+			all, _ := fetch(t, db, sort, "", 100, 0)
+			if len(all) != 11 {
+				t.Fatalf("expected 11 items, got %d", len(all))
+			}
+
+			var forward []item
+			var links Links
+			cursor := ""
+			for {
+				var items []item
+				items, links = fetch(t, db, sort, cursor, 4, 0)
+				forward = append(forward, items...)
+				if links.Next == "" {
+					break
+				}
+				cursor = links.Next
+			}
+			if fmt.Sprint(ids(forward)) != fmt.Sprint(ids(all)) {
+				t.Fatalf("forward walk %v does not match %v", ids(forward), ids(all))
+			}
+
+			backward, _ := fetch(t, db, sort, cursor, 4, 0)
+			for links.Prev != "" {
+				var items []item
+				items, links = fetch(t, db, sort, links.Prev, 4, 0)
+				backward = append(items, backward...)
+			}
+			if fmt.Sprint(ids(backward)) != fmt.Sprint(ids(all)) {
+				t.Fatalf("backward walk %v does not match %v", ids(backward), ids(all))
+			}
+		})
+	}
+}
+
+func TestOffsetModeLinks(t *testing.T) {
+	db := openTestDB(t, 5)
+
+	items, links := fetch(t, db, "name", "", 2, 2)
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	if links.Next == "" || links.Prev == "" {
+		t.Errorf("expected both cursors in the middle of the list, got %+v", links)
+	}
+
+	_, links = fetch(t, db, "name", "", 2, 4)
+	if links.Next != "" {
+		t.Errorf("expected no next cursor on the last page, got %q", links.Next)
+	}
+
+	_, links = fetch(t, db, "name", "", 2, 0)
+	if links.Prev != "" {
+		t.Errorf("expected no prev cursor on the first page, got %q", links.Prev)
+	}
+}
@@ -0,0 +1,251 @@
+// Package pdf writes simple PDF documents: text in the standard Helvetica
+// fonts, lines, filled rectangles and raster images. It covers what the
+// printed receipts need and leaves everything else out.
+//
+// Coordinates are in points, 1/72 of an inch, from the bottom left corner of
+// the page.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// Size is the size of a page in points.
+type Size struct {
+	Width  float64
+	Height float64
+}
+
+var (
+	A4     = Size{Width: 595.28, Height: 841.89}
+	Letter = Size{Width: 612, Height: 792}
+)
+
+// Papers names the paper sizes that can be configured.
+var Papers = map[string]Size{
+	"a4":     A4,
+	"letter": Letter,
+}
+
+// Mm converts millimetres to points.
+func Mm(mm float64) float64 {
+	return mm * 72 / 25.4
+}
+
+type Document struct {
+	Title  string
+	pages  []*Page
+	images []*Image
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+type Page struct {
+	size    Size
+	content bytes.Buffer
+}
+
+// Image is a raster image added to a document, to be drawn on any of its
+// pages.
+type Image struct {
+	name   string
+	width  int
+	height int
+	rgb    []byte
+	alpha  []byte
+}
+
+// Width returns the width of the image in pixels.
+func (i *Image) Width() int {
+	return i.width
+}
+
+// Height returns the height of the image in pixels.
+func (i *Image) Height() int {
+	return i.height
+}
+
+func (d *Document) AddPage(size Size) *Page {
+	page := &Page{size: size}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// AddImage adds img to the document. Transparent images keep their alpha
+// channel as a soft mask.
+func (d *Document) AddImage(img image.Image) (*Image, error) {
+	bounds := img.Bounds()
+	rgb := make([]byte, 0, bounds.Dx()*bounds.Dy()*3)
+	alpha := make([]byte, 0, bounds.Dx()*bounds.Dy())
+	opaque := true
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			if a > 0 && a < 0xffff {
+				// Undo the premultiplication of the color by alpha.
+				r, g, b = r*0xffff/a, g*0xffff/a, b*0xffff/a
+			}
+			rgb = append(rgb, byte(r>>8), byte(g>>8), byte(b>>8))
+			alpha = append(alpha, byte(a>>8))
+			if a != 0xffff {
+				opaque = false
+			}
+		}
+	}
+
+	compressed := &Image{
+		name:   "Im" + strconv.Itoa(len(d.images)+1),
+		width:  bounds.Dx(),
+		height: bounds.Dy(),
+	}
+	var err error
+	if compressed.rgb, err = deflate(rgb); err != nil {
+		return nil, err
+	}
+	if !opaque {
+		if compressed.alpha, err = deflate(alpha); err != nil {
+			return nil, err
+		}
+	}
+	d.images = append(d.images, compressed)
+	return compressed, nil
+}
+
+// Text draws s with its baseline starting at x, y. Characters outside of
+// Windows-1252 are printed as question marks.
+func (p *Page) Text(x, y float64, font Font, size float64, s string) {
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n",
+		font.resource(), num(size), num(x), num(y), escape(s))
+}
+
+// Line draws a black line of the given width.
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n", num(width), num(x1), num(y1), num(x2), num(y2))
+}
+
+// Rect fills a black rectangle with its bottom left corner at x, y.
+func (p *Page) Rect(x, y, width, height float64) {
+	fmt.Fprintf(&p.content, "%s %s %s %s re f\n", num(x), num(y), num(width), num(height))
+}
+
+// Image draws img stretched to the rectangle with its bottom left corner at
+// x, y.
+func (p *Page) Image(img *Image, x, y, width, height float64) {
+	fmt.Fprintf(&p.content, "q %s 0 0 %s %s %s cm /%s Do Q\n", num(width), num(height), num(x), num(y), img.name)
+}
+
+// WriteTo writes the document to w.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string, stream []byte) int {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s", len(offsets), body)
+		if stream != nil {
+			out.WriteString("\nstream\n")
+			out.Write(stream)
+			out.WriteString("\nendstream")
+		}
+		out.WriteString("\nendobj\n")
+		return len(offsets)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Object 2 is the page tree. It lists the pages, so it is written after
+	// them and only its number is reserved here.
+	object("<< /Type /Catalog /Pages 2 0 R >>", nil)
+	offsets = append(offsets, 0)
+
+	var fonts []string
+	for _, font := range []Font{Helvetica, HelveticaBold} {
+		id := object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", font.name()), nil)
+		fonts = append(fonts, fmt.Sprintf("/%s %d 0 R", font.resource(), id))
+	}
+
+	var images []string
+	for _, img := range d.images {
+		mask := ""
+		if img.alpha != nil {
+			id := object(fmt.Sprintf("<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /FlateDecode /Length %d >>",
+				img.width, img.height, len(img.alpha)), img.alpha)
+			mask = fmt.Sprintf(" /SMask %d 0 R", id)
+		}
+		id := object(fmt.Sprintf("<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode%s /Length %d >>",
+			img.width, img.height, mask, len(img.rgb)), img.rgb)
+		images = append(images, fmt.Sprintf("/%s %d 0 R", img.name, id))
+	}
+
+	resources := object(fmt.Sprintf("<< /Font << %s >> /XObject << %s >> >>",
+		strings.Join(fonts, " "), strings.Join(images, " ")), nil)
+
+	var kids []string
+	for _, page := range d.pages {
+		content := object(fmt.Sprintf("<< /Length %d >>", page.content.Len()), page.content.Bytes())
+		id := object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources %d 0 R /Contents %d 0 R >>",
+			num(page.size.Width), num(page.size.Height), resources, content), nil)
+		kids = append(kids, fmt.Sprintf("%d 0 R", id))
+	}
+
+	offsets[1] = out.Len()
+	fmt.Fprintf(&out, "2 0 obj\n<< /Type /Pages /Kids [%s] /Count %d >>\nendobj\n", strings.Join(kids, " "), len(kids))
+
+	info := object(fmt.Sprintf("<< /Title (%s) >>", escape(d.Title)), nil)
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, info, xref)
+
+	return out.WriteTo(w)
+}
+
+func deflate(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// escape encodes s in Windows-1252 as the body of a PDF string literal.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		c, ok := charmap.Windows1252.EncodeRune(r)
+		if !ok {
+			c = '?'
+		}
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 32 || c > 126:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
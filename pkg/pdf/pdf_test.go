@@ -0,0 +1,72 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWriteTo(t *testing.T) {
+	doc := New()
+	doc.Title = "Receipt (copy)"
+	logo := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	logo.Set(0, 0, color.NRGBA{R: 255, A: 128})
+	img, err := doc.AddImage(logo)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for range 2 {
+		page := doc.AddPage(A4)
+		page.Image(img, 10, 10, 20, 20)
+		page.Text(10, 800, HelveticaBold, 12, "Café (Dune) \\ 東")
+		page.Rect(10, 10, 1.5, 30)
+	}
+
+	var buf bytes.Buffer
+	if _, err := doc.WriteTo(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+
+	if !strings.HasPrefix(out, "%PDF-1.4\n") || !strings.HasSuffix(out, "%%EOF\n") {
+		t.Fatalf("expected a PDF header and trailer")
+	}
+	if !strings.Contains(out, `(Caf\351 \(Dune\) \\ ?) Tj`) {
+		t.Errorf("expected the text to be escaped in Windows-1252")
+	}
+	if !strings.Contains(out, "/Count 2") || !strings.Contains(out, "/SMask") {
+		t.Errorf("expected two pages and a soft mask for the transparent image")
+	}
+
+	// Every entry of the cross-reference table points at its object.
+	start, err := strconv.Atoi(regexp.MustCompile(`startxref\n(\d+)`).FindStringSubmatch(out)[1])
+	if err != nil {
+		t.Fatalf("invalid startxref: %v", err)
+	}
+	lines := strings.Split(out[start:], "\n")
+	if lines[0] != "xref" {
+		t.Fatalf("expected startxref to point at the xref table, got %q", lines[0])
+	}
+	var count int
+	fmt.Sscanf(lines[1], "0 %d", &count)
+	for i := 1; i < count; i++ {
+		offset, _ := strconv.Atoi(lines[2+i][:10])
+		if want := fmt.Sprintf("%d 0 obj\n", i); !strings.HasPrefix(out[offset:], want) {
+			t.Errorf("expected object %d at offset %d", i, offset)
+		}
+	}
+}
+
+func TestFontWidth(t *testing.T) {
+	if got := Helvetica.Width("Hi", 10); got != 9.44 {
+		t.Errorf("expected 9.44, got %v", got)
+	}
+	if Helvetica.Width("Dune", 12) >= HelveticaBold.Width("Dune", 12) {
+		t.Errorf("expected bold text to be wider")
+	}
+}
@@ -0,0 +1,167 @@
+package printing
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"BRSBackend/pkg/barcode"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/pdf"
+)
+
+const inch = 72.0
+
+// LabelTemplate is the layout of a sheet of adhesive labels, in points.
+// Labels are Pitch apart, the first one Left and Top from the corner of the
+// page.
+type LabelTemplate struct {
+	Paper   pdf.Size
+	Columns int
+	Rows    int
+	Width   float64
+	Height  float64
+	Left    float64
+	Top     float64
+	PitchX  float64
+	PitchY  float64
+}
+
+// LabelTemplates are the Avery sheets labels can be printed on, by product
+// code: address labels on US Letter and their A4 counterparts.
+var LabelTemplates = map[string]LabelTemplate{
+	"5160": {
+		Paper: pdf.Letter, Columns: 3, Rows: 10,
+		Width: 2.625 * inch, Height: 1 * inch,
+		Left: 0.1875 * inch, Top: 0.5 * inch,
+		PitchX: 2.75 * inch, PitchY: 1 * inch,
+	},
+	"5163": {
+		Paper: pdf.Letter, Columns: 2, Rows: 5,
+		Width: 4 * inch, Height: 2 * inch,
+		Left: 0.15625 * inch, Top: 0.5 * inch,
+		PitchX: 4.1875 * inch, PitchY: 2 * inch,
+	},
+	"5167": {
+		Paper: pdf.Letter, Columns: 4, Rows: 20,
+		Width: 1.75 * inch, Height: 0.5 * inch,
+		Left: 0.28125 * inch, Top: 0.5 * inch,
+		PitchX: 2.0625 * inch, PitchY: 0.5 * inch,
+	},
+	"L7160": {
+		Paper: pdf.A4, Columns: 3, Rows: 7,
+		Width: pdf.Mm(63.5), Height: pdf.Mm(38.1),
+		Left: pdf.Mm(7.21), Top: pdf.Mm(15.15),
+		PitchX: pdf.Mm(66.04), PitchY: pdf.Mm(38.1),
+	},
+	"L7163": {
+		Paper: pdf.A4, Columns: 2, Rows: 7,
+		Width: pdf.Mm(99.1), Height: pdf.Mm(38.1),
+		Left: pdf.Mm(4.65), Top: pdf.Mm(15.15),
+		PitchX: pdf.Mm(101.6), PitchY: pdf.Mm(38.1),
+	},
+	"L7651": {
+		Paper: pdf.A4, Columns: 5, Rows: 13,
+		Width: pdf.Mm(38.1), Height: pdf.Mm(21.2),
+		Left: pdf.Mm(4.75), Top: pdf.Mm(10.7),
+		PitchX: pdf.Mm(40.64), PitchY: pdf.Mm(21.2),
+	},
+}
+
+// PerSheet is the number of labels on a sheet.
+func (t LabelTemplate) PerSheet() int {
+	return t.Columns * t.Rows
+}
+
+// WritePDF writes the labels across the rows of as many sheets as they take,
+// each label copies times in a row. The first label goes to position start,
+// counted from 1, so that partly used sheets can be fed again.
+func (t LabelTemplate) WritePDF(w io.Writer, labels []dto.Label, start, copies int) error {
+	if start < 1 || start > t.PerSheet() {
+		return fmt.Errorf("start position %d is not on a sheet of %d labels", start, t.PerSheet())
+	}
+
+	doc := pdf.New()
+	doc.Title = "Labels"
+	var page *pdf.Page
+	position := start - 1
+	for _, label := range labels {
+		for range copies {
+			if page == nil || position == t.PerSheet() {
+				page = doc.AddPage(t.Paper)
+				if position == t.PerSheet() {
+					position = 0
+				}
+			}
+			col, row := position%t.Columns, position/t.Columns
+			x := t.Left + float64(col)*t.PitchX
+			top := t.Paper.Height - t.Top - float64(row)*t.PitchY
+			t.drawLabel(page, x, top, label)
+			position++
+		}
+	}
+
+	_, err := doc.WriteTo(w)
+	return err
+}
+
+// drawLabel draws a label with its top left corner at x, top. A Code 128
+// barcode runs across the label above the lines of text; a QR code stands
+// at the left with the text beside it.
+func (t LabelTemplate) drawLabel(page *pdf.Page, x, top float64, label dto.Label) {
+	padding := math.Min(t.Height/10, 6)
+	fontSize := math.Max(5, math.Min(t.Height/9, 9))
+	leading := fontSize * 1.2
+	left, right := x+padding, x+t.Width-padding
+	top, bottom := top-padding, top-t.Height+padding
+
+	lines := label.Lines
+	symbol := label.Symbol
+	measure := func(s string) float64 { return pdf.Helvetica.Width(s, fontSize) }
+
+	if symbol.Symbology == barcode.QR {
+		side := math.Min(top-bottom, (right-left)/2)
+		drawModules(page, symbol, left, top, side/float64(symbol.Width+2*symbol.QuietZone))
+		textLeft := left + side + padding/2
+		// Text lines are centered vertically next to the code.
+		room := int((top - bottom) / leading)
+		lines = lines[:min(len(lines), room)]
+		y := (top+bottom)/2 + float64(len(lines))*leading/2 - fontSize
+		for _, l := range lines {
+			page.Text(textLeft, y, pdf.Helvetica, fontSize, truncate(l, right-textLeft, measure))
+			y -= leading
+		}
+		return
+	}
+
+	// The barcode gets the height the text leaves, but at least half of the
+	// label, dropping the last lines of text if need be.
+	room := int((top - bottom) / 2 / leading)
+	lines = lines[:min(len(lines), room)]
+	barHeight := top - bottom - float64(len(lines))*leading
+	modules := float64(symbol.Width + 2*symbol.QuietZone)
+	module := (right - left) / modules
+	offset := left + float64(symbol.QuietZone)*module
+	symbol.Runs(0, func(start, length int) {
+		page.Rect(offset+float64(start)*module, top-barHeight, float64(length)*module, barHeight)
+	})
+
+	y := top - barHeight - fontSize
+	for _, l := range lines {
+		l = truncate(l, right-left, measure)
+		page.Text((left+right-measure(l))/2, y, pdf.Helvetica, fontSize, l)
+		y -= leading
+	}
+}
+
+// drawModules draws a two dimensional symbol with its quiet zone, its top
+// left corner at x, top and each module size points square.
+func drawModules(page *pdf.Page, symbol *barcode.Symbol, x, top, size float64) {
+	x += float64(symbol.QuietZone) * size
+	top -= float64(symbol.QuietZone) * size
+	for row := range symbol.Height {
+		symbol.Runs(row, func(start, length int) {
+			page.Rect(x+float64(start)*size, top-float64(row+1)*size, float64(length)*size, size)
+		})
+	}
+}
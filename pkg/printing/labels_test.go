@@ -0,0 +1,77 @@
+package printing
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"BRSBackend/pkg/barcode"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/pdf"
+)
+
+func TestLabelTemplates(t *testing.T) {
+	for name, template := range LabelTemplates {
+		right := template.Left + float64(template.Columns-1)*template.PitchX + template.Width
+		bottom := template.Top + float64(template.Rows-1)*template.PitchY + template.Height
+		if right > template.Paper.Width || bottom > template.Paper.Height {
+			t.Errorf("%s: labels run off the page to %.1f, %.1f", name, right, bottom)
+		}
+		if template.Width > template.PitchX || template.Height > template.PitchY {
+			t.Errorf("%s: labels overlap", name)
+		}
+	}
+}
+
+func TestWriteLabels(t *testing.T) {
+	symbol, err := barcode.Encode(barcode.Code128, "00000042")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	labels := []dto.Label{
+		{Symbol: symbol, Lines: []string{"Dune", "00000042"}},
+		{Symbol: symbol, Lines: []string{"The Hitchhiker's Guide to the Galaxy, the complete trilogy in five parts", "00000043"}},
+	}
+	template := LabelTemplates["5167"]
+
+	var buf bytes.Buffer
+	if err := template.WritePDF(&buf, labels, 80, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+
+	// The last position of the first sheet, then the top of the second.
+	if !strings.Contains(out, "/Count 2") {
+		t.Errorf("expected two sheets")
+	}
+	if !strings.Contains(out, "(The Hitchhiker's Guide to the Galaxy, the complete...)") {
+		t.Errorf("expected the long title to be truncated")
+	}
+	rect := regexp.MustCompile(`([\d.]+) ([\d.]+) ([\d.]+) ([\d.]+) re f`)
+	for _, m := range rect.FindAllStringSubmatch(out, -1) {
+		x, _ := strconv.ParseFloat(m[1], 64)
+		width, _ := strconv.ParseFloat(m[3], 64)
+		if x < template.Left || x+width > pdf.Letter.Width-template.Left+0.01 {
+			t.Errorf("bar at %s is off the labels", m[1])
+		}
+	}
+
+	if err := template.WritePDF(&buf, labels, 81, 1); err == nil {
+		t.Errorf("expected an error for a start position past the sheet")
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	measure := func(s string) float64 { return float64(len(s)) }
+	if got := truncate("Dune", 4, measure); got != "Dune" {
+		t.Errorf("expected Dune to fit, got %q", got)
+	}
+	if got := truncate("Dune Messiah", 8, measure); got != "Dune..." {
+		t.Errorf("expected Dune..., got %q", got)
+	}
+	if got := truncate("Dune", 2, measure); got != "" {
+		t.Errorf("expected nothing to fit, got %q", got)
+	}
+}
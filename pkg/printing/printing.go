@@ -0,0 +1,88 @@
+// Package printing renders documents for the desk printers: plain text for
+// thermal receipt printers and PDF for regular ones.
+package printing
+
+import (
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// LoadImage reads a PNG or JPEG image, such as the library's logo.
+func LoadImage(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return img, nil
+}
+
+// wrap breaks s into lines no wider than width, between words where it can.
+func wrap(s string, width float64, measure func(string) float64) []string {
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(s) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if measure(candidate) <= width {
+			line = candidate
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+		// A word wider than a whole line is split wherever it overflows.
+		line = ""
+		for _, r := range word {
+			if line != "" && measure(line+string(r)) > width {
+				lines = append(lines, line)
+				line = ""
+			}
+			line += string(r)
+		}
+	}
+	if line != "" || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// truncate shortens s to fit width, marking the cut with dots.
+func truncate(s string, width float64, measure func(string) float64) string {
+	if measure(s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && measure(string(runes)+"...") > width {
+		runes = runes[:len(runes)-1]
+	}
+	if len(runes) == 0 {
+		return ""
+	}
+	return strings.TrimRight(string(runes), " ") + "..."
+}
+
+func runeWidth(s string) float64 {
+	return float64(utf8.RuneCountInString(s))
+}
+
+func formatDate(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04 UTC")
+}
@@ -0,0 +1,258 @@
+package printing
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"io"
+	"strings"
+	"time"
+
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/pdf"
+)
+
+// ReceiptTemplate prints checkout and return receipts headed by the
+// library's name and logo.
+type ReceiptTemplate struct {
+	LibraryName string
+	// Logo is optional. Text receipts leave it out.
+	Logo image.Image
+	// Paper is the page size of PDF receipts.
+	Paper pdf.Size
+	// TextWidth is the number of characters in a line of a text receipt.
+	TextWidth int
+}
+
+func receiptTitle(receipt *dto.Receipt) string {
+	if receipt.Kind == dto.ReceiptReturn {
+		return "Return receipt"
+	}
+	return "Checkout receipt"
+}
+
+type receiptField struct {
+	label string
+	value string
+}
+
+func receiptFields(receipt *dto.Receipt) []receiptField {
+	fields := []receiptField{
+		{"Date", formatTime(receipt.IssuedAt)},
+		{"Student", receipt.Student.Name},
+		{"Card", receipt.Student.CardID},
+		{"Cart", receipt.CartID.String()},
+	}
+	if receipt.Kiosk != "" {
+		fields = append(fields, receiptField{"Kiosk", receipt.Kiosk})
+	}
+	return fields
+}
+
+// returnBy is the earliest due date of the books, the date the student is
+// asked to bring them back by.
+func returnBy(receipt *dto.Receipt) time.Time {
+	var due time.Time
+	for _, item := range receipt.Items {
+		if due.IsZero() || item.DueDate.Before(due) {
+			due = item.DueDate
+		}
+	}
+	return due
+}
+
+func returnedDate(item dto.ReceiptItem) string {
+	if item.ReturnedDate == nil {
+		return ""
+	}
+	return formatDate(*item.ReturnedDate)
+}
+
+func daysLate(days int) string {
+	if days == 1 {
+		return "1 day late"
+	}
+	return fmt.Sprintf("%d days late", days)
+}
+
+// WriteText writes the receipt as plain text lines of at most TextWidth
+// characters, for thermal printers.
+func (t ReceiptTemplate) WriteText(w io.Writer, receipt *dto.Receipt) error {
+	width := t.TextWidth
+	bw := bufio.NewWriter(w)
+	line := func(s string) {
+		bw.WriteString(strings.TrimRight(s, " "))
+		bw.WriteByte('\n')
+	}
+	center := func(s string) {
+		for _, l := range wrap(s, float64(width), runeWidth) {
+			line(strings.Repeat(" ", (width-int(runeWidth(l)))/2) + l)
+		}
+	}
+	indented := func(indent, s string) {
+		for _, l := range wrap(s, float64(width-len(indent)), runeWidth) {
+			line(indent + l)
+		}
+	}
+
+	center(t.LibraryName)
+	center(strings.ToUpper(receiptTitle(receipt)))
+	line(strings.Repeat("=", width))
+	for _, field := range receiptFields(receipt) {
+		label := fmt.Sprintf("%-9s", field.label+":")
+		if len(label)+int(runeWidth(field.value)) > width {
+			line(field.label + ":")
+			indented("  ", field.value)
+			continue
+		}
+		line(label + field.value)
+	}
+	line(strings.Repeat("-", width))
+
+	for _, item := range receipt.Items {
+		indented("", item.Title)
+		if receipt.Kind == dto.ReceiptReturn {
+			returned := "  Returned: " + returnedDate(item)
+			if item.Condition != "" {
+				returned += " (" + item.Condition + ")"
+			}
+			line(returned)
+			if item.OverdueDays > 0 {
+				line("  " + daysLate(item.OverdueDays))
+			}
+			continue
+		}
+		line("  Due: " + formatDate(item.DueDate))
+	}
+
+	line(strings.Repeat("-", width))
+	line(fmt.Sprintf("Books: %d", len(receipt.Items)))
+	if receipt.Kind == dto.ReceiptCheckout && len(receipt.Items) > 0 {
+		indented("", "Please return by "+formatDate(returnBy(receipt))+".")
+	}
+
+	return bw.Flush()
+}
+
+const (
+	pdfMargin    = 50.0
+	pdfFontSize  = 10.0
+	pdfLeading   = 14.0
+	pdfLogoSize  = 48.0
+	pdfTitleSize = 14.0
+	pdfNameSize  = 18.0
+)
+
+// WritePDF writes the receipt as a PDF document on pages of the template's
+// paper size.
+func (t ReceiptTemplate) WritePDF(w io.Writer, receipt *dto.Receipt) error {
+	doc := pdf.New()
+	doc.Title = receiptTitle(receipt)
+	page := doc.AddPage(t.Paper)
+	left, right := pdfMargin, t.Paper.Width-pdfMargin
+	y := t.Paper.Height - pdfMargin
+
+	// The name of the library stands next to the logo, or alone at the
+	// margin when there is none.
+	nameX, headerHeight := left, pdfNameSize
+	if t.Logo != nil {
+		logo, err := doc.AddImage(t.Logo)
+		if err != nil {
+			return fmt.Errorf("failed to add logo: %w", err)
+		}
+		height := pdfLogoSize
+		width := height * float64(logo.Width()) / float64(logo.Height())
+		if width > 3*pdfLogoSize {
+			width = 3 * pdfLogoSize
+			height = width * float64(logo.Height()) / float64(logo.Width())
+		}
+		page.Image(logo, left, y-height, width, height)
+		nameX = left + width + 12
+		headerHeight = max(height, pdfNameSize)
+	}
+	page.Text(nameX, y-headerHeight/2-pdfNameSize/3, pdf.HelveticaBold, pdfNameSize, t.LibraryName)
+	y -= headerHeight + pdfLeading
+
+	y -= pdfTitleSize
+	page.Text(left, y, pdf.HelveticaBold, pdfTitleSize, receiptTitle(receipt))
+	y -= pdfLeading * 1.5
+
+	for _, field := range receiptFields(receipt) {
+		page.Text(left, y, pdf.HelveticaBold, pdfFontSize, field.label)
+		page.Text(left+60, y, pdf.Helvetica, pdfFontSize, field.value)
+		y -= pdfLeading
+	}
+	y -= pdfLeading
+
+	type column struct {
+		heading string
+		width   float64
+		value   func(dto.ReceiptItem) string
+	}
+	columns := []column{{heading: "Due date", width: 80, value: func(item dto.ReceiptItem) string {
+		return formatDate(item.DueDate)
+	}}}
+	if receipt.Kind == dto.ReceiptReturn {
+		columns = []column{
+			{heading: "Returned", width: 80, value: returnedDate},
+			{heading: "Condition", width: 70, value: func(item dto.ReceiptItem) string {
+				return item.Condition
+			}},
+			{heading: "Days late", width: 55, value: func(item dto.ReceiptItem) string {
+				return fmt.Sprint(item.OverdueDays)
+			}},
+		}
+	}
+	// The title takes the room the other columns leave.
+	titleWidth := right - left
+	for _, c := range columns {
+		titleWidth -= c.width
+	}
+
+	header := func() {
+		page.Text(left, y, pdf.HelveticaBold, pdfFontSize, "Title")
+		x := left + titleWidth
+		for _, c := range columns {
+			page.Text(x, y, pdf.HelveticaBold, pdfFontSize, c.heading)
+			x += c.width
+		}
+		page.Line(left, y-4, right, y-4, 0.5)
+		y -= pdfLeading + 2
+	}
+	header()
+
+	measure := func(s string) float64 { return pdf.Helvetica.Width(s, pdfFontSize) }
+	for _, item := range receipt.Items {
+		lines := wrap(item.Title, titleWidth-10, measure)
+		if y-float64(len(lines)-1)*pdfLeading < pdfMargin {
+			page = doc.AddPage(t.Paper)
+			y = t.Paper.Height - pdfMargin - pdfFontSize
+			header()
+		}
+		x := left + titleWidth
+		for _, c := range columns {
+			page.Text(x, y, pdf.Helvetica, pdfFontSize, c.value(item))
+			x += c.width
+		}
+		for _, l := range lines {
+			page.Text(left, y, pdf.Helvetica, pdfFontSize, l)
+			y -= pdfLeading
+		}
+	}
+
+	if y-2*pdfLeading < pdfMargin {
+		page = doc.AddPage(t.Paper)
+		y = t.Paper.Height - pdfMargin - pdfFontSize
+	} else {
+		page.Line(left, y+pdfLeading-4, right, y+pdfLeading-4, 0.5)
+		y -= 4
+	}
+	page.Text(left, y, pdf.Helvetica, pdfFontSize, fmt.Sprintf("Books: %d", len(receipt.Items)))
+	if receipt.Kind == dto.ReceiptCheckout && len(receipt.Items) > 0 {
+		y -= pdfLeading
+		page.Text(left, y, pdf.HelveticaBold, pdfFontSize, "Please return by "+formatDate(returnBy(receipt))+".")
+	}
+
+	_, err := doc.WriteTo(w)
+	return err
+}
@@ -0,0 +1,109 @@
+package printing
+
+import (
+	"bytes"
+	"image"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/pdf"
+)
+
+func testReceipt(kind string) *dto.Receipt {
+	rentedAt := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	returnedAt := time.Date(2025, 3, 18, 10, 0, 0, 0, time.UTC)
+	receipt := &dto.Receipt{
+		Kind:     kind,
+		CartID:   uuid.MustParse("0b6f3c52-6f0e-4a53-9a0c-2f1d3c4e5f60"),
+		Student:  dto.ReceiptStudent{Name: "Ada Lovelace", CardID: "C-100"},
+		IssuedAt: rentedAt,
+		Items: []dto.ReceiptItem{
+			{Title: "Dune", DueDate: rentedAt.AddDate(0, 0, 14)},
+			{Title: "The Hitchhiker's Guide to the Galaxy", DueDate: rentedAt.AddDate(0, 0, 14)},
+		},
+	}
+	if kind == dto.ReceiptReturn {
+		receipt.IssuedAt = returnedAt
+		for i := range receipt.Items {
+			receipt.Items[i].ReturnedDate = &returnedAt
+			receipt.Items[i].Condition = "good"
+			receipt.Items[i].OverdueDays = 3
+		}
+	}
+	return receipt
+}
+
+func TestWriteText(t *testing.T) {
+	template := ReceiptTemplate{LibraryName: "Springfield Library", TextWidth: 32}
+
+	t.Run("checkout", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := template.WriteText(&buf, testReceipt(dto.ReceiptCheckout)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := `      Springfield Library
+        CHECKOUT RECEIPT
+================================
+Date:    2025-03-01 09:30 UTC
+Student: Ada Lovelace
+Card:    C-100
+Cart:
+  0b6f3c52-6f0e-4a53-9a0c-2f1d3c
+  4e5f60
+--------------------------------
+Dune
+  Due: 2025-03-15
+The Hitchhiker's Guide to the
+Galaxy
+  Due: 2025-03-15
+--------------------------------
+Books: 2
+Please return by 2025-03-15.
+`
+		if got := buf.String(); got != want {
+			t.Errorf("unexpected receipt:\n%s\nwant:\n%s", got, want)
+		}
+	})
+
+	t.Run("return", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := template.WriteText(&buf, testReceipt(dto.ReceiptReturn)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got := buf.String()
+		for _, want := range []string{"RETURN RECEIPT", "  Returned: 2025-03-18 (good)\n  3 days late\n"} {
+			if !strings.Contains(got, want) {
+				t.Errorf("expected %q in receipt:\n%s", want, got)
+			}
+		}
+		if strings.Contains(got, "Please return") {
+			t.Errorf("expected no return date on a return receipt")
+		}
+	})
+}
+
+func TestWritePDF(t *testing.T) {
+	template := ReceiptTemplate{
+		LibraryName: "Springfield Library",
+		Logo:        image.NewGray(image.Rect(0, 0, 64, 32)),
+		Paper:       pdf.A4,
+	}
+
+	var buf bytes.Buffer
+	if err := template.WritePDF(&buf, testReceipt(dto.ReceiptCheckout)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{"(Springfield Library) Tj", "(Checkout receipt) Tj", "(Please return by 2025-03-15.) Tj", "/Im1 Do"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in the PDF", want)
+		}
+	}
+}
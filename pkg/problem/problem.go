@@ -0,0 +1,117 @@
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/logging"
+)
+
+const (
+	ContentType = "application/problem+json"
+
+	typePrefix = "urn:brs:problem:"
+
+	CodeBadRequest       = "bad_request"
+	CodeValidation       = "validation_failed"
+	CodeUnauthorized     = "unauthorized"
+	CodeForbidden        = "forbidden"
+	CodeNotFound         = "not_found"
+	CodeMethodNotAllowed = "method_not_allowed"
+	CodeConflict         = "conflict"
+	CodeUnprocessable    = "unprocessable_entity"
+	CodeInternal         = "internal_error"
+	CodeUnavailable      = "service_unavailable"
+)
+
+type Details struct {
+	Type      string                 `json:"type"`
+	Title     string                 `json:"title"`
+	Status    int                    `json:"status"`
+	Detail    string                 `json:"detail,omitempty"`
+	Instance  string                 `json:"instance,omitempty"`
+	Code      string                 `json:"code"`
+	RequestId string                 `json:"request_id,omitempty"`
+	Errors    []apperrors.FieldError `json:"errors,omitempty"`
+}
+
+func New(status int, code, detail string) *Details {
+	return &Details{
+		Type:   typePrefix + code,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+func FromStatus(status int, detail string) *Details {
+	return New(status, codeForStatus(status), detail)
+}
+
+func FromError(err error) *Details {
+	appErr, ok := apperrors.As(err)
+	if !ok {
+		return New(http.StatusInternalServerError, CodeInternal, "An unexpected error occurred")
+	}
+
+	p := New(statusForKind(appErr.Kind), appErr.Code, appErr.Message)
+	p.Errors = appErr.Fields
+	return p
+}
+
+func Write(w http.ResponseWriter, r *http.Request, p *Details) {
+	p.Instance = r.URL.Path
+	p.RequestId = logging.RequestID(r.Context())
+
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+func statusForKind(kind apperrors.Kind) int {
+	switch kind {
+	case apperrors.KindNotFound:
+		return http.StatusNotFound
+	case apperrors.KindConflict, apperrors.KindInsufficientStock:
+		return http.StatusConflict
+	case apperrors.KindRentalLimit:
+		return http.StatusUnprocessableEntity
+	case apperrors.KindValidation:
+		return http.StatusBadRequest
+	case apperrors.KindUnauthorized:
+		return http.StatusUnauthorized
+	case apperrors.KindTooManyRequests:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func codeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusUnprocessableEntity:
+		return CodeUnprocessable
+	case http.StatusServiceUnavailable:
+		return CodeUnavailable
+	default:
+		if status >= http.StatusInternalServerError {
+			return CodeInternal
+		}
+		return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+	}
+}
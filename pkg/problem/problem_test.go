@@ -0,0 +1,84 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/logging"
+)
+
+func TestFromError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"not found", apperrors.NotFound("book_not_found", "book not found"), http.StatusNotFound, "book_not_found"},
+		{"wrapped conflict", fmt.Errorf("create: %w", apperrors.Conflict("student_card_exists", "exists")), http.StatusConflict, "student_card_exists"},
+		{"insufficient stock", apperrors.InsufficientStock("none left"), http.StatusConflict, "insufficient_stock"},
+		{"rental limit", apperrors.RentalLimit("too many"), http.StatusUnprocessableEntity, "rental_limit_exceeded"},
+		{"validation", apperrors.Validation("invalid_id", "bad id"), http.StatusBadRequest, "invalid_id"},
+		{"unauthorized", apperrors.Unauthorized("invalid_credentials", "nope"), http.StatusUnauthorized, "invalid_credentials"},
+		{"too many requests", apperrors.TooManyRequests("pin_locked", "try later"), http.StatusTooManyRequests, "pin_locked"},
+		{"unknown", errors.New("disk on fire"), http.StatusInternalServerError, CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := FromError(tt.err)
+			if p.Status != tt.status {
+				t.Errorf("expected status %d, got %d", tt.status, p.Status)
+			}
+			if p.Code != tt.code {
+				t.Errorf("expected code %q, got %q", tt.code, p.Code)
+			}
+			if p.Type != typePrefix+tt.code {
+				t.Errorf("expected type %q, got %q", typePrefix+tt.code, p.Type)
+			}
+		})
+	}
+}
+
+func TestFromErrorHidesInternalDetails(t *testing.T) {
+	p := FromError(errors.New("sql: connection refused"))
+	if p.Detail != "An unexpected error occurred" {
+		t.Errorf("unexpected detail %q", p.Detail)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/books/123", nil)
+	req = req.WithContext(logging.WithRequestID(req.Context(), "req-1"))
+	w := httptest.NewRecorder()
+
+	p := New(http.StatusBadRequest, CodeValidation, "request body failed validation")
+	p.Errors = []apperrors.FieldError{{Field: "title", Code: "required", Message: "title is required"}}
+	Write(w, req, p)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != ContentType {
+		t.Errorf("expected content type %q, got %q", ContentType, ct)
+	}
+
+	var body Details
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if body.Instance != "/books/123" {
+		t.Errorf("expected instance %q, got %q", "/books/123", body.Instance)
+	}
+	if body.RequestId != "req-1" {
+		t.Errorf("expected request id %q, got %q", "req-1", body.RequestId)
+	}
+	if len(body.Errors) != 1 || body.Errors[0].Field != "title" {
+		t.Errorf("unexpected field errors %+v", body.Errors)
+	}
+}
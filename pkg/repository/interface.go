@@ -2,31 +2,47 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
 	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pagination"
 )
 
 type BookRepository interface {
 	Create(ctx context.Context, book *models.Book) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Book, error)
-	GetAll(ctx context.Context, params dto.PaginationParams) ([]*models.Book, int64, error)
+	GetAll(ctx context.Context, params dto.PaginationParams, filters dto.BookFilters) ([]*models.Book, int64, pagination.Links, error)
+	GetFacets(ctx context.Context, query string, filters dto.BookFilters) (*dto.BookFacets, error)
 	GetBooksByIDs(ctx context.Context, bookIDs []uuid.UUID) ([]*models.Book, error)
+	GetBooksByCodes(ctx context.Context, codes []string) ([]*models.Book, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	UpdateCount(ctx context.Context, bookID uuid.UUID, delta int) error
 	DecrementCount(ctx context.Context, bookID uuid.UUID) error
 	IncrementCount(ctx context.Context, bookID uuid.UUID) error
 	DecrementMultipleBooks(ctx context.Context, bookIDs []uuid.UUID) error
 	IncrementMultipleBooks(ctx context.Context, bookIDs []uuid.UUID) error
+	UpdateClassification(ctx context.Context, book *models.Book) error
+	GetTags(ctx context.Context) ([]dto.TagCount, error)
+}
+
+type CategoryRepository interface {
+	Create(ctx context.Context, category *models.Category) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Category, error)
+	GetAll(ctx context.Context) ([]*models.Category, error)
+	Update(ctx context.Context, category *models.Category) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetCounts(ctx context.Context) ([]dto.CategoryCount, error)
 }
 
 type StudentRepository interface {
 	Create(ctx context.Context, student *models.Student) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Student, error)
 	GetByCardID(ctx context.Context, cardID string) (*models.Student, error)
-	GetAll(ctx context.Context, offset, limit int) ([]*models.Student, int64, error)
+	GetStudentsByIDs(ctx context.Context, studentIDs []uuid.UUID) ([]*models.Student, error)
+	GetAll(ctx context.Context, params dto.PaginationParams, filters dto.StudentFilters) ([]*models.Student, int64, pagination.Links, error)
 	Update(ctx context.Context, student *models.Student) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -35,6 +51,8 @@ type LibrarianRepository interface {
 	Create(ctx context.Context, librarian *models.Librarian) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Librarian, error)
 	GetByUsername(ctx context.Context, username string) (*models.Librarian, error)
+	GetByOIDCSubject(ctx context.Context, subject string) (*models.Librarian, error)
+	UpdateRole(ctx context.Context, id uuid.UUID, role string) error
 }
 
 type CartRepository interface {
@@ -48,9 +66,18 @@ type CartRepository interface {
 type RentRepository interface {
 	Create(ctx context.Context, rent *models.Rent) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Rent, error)
-	GetRentsByFilters(ctx context.Context, filters dto.RentFilters) ([]*dto.RentSummary, int64, error)
+	GetRentsByFilters(ctx context.Context, filters dto.RentFilters) ([]*dto.RentSummary, int64, pagination.Links, error)
 	GetRentedBooksByStudent(ctx context.Context, studentCardID string) ([]*dto.RentSummary, error)
 	GetRentsByCartID(ctx context.Context, cartID uuid.UUID) ([]*models.Rent, error)
+	GetHistoryByStudent(ctx context.Context, studentID uuid.UUID, params dto.PaginationParams) ([]*dto.StudentRental, int64, pagination.Links, error)
+	GetLoansByStudent(ctx context.Context, studentID uuid.UUID) ([]*dto.StudentRental, error)
+	GetRentalsByCart(ctx context.Context, cartID uuid.UUID) ([]*dto.StudentRental, error)
+	GetStudentStats(ctx context.Context, studentID uuid.UUID, overduePeriod int, topN int) (*dto.StudentStats, error)
+}
+
+type ReturnRepository interface {
+	ReturnCart(ctx context.Context, cartID uuid.UUID, events []*models.ReturnEvent, bookIDs []uuid.UUID) error
+	GetByFilters(ctx context.Context, filters dto.ReturnFilters) ([]*dto.ReturnRecord, int64, pagination.Links, error)
 }
 
 type SessionRepository interface {
@@ -61,17 +88,76 @@ type SessionRepository interface {
 	DeleteByLibrarianID(ctx context.Context, librarianId uuid.UUID) error
 }
 
+// StudentAuthRepository stores the portal PINs, sessions and magic link
+// tokens of students.
+type StudentAuthRepository interface {
+	GetCredential(ctx context.Context, studentID uuid.UUID) (*models.StudentCredential, error)
+	SaveCredential(ctx context.Context, credential *models.StudentCredential) error
+	RecordFailedAttempt(ctx context.Context, studentID uuid.UUID, maxAttempts int, lockUntil time.Time) error
+	ResetFailedAttempts(ctx context.Context, studentID uuid.UUID) error
+	CreateSession(ctx context.Context, session *models.StudentSession) error
+	GetSession(ctx context.Context, sessionID string) (*models.StudentSession, error)
+	DeleteSession(ctx context.Context, sessionID string) error
+	DeleteSessionsByStudent(ctx context.Context, studentID uuid.UUID) error
+	CreateLoginToken(ctx context.Context, token *models.StudentLoginToken) error
+	UseLoginToken(ctx context.Context, tokenHash string) (*models.StudentLoginToken, error)
+	DeleteExpired(ctx context.Context) error
+}
+
 type ReportRepository interface {
-	GetOverdueRentals(ctx context.Context, studentCardID *string, limit, offset, overduePeriod int) ([]dto.OverdueUser, int64, error)
-	GetRentalReport(ctx context.Context, limit, offset, overduePeriod int) (*dto.RentReport, error)
+	GetOverdueRentals(ctx context.Context, studentCardID *string, params dto.PaginationParams, now time.Time, overduePeriod int) ([]dto.OverdueUser, int64, pagination.Links, error)
+	GetOverdueAging(ctx context.Context, studentCardID *string, buckets []dto.AgingBucket, now time.Time, overduePeriod int) ([]dto.AgingBucket, error)
+	GetRentalReport(ctx context.Context, limit, offset int, now time.Time, overduePeriod int) (*dto.RentReport, error)
+	GetCirculationStats(ctx context.Context, overduePeriod int) (*dto.CirculationStats, error)
+	GetCirculationSeries(ctx context.Context, from, to time.Time, interval, groupBy string, groupLimit, overduePeriod int) ([]*dto.AnalyticsRow, error)
+	RollupCirculation(ctx context.Context, through time.Time, overduePeriod int) error
+	GetCollectionReport(ctx context.Context, filters dto.CollectionFilters) ([]dto.CollectionItem, dto.CollectionSummary, int64, pagination.Links, error)
+	ListCollection(ctx context.Context, filters dto.CollectionFilters) ([]dto.CollectionItem, error)
+}
+
+type ReportScheduleRepository interface {
+	Create(ctx context.Context, schedule *models.ReportSchedule) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.ReportSchedule, error)
+	GetAll(ctx context.Context) ([]*models.ReportSchedule, error)
+	Update(ctx context.Context, schedule *models.ReportSchedule) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetDue(ctx context.Context, now time.Time) ([]*models.ReportSchedule, error)
+	Claim(ctx context.Context, id uuid.UUID, due, next, now time.Time) (bool, error)
+	CreateRun(ctx context.Context, run *models.ReportRun) error
+	FinishRun(ctx context.Context, run *models.ReportRun) error
+	GetRuns(ctx context.Context, scheduleID uuid.UUID, params dto.PaginationParams) ([]*models.ReportRun, int64, pagination.Links, error)
+	GetFile(ctx context.Context, runID uuid.UUID, format string) (*models.ReportFile, error)
+	DeleteRunsBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type KioskRepository interface {
+	Create(ctx context.Context, device *models.KioskDevice) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.KioskDevice, error)
+	GetAll(ctx context.Context) ([]*models.KioskDevice, error)
+	GetActiveByKeyHash(ctx context.Context, keyHash string) (*models.KioskDevice, error)
+	Revoke(ctx context.Context, id uuid.UUID, at time.Time) error
+	Touch(ctx context.Context, id uuid.UUID, at time.Time) error
+	CreateTransaction(ctx context.Context, transaction *models.KioskTransaction) error
+	GetTransactions(ctx context.Context, kioskID uuid.UUID, params dto.PaginationParams) ([]*models.KioskTransaction, int64, pagination.Links, error)
+}
+
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+	GetSchemaVersion(ctx context.Context) (int, error)
 }
 
 type Repository struct {
-	Book      BookRepository
-	Student   StudentRepository
-	Librarian LibrarianRepository
-	Cart      CartRepository
-	Rent      RentRepository
-	Session   SessionRepository
-	Report    ReportRepository
+	Book        BookRepository
+	Category    CategoryRepository
+	Student     StudentRepository
+	Librarian   LibrarianRepository
+	Cart        CartRepository
+	Rent        RentRepository
+	Return      ReturnRepository
+	Session     SessionRepository
+	StudentAuth StudentAuthRepository
+	Report      ReportRepository
+	Schedule    ReportScheduleRepository
+	Kiosk       KioskRepository
+	Health      HealthRepository
 }
@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"BRSBackend/pkg/apperrors"
 	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pagination"
 	"BRSBackend/pkg/repository"
 )
 
@@ -22,8 +25,23 @@ func NewBookRepository(db *gorm.DB) repository.BookRepository {
 	return &bookRepository{db: db}
 }
 
+// Create adds the book, with the next generated barcode when it has none.
 func (b *bookRepository) Create(ctx context.Context, book *models.Book) error {
-	if err := b.db.WithContext(ctx).Create(book).Error; err != nil {
+	err := b.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if book.Barcode == nil || strings.TrimSpace(*book.Barcode) == "" {
+			next, err := models.NextBookBarcode(tx)
+			if err != nil {
+				return fmt.Errorf("failed to generate barcode: %w", err)
+			}
+			barcode := models.BookBarcode(next)
+			book.Barcode = &barcode
+		}
+		return tx.Create(book).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return apperrors.Conflict("book_exists", "book already exists").Wrap(err)
+		}
 		return fmt.Errorf("failed to create book: %w", err)
 	}
 
@@ -34,7 +52,7 @@ func (b *bookRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Boo
 	var book models.Book
 	if err := b.db.WithContext(ctx).Where("id = ?", id).First(&book).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("book not found")
+			return nil, apperrors.NotFound("book_not_found", "book not found")
 		}
 		return nil, fmt.Errorf("failed to get book: %w", err)
 	}
@@ -42,34 +60,123 @@ func (b *bookRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Boo
 	return &book, nil
 }
 
-func (b *bookRepository) GetAll(ctx context.Context, params dto.PaginationParams) ([]*models.Book, int64, error) {
+var bookSortFields = pagination.Fields{
+	"title":       {Column: "title", Kind: pagination.String},
+	"count":       {Column: "count", Kind: pagination.Int},
+	"created_at":  {Column: "created_at", Kind: pagination.Time},
+	"call_number": {Column: "COALESCE(call_number, '')", Kind: pagination.String},
+}
+
+func bookSearch(query string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if query == "" {
+			return db
+		}
+		if id, err := uuid.Parse(query); err == nil {
+			return db.Where("id = ?", id)
+		}
+		search := fmt.Sprintf("%%%s%%", strings.ToLower(strings.Trim(query, `"`)))
+		return db.Where("LOWER(title) LIKE ?", search)
+	}
+}
+
+func bookFilters(filters dto.BookFilters, withAvailability bool) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if withAvailability {
+			switch filters.Availability {
+			case dto.AvailabilityAvailable:
+				db = db.Where("count > 0")
+			case dto.AvailabilityOutOfStock:
+				db = db.Where("count <= 0")
+			}
+		}
+		if filters.MinCount != nil {
+			db = db.Where("count >= ?", *filters.MinCount)
+		}
+		if filters.MaxCount != nil {
+			db = db.Where("count <= ?", *filters.MaxCount)
+		}
+		if filters.AddedFrom != nil {
+			db = db.Where("created_at >= ?", filters.AddedFrom.Truncate(24*time.Hour))
+		}
+		if filters.AddedTo != nil {
+			db = db.Where("created_at < ?", filters.AddedTo.Truncate(24*time.Hour).Add(24*time.Hour))
+		}
+		if filters.CategoryID != nil {
+			db = db.Where(`category_id IN (
+				WITH RECURSIVE subtree(id) AS (
+					SELECT ?
+					UNION ALL
+					SELECT categories.id FROM categories JOIN subtree ON categories.parent_id = subtree.id
+				)
+				SELECT id FROM subtree
+			)`, *filters.CategoryID)
+		}
+		for _, tag := range models.NormalizeTags(filters.Tags) {
+			db = db.Where("EXISTS (SELECT 1 FROM json_each(books.tags) WHERE json_each.value = ?)", tag)
+		}
+		return db
+	}
+}
+
+func (b *bookRepository) GetAll(ctx context.Context, params dto.PaginationParams, filters dto.BookFilters) ([]*models.Book, int64, pagination.Links, error) {
 	var books []*models.Book
 	var total int64
 
-	query := b.db.WithContext(ctx).Model(&models.Book{})
+	page, err := pagination.New(params.Sort, params.Cursor, params.Limit, params.Offset,
+		bookSortFields, pagination.Sort{Key: "created_at", Desc: true}, "id")
+	if err != nil {
+		return nil, 0, pagination.Links{}, err
+	}
 
-	if params.Query != "" {
-		if id, err := uuid.Parse(params.Query); err == nil {
-			query = query.Where("id = ?", id)
-		} else {
-			search := fmt.Sprintf("%%%s%%", strings.ToLower(strings.Trim(params.Query, `"`)))
-			query = query.Where("LOWER(title) LIKE ?", search)
-		}
+	query := b.db.WithContext(ctx).Model(&models.Book{}).
+		Scopes(bookSearch(params.Query), bookFilters(filters, true))
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to count books: %w", err)
 	}
 
-	if err := query.Count(&total).Error; errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, 0, fmt.Errorf("book not found")
+	if err := query.Scopes(page.Scope).Find(&books).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to get books: %w", err)
 	}
 
-	if err := query.
-		Order("created_at DESC").
-		Offset(params.Offset).
-		Limit(params.Limit).
-		Find(&books).Error; err != nil {
-		return nil, 0, fmt.Errorf("failed to get books: %w", err)
+	books, links := pagination.Window(page, books, func(book *models.Book) (any, string) {
+		return bookSortValue(book, page.Sort.Key), book.Id.String()
+	})
+	return books, total, links, nil
+}
+
+func (b *bookRepository) GetFacets(ctx context.Context, query string, filters dto.BookFilters) (*dto.BookFacets, error) {
+	var facets dto.BookFacets
+
+	if err := b.db.WithContext(ctx).
+		Model(&models.Book{}).
+		Scopes(bookSearch(query), bookFilters(filters, false)).
+		Select(`
+			COALESCE(SUM(CASE WHEN count > 0 THEN 1 ELSE 0 END), 0) as available,
+			COALESCE(SUM(CASE WHEN count <= 0 THEN 1 ELSE 0 END), 0) as out_of_stock
+		`).
+		Scan(&facets).Error; err != nil {
+		return nil, fmt.Errorf("failed to get book facets: %w", err)
 	}
 
-	return books, total, nil
+	return &facets, nil
+}
+
+func bookSortValue(book *models.Book, key string) any {
+	switch key {
+	case "title":
+		return book.Title
+	case "count":
+		return book.Count
+	case "call_number":
+		if book.CallNumber == nil {
+			return ""
+		}
+		return *book.CallNumber
+	default:
+		return book.CreatedAt
+	}
 }
 
 func (b *bookRepository) GetBooksByIDs(ctx context.Context, bookIDs []uuid.UUID) ([]*models.Book, error) {
@@ -77,6 +184,23 @@ func (b *bookRepository) GetBooksByIDs(ctx context.Context, bookIDs []uuid.UUID)
 	return books, b.db.WithContext(ctx).Where("id IN ?", bookIDs).Find(&books).Error
 }
 
+// GetBooksByCodes returns the books whose barcode or normalized ISBN is one of
+// codes.
+func (b *bookRepository) GetBooksByCodes(ctx context.Context, codes []string) ([]*models.Book, error) {
+	barcodes := make([]string, len(codes))
+	isbns := make([]string, len(codes))
+	for i, code := range codes {
+		barcodes[i] = strings.TrimSpace(code)
+		isbns[i] = models.NormalizeISBN(code)
+	}
+
+	var books []*models.Book
+	if err := b.db.WithContext(ctx).Where("barcode IN ? OR isbn IN ?", barcodes, isbns).Find(&books).Error; err != nil {
+		return nil, fmt.Errorf("failed to get books by code: %w", err)
+	}
+	return books, nil
+}
+
 func (b *bookRepository) UpdateCount(ctx context.Context, bookID uuid.UUID, delta int) error {
 
 	tx := b.db.WithContext(ctx).Begin()
@@ -90,7 +214,7 @@ func (b *bookRepository) UpdateCount(ctx context.Context, bookID uuid.UUID, delt
 	if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("id = ?", bookID).First(&book).Error; err != nil {
 		tx.Rollback()
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("book not found")
+			return apperrors.NotFound("book_not_found", "book not found")
 		}
 		return fmt.Errorf("failed to get book: %w", err)
 	}
@@ -98,7 +222,7 @@ func (b *bookRepository) UpdateCount(ctx context.Context, bookID uuid.UUID, delt
 	newCount := book.Count + delta
 	if newCount < 0 {
 		tx.Rollback()
-		return fmt.Errorf("insufficient book count: current=%d, requested=%d", book.Count, -delta)
+		return apperrors.InsufficientStock("insufficient book count: current=%d, requested=%d", book.Count, -delta)
 	}
 
 	if err := tx.Model(&book).Update("count", newCount).Error; err != nil {
@@ -135,7 +259,7 @@ func (b *bookRepository) DecrementMultipleBooks(ctx context.Context, bookIDs []u
 		if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("id = ?", bookID).First(&book).Error; err != nil {
 			tx.Rollback()
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return fmt.Errorf("book not found: %s", bookID)
+				return apperrors.NotFound("book_not_found", "book not found: %s", bookID)
 			}
 			return fmt.Errorf("failed to get book %s: %w", bookID, err)
 		}
@@ -143,7 +267,7 @@ func (b *bookRepository) DecrementMultipleBooks(ctx context.Context, bookIDs []u
 		newCount := book.Count - count
 		if newCount < 0 {
 			tx.Rollback()
-			return fmt.Errorf("insufficient book count for '%s': current=%d, requested=%d", book.Title, book.Count, count)
+			return apperrors.InsufficientStock("insufficient book count for '%s': current=%d, requested=%d", book.Title, book.Count, count)
 		}
 
 		if err := tx.Model(&book).Update("count", newCount).Error; err != nil {
@@ -181,5 +305,41 @@ func (b *bookRepository) IncrementMultipleBooks(ctx context.Context, bookIDs []u
 }
 
 func (b *bookRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return b.db.WithContext(ctx).Where("id = ?", id).Delete(&models.Book{}).Error
+	result := b.db.WithContext(ctx).Where("id = ?", id).Delete(&models.Book{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete book: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("book_not_found", "book not found")
+	}
+	return nil
+}
+
+// UpdateClassification sets the category, call number and tags of the book.
+func (b *bookRepository) UpdateClassification(ctx context.Context, book *models.Book) error {
+	result := b.db.WithContext(ctx).Model(book).Where("id = ?", book.Id).
+		Select("category_id", "call_number", "tags").
+		Updates(book)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update book classification: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("book_not_found", "book not found")
+	}
+	return nil
+}
+
+// GetTags counts the books with each tag, most used first.
+func (b *bookRepository) GetTags(ctx context.Context) ([]dto.TagCount, error) {
+	var tags []dto.TagCount
+	if err := b.db.WithContext(ctx).
+		Table("books, json_each(books.tags)").
+		Where("books.deleted_at IS NULL").
+		Select("json_each.value as tag, COUNT(*) as books").
+		Group("json_each.value").
+		Order("books DESC, tag").
+		Scan(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	return tags, nil
 }
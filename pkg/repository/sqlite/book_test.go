@@ -0,0 +1,132 @@
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository/sqlite"
+)
+
+func TestCreateBookBarcodes(t *testing.T) {
+	db := openDatabase(t)
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo := sqlite.NewRepository(db.DB, clock.System{})
+
+	const creates = 20
+	books := make([]*models.Book, creates)
+	errs := make([]error, creates)
+	var wg sync.WaitGroup
+	for i := range creates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			books[i] = &models.Book{Title: fmt.Sprintf("Book %d", i), Count: 1}
+			errs[i] = repo.Book.Create(context.Background(), books[i])
+		}()
+	}
+	wg.Wait()
+
+	seen := make(map[string]bool, creates)
+	for i, book := range books {
+		if errs[i] != nil {
+			t.Fatalf("create %d failed: %v", i, errs[i])
+		}
+		if seen[*book.Barcode] {
+			t.Errorf("barcode %s was given twice", *book.Barcode)
+		}
+		seen[*book.Barcode] = true
+	}
+	for n := 1; n <= creates; n++ {
+		if !seen[models.BookBarcode(n)] {
+			t.Errorf("expected barcode %s to be given", models.BookBarcode(n))
+		}
+	}
+}
+
+func TestBookBarcodeSequence(t *testing.T) {
+	db := openDatabase(t)
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo := sqlite.NewRepository(db.DB, clock.System{})
+	ctx := context.Background()
+
+	// Barcodes in other forms, like EAN-8 labels, leave the sequence alone.
+	for _, barcode := range []string{"96385074", "99999999", "BRS-OLD"} {
+		if err := repo.Book.Create(ctx, &models.Book{Title: barcode, Barcode: &barcode}); err != nil {
+			t.Fatalf("failed to create book %s: %v", barcode, err)
+		}
+	}
+	book := &models.Book{Title: "Generated", Count: 1}
+	if err := repo.Book.Create(ctx, book); err != nil || *book.Barcode != "BRS00000001" {
+		t.Fatalf("expected barcode BRS00000001, got %v, %v", book.Barcode, err)
+	}
+
+	// Restarting does not move the sequence back, even when the highest
+	// generated barcode belongs to a deleted book.
+	if err := repo.Book.Delete(ctx, book.Id); err != nil {
+		t.Fatalf("failed to delete book: %v", err)
+	}
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate again: %v", err)
+	}
+	book = &models.Book{Title: "Generated", Count: 1}
+	if err := repo.Book.Create(ctx, book); err != nil || *book.Barcode != "BRS00000002" {
+		t.Errorf("expected barcode BRS00000002, got %v, %v", book.Barcode, err)
+	}
+}
+
+type baselineBook struct {
+	gorm.Model
+	Id          uuid.UUID `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())"`
+	Title       string    `gorm:"type:varchar(255);not null"`
+	Description string    `gorm:"type:text;not null"`
+	Count       int       `gorm:"type:int;not null"`
+}
+
+func (baselineBook) TableName() string { return "books" }
+
+func TestAutoMigrateBookBarcodes(t *testing.T) {
+	db := openDatabase(t)
+	if err := db.DB.AutoMigrate(&baselineBook{}); err != nil {
+		t.Fatalf("failed to create baseline tables: %v", err)
+	}
+	books := []baselineBook{
+		{Id: uuid.New(), Title: "Dune", Count: 2},
+		{Id: uuid.New(), Title: "Emma", Count: 1},
+	}
+	for _, book := range books {
+		if err := db.DB.Create(&book).Error; err != nil {
+			t.Fatalf("failed to seed books: %v", err)
+		}
+	}
+
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	repo := sqlite.NewRepository(db.DB, clock.System{})
+	ctx := context.Background()
+	for i, book := range books {
+		migrated, err := repo.Book.GetByID(ctx, book.Id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := models.BookBarcode(i + 1); migrated.Barcode == nil || *migrated.Barcode != want {
+			t.Errorf("expected %s to get barcode %s, got %v", book.Title, want, migrated.Barcode)
+		}
+	}
+	book := &models.Book{Title: "Generated", Count: 1}
+	if err := repo.Book.Create(ctx, book); err != nil || *book.Barcode != models.BookBarcode(len(books)+1) {
+		t.Errorf("expected barcode %s, got %v, %v", models.BookBarcode(len(books)+1), book.Barcode, err)
+	}
+}
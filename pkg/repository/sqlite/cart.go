@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"BRSBackend/pkg/apperrors"
 	"BRSBackend/pkg/models"
 	"BRSBackend/pkg/repository"
 )
@@ -32,7 +33,7 @@ func (c cartRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Cart
 	var cart models.Cart
 	if err := c.db.WithContext(ctx).Where("id = ?", id).First(&cart).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("cart not found")
+			return nil, apperrors.NotFound("cart_not_found", "cart not found")
 		}
 		return nil, fmt.Errorf("failed to find cart: %w", err)
 	}
@@ -44,7 +45,7 @@ func (c cartRepository) GetByStatus(ctx context.Context, status string) ([]*mode
 	var carts []*models.Cart
 	if err := c.db.WithContext(ctx).Where("status = ?", status).Find(&carts).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("cart not found")
+			return nil, apperrors.NotFound("cart_not_found", "cart not found")
 		}
 	}
 	return carts, nil
@@ -0,0 +1,140 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+type categoryRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) repository.CategoryRepository {
+	return &categoryRepository{db: db}
+}
+
+// checkName fails when another child of the category's parent has its name,
+// ignoring case.
+func checkName(tx *gorm.DB, category *models.Category) error {
+	var count int64
+	if err := tx.Model(&models.Category{}).
+		Where("parent_id IS ? AND LOWER(name) = LOWER(?) AND id <> ?", category.ParentId, category.Name, category.Id).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check category name: %w", err)
+	}
+	if count > 0 {
+		return apperrors.Conflict("category_exists", "category %q already exists there", category.Name)
+	}
+	return nil
+}
+
+func (c categoryRepository) Create(ctx context.Context, category *models.Category) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkName(tx, category); err != nil {
+			return err
+		}
+		if err := tx.Create(category).Error; err != nil {
+			return fmt.Errorf("failed to create category: %w", err)
+		}
+		return nil
+	})
+}
+
+func (c categoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Category, error) {
+	var category models.Category
+	if err := c.db.WithContext(ctx).Where("id = ?", id).First(&category).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("category_not_found", "category not found")
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	return &category, nil
+}
+
+func (c categoryRepository) GetAll(ctx context.Context) ([]*models.Category, error) {
+	var categories []*models.Category
+	if err := c.db.WithContext(ctx).Order("name, id").Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	return categories, nil
+}
+
+func (c categoryRepository) Update(ctx context.Context, category *models.Category) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkName(tx, category); err != nil {
+			return err
+		}
+		result := tx.Model(category).Where("id = ?", category.Id).
+			Select("name", "parent_id").
+			Updates(category)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update category: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.NotFound("category_not_found", "category not found")
+		}
+		return nil
+	})
+}
+
+// Delete removes a category that has neither subcategories nor books.
+func (c categoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var children, books int64
+		if err := tx.Model(&models.Category{}).Where("parent_id = ?", id).Count(&children).Error; err != nil {
+			return fmt.Errorf("failed to count subcategories: %w", err)
+		}
+		if err := tx.Model(&models.Book{}).Where("category_id = ?", id).Count(&books).Error; err != nil {
+			return fmt.Errorf("failed to count books: %w", err)
+		}
+		if children > 0 || books > 0 {
+			return apperrors.Conflict("category_not_empty", "category has %d subcategories and %d books", children, books)
+		}
+
+		result := tx.Where("id = ?", id).Delete(&models.Category{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete category: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.NotFound("category_not_found", "category not found")
+		}
+		return nil
+	})
+}
+
+// GetCounts counts the books of each category that has any. The copies of a
+// book are those on the shelf and those out on loan.
+func (c categoryRepository) GetCounts(ctx context.Context) ([]dto.CategoryCount, error) {
+	out := c.db.
+		Table("rents").
+		Joins("JOIN carts ON rents.cart_id = carts.id").
+		Where("rents.deleted_at IS NULL AND carts.deleted_at IS NULL AND carts.status = ?", "RENTED").
+		Select("rents.book_id, COUNT(*) as out_now").
+		Group("rents.book_id")
+
+	var counts []dto.CategoryCount
+	if err := c.db.WithContext(ctx).
+		Model(&models.Book{}).
+		Joins("LEFT JOIN (?) as out ON out.book_id = books.id", out).
+		Where("books.category_id IS NOT NULL").
+		Select(`
+			books.category_id,
+			COUNT(*) as books,
+			COALESCE(SUM(books.count + COALESCE(out.out_now, 0)), 0) as copies,
+			COALESCE(SUM(books.count), 0) as available
+		`).
+		Group("books.category_id").
+		Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count books by category: %w", err)
+	}
+	return counts, nil
+}
@@ -0,0 +1,43 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+type healthRepository struct {
+	db *gorm.DB
+}
+
+func NewHealthRepository(db *gorm.DB) repository.HealthRepository {
+	return &healthRepository{db: db}
+}
+
+func (h *healthRepository) Ping(ctx context.Context) error {
+	sqlDB, err := h.db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+
+	return sqlDB.PingContext(ctx)
+}
+
+func (h *healthRepository) GetSchemaVersion(ctx context.Context) (int, error) {
+	var version *int
+	if err := h.db.WithContext(ctx).
+		Model(&models.SchemaMigration{}).
+		Select("MAX(version)").
+		Scan(&version).Error; err != nil {
+		return 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	if version == nil {
+		return 0, nil
+	}
+
+	return *version, nil
+}
@@ -0,0 +1,124 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pagination"
+	"BRSBackend/pkg/repository"
+)
+
+type kioskRepository struct {
+	db *gorm.DB
+}
+
+func NewKioskRepository(db *gorm.DB) repository.KioskRepository {
+	return &kioskRepository{db: db}
+}
+
+func (r kioskRepository) Create(ctx context.Context, device *models.KioskDevice) error {
+	if err := r.db.WithContext(ctx).Create(device).Error; err != nil {
+		return fmt.Errorf("failed to register kiosk: %w", err)
+	}
+	return nil
+}
+
+func (r kioskRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.KioskDevice, error) {
+	var device models.KioskDevice
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&device).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("kiosk_not_found", "kiosk not found")
+		}
+		return nil, fmt.Errorf("failed to get kiosk: %w", err)
+	}
+	return &device, nil
+}
+
+func (r kioskRepository) GetAll(ctx context.Context) ([]*models.KioskDevice, error) {
+	var devices []*models.KioskDevice
+	if err := r.db.WithContext(ctx).Order("name, id").Find(&devices).Error; err != nil {
+		return nil, fmt.Errorf("failed to list kiosks: %w", err)
+	}
+	return devices, nil
+}
+
+func (r kioskRepository) GetActiveByKeyHash(ctx context.Context, keyHash string) (*models.KioskDevice, error) {
+	var device models.KioskDevice
+	err := r.db.WithContext(ctx).
+		Where("key_hash = ? AND revoked_at IS NULL", keyHash).
+		First(&device).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.Unauthorized("invalid_kiosk_key", "invalid or revoked kiosk key")
+		}
+		return nil, fmt.Errorf("failed to get kiosk: %w", err)
+	}
+	return &device, nil
+}
+
+func (r kioskRepository) Revoke(ctx context.Context, id uuid.UUID, at time.Time) error {
+	result := r.db.WithContext(ctx).Model(&models.KioskDevice{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at.UTC())
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke kiosk: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("kiosk_not_found", "kiosk not found or already revoked")
+	}
+	return nil
+}
+
+func (r kioskRepository) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	err := r.db.WithContext(ctx).Model(&models.KioskDevice{}).
+		Where("id = ?", id).
+		Update("last_seen_at", at.UTC()).Error
+	if err != nil {
+		return fmt.Errorf("failed to update kiosk: %w", err)
+	}
+	return nil
+}
+
+func (r kioskRepository) CreateTransaction(ctx context.Context, transaction *models.KioskTransaction) error {
+	if err := r.db.WithContext(ctx).Create(transaction).Error; err != nil {
+		return fmt.Errorf("failed to record kiosk transaction: %w", err)
+	}
+	return nil
+}
+
+var kioskTransactionSortFields = pagination.Fields{
+	"created_at": {Column: "kiosk_transactions.created_at", Kind: pagination.Time},
+}
+
+func (r kioskRepository) GetTransactions(ctx context.Context, kioskID uuid.UUID, params dto.PaginationParams) ([]*models.KioskTransaction, int64, pagination.Links, error) {
+	var transactions []*models.KioskTransaction
+	var total int64
+
+	page, err := pagination.New(params.Sort, params.Cursor, params.Limit, params.Offset,
+		kioskTransactionSortFields, pagination.Sort{Key: "created_at", Desc: true}, "kiosk_transactions.id")
+	if err != nil {
+		return nil, 0, pagination.Links{}, err
+	}
+
+	query := r.db.WithContext(ctx).Model(&models.KioskTransaction{}).Where("kiosk_id = ?", kioskID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to count kiosk transactions: %w", err)
+	}
+
+	if err := query.Scopes(page.Scope).Find(&transactions).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to get kiosk transactions: %w", err)
+	}
+
+	transactions, links := pagination.Window(page, transactions, func(transaction *models.KioskTransaction) (any, string) {
+		return transaction.CreatedAt, transaction.Id.String()
+	})
+	return transactions, total, links, nil
+}
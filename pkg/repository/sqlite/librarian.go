@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"BRSBackend/pkg/apperrors"
 	"BRSBackend/pkg/models"
 	"BRSBackend/pkg/repository"
 )
@@ -22,6 +23,9 @@ func NewLibrarianRepository(db *gorm.DB) repository.LibrarianRepository {
 
 func (l *librarianRepository) Create(ctx context.Context, librarian *models.Librarian) error {
 	if err := l.db.WithContext(ctx).Create(librarian).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return apperrors.Conflict("librarian_exists", "librarian already exists").Wrap(err)
+		}
 		return fmt.Errorf("failed to create librarian: %w", err)
 	}
 	return nil
@@ -31,7 +35,7 @@ func (l *librarianRepository) GetByID(ctx context.Context, id uuid.UUID) (*model
 	var librarian models.Librarian
 	if err := l.db.WithContext(ctx).Where("id = ?", id).First(&librarian).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("librarian not found")
+			return nil, apperrors.NotFound("librarian_not_found", "librarian not found")
 		}
 		return nil, fmt.Errorf("failed to get librarian by id: %w", err)
 	}
@@ -45,3 +49,23 @@ func (l *librarianRepository) GetByUsername(ctx context.Context, username string
 	}
 	return &librarian, nil
 }
+
+func (l *librarianRepository) GetByOIDCSubject(ctx context.Context, subject string) (*models.Librarian, error) {
+	var librarian models.Librarian
+	if err := l.db.WithContext(ctx).Where("oidc_subject = ?", subject).First(&librarian).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("librarian_not_found", "librarian not found")
+		}
+		return nil, fmt.Errorf("failed to get librarian by subject: %w", err)
+	}
+	return &librarian, nil
+}
+
+func (l *librarianRepository) UpdateRole(ctx context.Context, id uuid.UUID, role string) error {
+	if err := l.db.WithContext(ctx).Model(&models.Librarian{}).
+		Where("id = ?", id).
+		Update("role", role).Error; err != nil {
+		return fmt.Errorf("failed to update librarian role: %w", err)
+	}
+	return nil
+}
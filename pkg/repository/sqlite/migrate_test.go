@@ -0,0 +1,91 @@
+package sqlite_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/config"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/repository/sqlite"
+)
+
+// openDatabase opens an empty database file without migrating it.
+func openDatabase(t *testing.T) *config.Database {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	db, err := config.NewDatabase(config.DatabaseConfig{
+		DSN:      filepath.Join(t.TempDir(), "brs.sqlite"),
+		LogLevel: "silent",
+	}, logger, clock.System{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	return db
+}
+
+// Tables as the first release created them, before schema_migrations.
+type baselineStudent struct {
+	gorm.Model
+	Id        uuid.UUID `gorm:"primaryKey;type:uuid;default:(gen_random_uuid())"`
+	FirstName string    `gorm:"type:varchar(255);not null"`
+	LastName  string    `gorm:"type:varchar(255);not null"`
+	CardId    string    `gorm:"type:varchar(255);not null"`
+	Major     string    `gorm:"type:varchar(255);not null"`
+	Phone     string    `gorm:"type:varchar(255);not null"`
+}
+
+func (baselineStudent) TableName() string { return "students" }
+
+func TestAutoMigrateBaseline(t *testing.T) {
+	db := openDatabase(t)
+	if err := db.DB.AutoMigrate(&baselineStudent{}); err != nil {
+		t.Fatalf("failed to create baseline tables: %v", err)
+	}
+	students := []baselineStudent{
+		{Id: uuid.New(), FirstName: "María", LastName: "García", CardId: "C-100", Major: "Physics", Phone: "+1 (555) 010-2030"},
+		{Id: uuid.New(), FirstName: "John", LastName: "Smith", CardId: "C-200", Major: "History", Phone: "555 0199"},
+	}
+	if err := db.DB.Create(&students).Error; err != nil {
+		t.Fatalf("failed to seed students: %v", err)
+	}
+
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	repo := sqlite.NewRepository(db.DB, clock.System{})
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		query   string
+		filters dto.StudentFilters
+		want    string
+	}{
+		{"folded name", "garcia", dto.StudentFilters{}, "C-100"},
+		{"several words", "maria garc", dto.StudentFilters{}, "C-100"},
+		{"card id", "c-2", dto.StudentFilters{}, "C-200"},
+		{"phone suffix", "2030", dto.StudentFilters{}, "C-100"},
+		{"major", "", dto.StudentFilters{Major: "history"}, "C-200"},
+	}
+	for _, tt := range tests {
+		found, total, _, err := repo.Student.GetAll(ctx, dto.PaginationParams{Query: tt.query, Limit: 10}, tt.filters)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if total != 1 || len(found) != 1 || found[0].CardId != tt.want {
+			t.Errorf("%s: expected %s, got %d students", tt.name, tt.want, total)
+		}
+	}
+}
@@ -10,17 +10,21 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
 	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pagination"
 	"BRSBackend/pkg/repository"
 )
 
 type rentRepository struct {
-	db *gorm.DB
+	db    *gorm.DB
+	clock clock.Clock
 }
 
-func NewRentRepository(db *gorm.DB) repository.RentRepository {
-	return &rentRepository{db: db}
+func NewRentRepository(db *gorm.DB, clock clock.Clock) repository.RentRepository {
+	return &rentRepository{db: db, clock: clock}
 }
 
 func (r rentRepository) Create(ctx context.Context, rent *models.Rent) error {
@@ -36,7 +40,7 @@ func (r rentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Rent
 	var rent models.Rent
 	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&rent).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("rent not found")
+			return nil, apperrors.NotFound("rent_not_found", "rent not found")
 		}
 		return nil, fmt.Errorf("failed to get rent: %w", err)
 	}
@@ -53,10 +57,22 @@ func (r rentRepository) GetByCartId(ctx context.Context, cartID uuid.UUID) ([]*m
 	return rents, nil
 }
 
-func (r rentRepository) GetRentsByFilters(ctx context.Context, filters dto.RentFilters) ([]*dto.RentSummary, int64, error) {
+var rentSortFields = pagination.Fields{
+	"title":      {Column: "books.title", Kind: pagination.String},
+	"name":       {Column: "students.first_name || ' ' || students.last_name", Kind: pagination.String},
+	"created_at": {Column: "carts.created_at", Kind: pagination.Time},
+}
+
+func (r rentRepository) GetRentsByFilters(ctx context.Context, filters dto.RentFilters) ([]*dto.RentSummary, int64, pagination.Links, error) {
 	var results []*dto.RentSummary
 	var total int64
 
+	page, err := pagination.New(filters.Sort, filters.Cursor, filters.Limit, filters.Offset,
+		rentSortFields, pagination.Sort{Key: "created_at", Desc: true}, "rents.id")
+	if err != nil {
+		return nil, 0, pagination.Links{}, err
+	}
+
 	query := r.db.WithContext(ctx).
 		Table("rents").
 		Select(`
@@ -107,21 +123,28 @@ func (r rentRepository) GetRentsByFilters(ctx context.Context, filters dto.RentF
 	}
 
 	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, fmt.Errorf("failed to get total rents by filters: %w", err)
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to get total rents by filters: %w", err)
 	}
 
-	if filters.Limit > 0 {
-		query = query.Limit(filters.Limit)
-	}
-	if filters.Offset > 0 {
-		query = query.Offset(filters.Offset)
+	if err := query.Scopes(page.Scope).Find(&results).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to get rents by filters: %w", err)
 	}
 
-	if err := query.Find(&results).Error; err != nil {
-		return nil, 0, fmt.Errorf("failed to get rents by filters: %w", err)
-	}
+	results, links := pagination.Window(page, results, func(rent *dto.RentSummary) (any, string) {
+		return rentSortValue(rent, page.Sort.Key), rent.RentID.String()
+	})
+	return results, total, links, nil
+}
 
-	return results, total, nil
+func rentSortValue(rent *dto.RentSummary, key string) any {
+	switch key {
+	case "title":
+		return rent.BookTitle
+	case "name":
+		return rent.StudentName
+	default:
+		return rent.RentedDate
+	}
 }
 
 func (r rentRepository) GetRentedBooksByStudent(ctx context.Context, studentCardID string) ([]*dto.RentSummary, error) {
@@ -161,3 +184,163 @@ func (r rentRepository) GetRentsByCartID(ctx context.Context, cartID uuid.UUID)
 
 	return rents, nil
 }
+
+var studentRentalSortFields = pagination.Fields{
+	"title":      {Column: "books.title", Kind: pagination.String},
+	"created_at": {Column: "carts.created_at", Kind: pagination.Time},
+}
+
+// studentRentalRow carries the cart's last update as the return date of
+// carts returned before return events were recorded.
+type studentRentalRow struct {
+	dto.StudentRental
+	CartUpdatedAt time.Time
+}
+
+func (r rentRepository) GetHistoryByStudent(ctx context.Context, studentID uuid.UUID, params dto.PaginationParams) ([]*dto.StudentRental, int64, pagination.Links, error) {
+	var rows []*studentRentalRow
+	var total int64
+
+	page, err := pagination.New(params.Sort, params.Cursor, params.Limit, params.Offset,
+		studentRentalSortFields, pagination.Sort{Key: "created_at", Desc: true}, "rents.id")
+	if err != nil {
+		return nil, 0, pagination.Links{}, err
+	}
+
+	query := r.studentRentals(ctx, studentID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to count student history: %w", err)
+	}
+
+	if err := query.Scopes(page.Scope).Find(&rows).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to get student history: %w", err)
+	}
+
+	results := studentRentalResults(rows)
+	results, links := pagination.Window(page, results, func(rental *dto.StudentRental) (any, string) {
+		if page.Sort.Key == "title" {
+			return rental.BookTitle, rental.RentID.String()
+		}
+		return rental.RentedDate, rental.RentID.String()
+	})
+	return results, total, links, nil
+}
+
+// GetLoansByStudent returns the books the student has not returned yet,
+// oldest first.
+func (r rentRepository) GetLoansByStudent(ctx context.Context, studentID uuid.UUID) ([]*dto.StudentRental, error) {
+	var rows []*studentRentalRow
+	err := r.studentRentals(ctx, studentID).
+		Where("carts.status = ? AND return_events.id IS NULL", "RENTED").
+		Order("carts.created_at, rents.id").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student loans: %w", err)
+	}
+	return studentRentalResults(rows), nil
+}
+
+// GetRentalsByCart returns the books of a cart in the order they were
+// rented.
+func (r rentRepository) GetRentalsByCart(ctx context.Context, cartID uuid.UUID) ([]*dto.StudentRental, error) {
+	var rows []*studentRentalRow
+	err := r.rentals(ctx).
+		Where("rents.cart_id = ?", cartID).
+		Order("rents.created_at, rents.id").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cart rentals: %w", err)
+	}
+	return studentRentalResults(rows), nil
+}
+
+func (r rentRepository) studentRentals(ctx context.Context, studentID uuid.UUID) *gorm.DB {
+	return r.rentals(ctx).Where("carts.student_id = ?", studentID)
+}
+
+func (r rentRepository) rentals(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("rents").
+		Select(`
+			rents.id as rent_id,
+			rents.cart_id,
+			rents.book_id,
+			books.title as book_title,
+			carts.status,
+			carts.created_at as rented_date,
+			carts.updated_at as cart_updated_at,
+			return_events.returned_at as returned_date,
+			COALESCE(return_events.condition, '') as condition
+		`).
+		Joins("JOIN carts ON rents.cart_id = carts.id").
+		Joins("JOIN books ON rents.book_id = books.id").
+		Joins("LEFT JOIN return_events ON return_events.rent_id = rents.id AND return_events.deleted_at IS NULL").
+		Where("rents.deleted_at IS NULL AND carts.deleted_at IS NULL")
+}
+
+func studentRentalResults(rows []*studentRentalRow) []*dto.StudentRental {
+	results := make([]*dto.StudentRental, len(rows))
+	for i, row := range rows {
+		if row.Status == "RETURNED" && row.ReturnedDate == nil {
+			row.ReturnedDate = &row.CartUpdatedAt
+		}
+		results[i] = &row.StudentRental
+	}
+	return results
+}
+
+func (r rentRepository) GetStudentStats(ctx context.Context, studentID uuid.UUID, overduePeriod int, topN int) (*dto.StudentStats, error) {
+	var counts struct {
+		TotalRentals      int64
+		CurrentlyBorrowed int64
+		OverdueCount      int64
+	}
+
+	studentRents := func() *gorm.DB {
+		return r.db.WithContext(ctx).
+			Table("rents").
+			Joins("JOIN carts ON rents.cart_id = carts.id").
+			Where("carts.student_id = ?", studentID).
+			Where("rents.deleted_at IS NULL AND carts.deleted_at IS NULL")
+	}
+
+	if err := studentRents().
+		Select(`
+			COUNT(*) as total_rentals,
+			COALESCE(SUM(CASE WHEN carts.status = 'RENTED' THEN 1 ELSE 0 END), 0) as currently_borrowed,
+			COALESCE(SUM(CASE WHEN carts.status = 'RENTED' AND julianday(?) - julianday(carts.created_at) > ? THEN 1 ELSE 0 END), 0) as overdue_count
+		`, r.clock.Now().UTC(), overduePeriod).
+		Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to get student rental counts: %w", err)
+	}
+
+	stats := dto.StudentStats{
+		TotalRentals:      counts.TotalRentals,
+		CurrentlyBorrowed: counts.CurrentlyBorrowed,
+		OverdueCount:      counts.OverdueCount,
+		MostBorrowed:      []dto.BorrowedTitle{},
+	}
+
+	var lastRented []time.Time
+	if err := studentRents().
+		Order("carts.created_at DESC").
+		Limit(1).
+		Pluck("carts.created_at", &lastRented).Error; err != nil {
+		return nil, fmt.Errorf("failed to get last rental date: %w", err)
+	}
+	if len(lastRented) > 0 {
+		stats.LastRentedAt = &lastRented[0]
+	}
+
+	if err := studentRents().
+		Select("books.id as book_id, books.title as book_title, COUNT(*) as count").
+		Joins("JOIN books ON rents.book_id = books.id").
+		Group("books.id, books.title").
+		Order("count DESC, books.title ASC").
+		Limit(topN).
+		Scan(&stats.MostBorrowed).Error; err != nil {
+		return nil, fmt.Errorf("failed to get most borrowed titles: %w", err)
+	}
+
+	return &stats, nil
+}
@@ -3,105 +3,205 @@ package sqlite
 import (
 	"context"
 	"fmt"
+	"math"
+	"slices"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"BRSBackend/pkg/clock"
 	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pagination"
 	"BRSBackend/pkg/repository"
 )
 
 type reportRepository struct {
-	db *gorm.DB
+	db    *gorm.DB
+	clock clock.Clock
 }
 
-func NewReportRepository(db *gorm.DB) repository.ReportRepository {
-	return &reportRepository{db: db}
+func NewReportRepository(db *gorm.DB, clock clock.Clock) repository.ReportRepository {
+	return &reportRepository{db: db, clock: clock}
 }
 
-func (r reportRepository) GetOverdueRentals(ctx context.Context, studentCardID *string, limit, offset, overduePeriod int) ([]dto.OverdueUser, int64, error) {
-	var overdueUsers []dto.OverdueUser
-	var total int64
+var overdueSortFields = pagination.Fields{
+	"name":       {Column: "student_name", Kind: pagination.String},
+	"count":      {Column: "total_books", Kind: pagination.Int},
+	"created_at": {Column: "date_rented", Kind: pagination.Time},
+}
 
+// overdueRents selects the books of rented carts that were due before now.
+func (r reportRepository) overdueRents(ctx context.Context, studentCardID *string, now time.Time, overduePeriod int) *gorm.DB {
 	query := r.db.WithContext(ctx).
 		Table("rents").
-		Select(`
-			students.id,
-			carts.id as cart_id,
-			students.first_name || ' ' || students.last_name as student_name,
-	 		students.card_id,
-			students.phone,
-			COUNT(*) as total_books,
-			MIN(carts.created_at) as date_rented,
-			julianday('now') - julianday(MIN(carts.created_at)) as days_overdue
-		`).
 		Joins("JOIN carts ON rents.cart_id = carts.id").
 		Joins("JOIN students ON carts.student_id = students.id").
-		Where("carts.status = ? ", "RENTED").
-		Group("students.id, students.first_name, students.last_name, students.phone").
-		Having("julianday('now') - julianday(MIN(carts.created_at)) > ?", overduePeriod)
+		Where("carts.status = ?", "RENTED").
+		Where("rents.deleted_at IS NULL AND carts.deleted_at IS NULL").
+		Where("julianday(?) - julianday(carts.created_at) > ?", now.UTC(), overduePeriod)
 
 	if studentCardID != nil && *studentCardID != "" {
 		query = query.Where("students.card_id = ?", *studentCardID)
 	}
+	return query
+}
 
-	var countResult struct {
-		Count int64
+func (r reportRepository) GetOverdueRentals(ctx context.Context, studentCardID *string, params dto.PaginationParams, now time.Time, overduePeriod int) ([]dto.OverdueUser, int64, pagination.Links, error) {
+	page, err := pagination.New(params.Sort, params.Cursor, params.Limit, params.Offset,
+		overdueSortFields, pagination.Sort{Key: "created_at"}, "student_id")
+	if err != nil {
+		return nil, 0, pagination.Links{}, err
 	}
 
-	countQuery := r.db.WithContext(ctx).
-		Table("(?) as grouped_results", query).
-		Select("COUNT(*) as count")
+	query := r.overdueRents(ctx, studentCardID, now, overduePeriod).
+		Select(`
+			students.id as student_id,
+			students.first_name || ' ' || students.last_name as student_name,
+			students.card_id,
+			students.phone,
+			COUNT(*) as total_books,
+			MIN(carts.created_at) as date_rented
+		`).
+		Group("students.id")
 
-	type tempOverdueUser struct {
-		ID          string  `json:"id"`
-		CartId      string  `json:"cart_id"`
-		StudentName string  `json:"student_name"`
-		CardId      string  `json:"card_id"`
-		Phone       string  `json:"phone"`
-		TotalBooks  int     `json:"total_books"`
-		DateRented  string  `json:"date_rented"`
-		DaysOverdue float64 `json:"days_overdue"`
+	var total int64
+	if err := r.db.WithContext(ctx).
+		Table("(?) as grouped_results", query).
+		Count(&total).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to count overdue rentals: %w", err)
 	}
-	var tempUsers []tempOverdueUser
 
-	if err := countQuery.Scan(&countResult).Error; err != nil {
-		return nil, 0, fmt.Errorf("failed to count overdue rentals: %w", err)
+	type overdueRow struct {
+		StudentID   string
+		StudentName string
+		CardId      string
+		Phone       string
+		TotalBooks  int
+		DateRented  string
 	}
-	total = countResult.Count
-
-	if err := query.Limit(limit).Offset(offset).Scan(&tempUsers).Error; err != nil {
-		return nil, 0, fmt.Errorf("failed to get overdue rentals: %w", err)
+	var rows []overdueRow
+	if err := r.db.WithContext(ctx).
+		Table("(?) as overdue", query).
+		Scopes(page.Scope).
+		Scan(&rows).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to get overdue rentals: %w", err)
 	}
-	overdueUsers = make([]dto.OverdueUser, len(tempUsers))
-	for i, temp := range tempUsers {
-		dateRented, err := time.Parse("2006-01-02 15:04:05.999999999-07:00", temp.DateRented)
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to parse date_rented '%s': %w", temp.DateRented, err)
-		}
 
-		daysOverdue := int(temp.DaysOverdue)
-
-		if daysOverdue > overduePeriod {
-			daysOverdue = daysOverdue - overduePeriod
+	overdueUsers := make([]dto.OverdueUser, len(rows))
+	for i, row := range rows {
+		dateRented, err := time.Parse("2006-01-02 15:04:05.999999999-07:00", row.DateRented)
+		if err != nil {
+			return nil, 0, pagination.Links{}, fmt.Errorf("failed to parse date_rented '%s': %w", row.DateRented, err)
 		}
 
 		overdueUsers[i] = dto.OverdueUser{
-			CartId:      temp.CartId,
-			StudentName: temp.StudentName,
-			CardId:      temp.CardId,
-			Phone:       temp.Phone,
-			TotalBooks:  temp.TotalBooks,
+			StudentId:   row.StudentID,
+			StudentName: row.StudentName,
+			CardId:      row.CardId,
+			Phone:       row.Phone,
+			TotalBooks:  row.TotalBooks,
 			DateRented:  dateRented,
-			DaysOverdue: daysOverdue,
 		}
 	}
 
-	return overdueUsers, total, nil
+	overdueUsers, links := pagination.Window(page, overdueUsers, func(user dto.OverdueUser) (any, string) {
+		return overdueSortValue(user, page.Sort.Key), user.StudentId
+	})
+
+	if err := r.attachOverdueItems(ctx, overdueUsers, now, overduePeriod); err != nil {
+		return nil, 0, pagination.Links{}, err
+	}
+	return overdueUsers, total, links, nil
+}
+
+// attachOverdueItems loads the overdue books of the given students, oldest
+// first.
+func (r reportRepository) attachOverdueItems(ctx context.Context, users []dto.OverdueUser, now time.Time, overduePeriod int) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	studentIDs := make([]string, len(users))
+	for i, user := range users {
+		studentIDs[i] = user.StudentId
+	}
+
+	var items []dto.OverdueItem
+	if err := r.overdueRents(ctx, nil, now, overduePeriod).
+		Select(`
+			rents.id as rent_id,
+			carts.id as cart_id,
+			carts.student_id,
+			books.id as book_id,
+			books.title,
+			carts.created_at as rented_at
+		`).
+		Joins("JOIN books ON rents.book_id = books.id").
+		Where("carts.student_id IN ?", studentIDs).
+		Order("carts.created_at, books.title, rents.id").
+		Scan(&items).Error; err != nil {
+		return fmt.Errorf("failed to get overdue items: %w", err)
+	}
+
+	index := make(map[string]int, len(users))
+	for i, user := range users {
+		index[user.StudentId] = i
+	}
+	for _, item := range items {
+		user := &users[index[item.StudentId]]
+		user.Items = append(user.Items, item)
+	}
+	return nil
+}
+
+// GetOverdueAging counts the overdue books per bucket of days past due. A
+// book counts as a day overdue as soon as it is past due, so the fractional
+// days past due can be compared with the inclusive upper bounds directly.
+func (r reportRepository) GetOverdueAging(ctx context.Context, studentCardID *string, buckets []dto.AgingBucket, now time.Time, overduePeriod int) ([]dto.AgingBucket, error) {
+	cases := "CASE"
+	var args []any
+	for i, bucket := range buckets {
+		if bucket.MaxDays != nil {
+			cases += fmt.Sprintf(" WHEN julianday(?) - julianday(carts.created_at) - ? <= %d THEN %d", *bucket.MaxDays, i)
+			args = append(args, now.UTC(), overduePeriod)
+		}
+	}
+	cases += fmt.Sprintf(" ELSE %d END", len(buckets)-1)
+
+	var counts []struct {
+		Bucket   int
+		Items    int64
+		Students int64
+	}
+	if err := r.overdueRents(ctx, studentCardID, now, overduePeriod).
+		Select(cases+" as bucket, COUNT(*) as items, COUNT(DISTINCT carts.student_id) as students", args...).
+		Group("bucket").
+		Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count overdue aging: %w", err)
+	}
+
+	aging := slices.Clone(buckets)
+	for _, count := range counts {
+		aging[count.Bucket].Items = count.Items
+		aging[count.Bucket].Students = count.Students
+	}
+	return aging, nil
+}
+
+func overdueSortValue(user dto.OverdueUser, key string) any {
+	switch key {
+	case "name":
+		return user.StudentName
+	case "count":
+		return user.TotalBooks
+	default:
+		return user.DateRented
+	}
 }
 
-func (r reportRepository) GetRentalReport(ctx context.Context, limit, offset, overduePeriod int) (*dto.RentReport, error) {
+func (r reportRepository) GetRentalReport(ctx context.Context, limit, offset int, now time.Time, overduePeriod int) (*dto.RentReport, error) {
 	var report dto.RentReport
 	var totalRents int64
 	var totalStudents int64
@@ -134,7 +234,7 @@ func (r reportRepository) GetRentalReport(ctx context.Context, limit, offset, ov
 	}
 	report.TopBooks = topBooks
 
-	overdueUsers, _, err := r.GetOverdueRentals(ctx, nil, limit, offset, overduePeriod)
+	overdueUsers, _, _, err := r.GetOverdueRentals(ctx, nil, dto.PaginationParams{Limit: limit, Offset: offset}, now, overduePeriod)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get overdue rentals: %w", err)
 	}
@@ -142,3 +242,522 @@ func (r reportRepository) GetRentalReport(ctx context.Context, limit, offset, ov
 
 	return &report, nil
 }
+
+func (r reportRepository) GetCirculationStats(ctx context.Context, overduePeriod int) (*dto.CirculationStats, error) {
+	var stats dto.CirculationStats
+
+	if err := r.db.WithContext(ctx).
+		Table("rents").
+		Joins("JOIN carts ON rents.cart_id = carts.id").
+		Where("carts.status = ?", "RENTED").
+		Where("rents.deleted_at IS NULL AND carts.deleted_at IS NULL").
+		Count(&stats.ActiveRentals).Error; err != nil {
+		return nil, fmt.Errorf("failed to count active rentals: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).
+		Model(&models.Cart{}).
+		Where("status = ?", "RENTED").
+		Where("julianday(?) - julianday(created_at) > ?", r.clock.Now().UTC(), overduePeriod).
+		Count(&stats.OverdueCarts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count overdue carts: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).
+		Model(&models.Book{}).
+		Where("count <= 0").
+		Count(&stats.BooksOutOfStock).Error; err != nil {
+		return nil, fmt.Errorf("failed to count books out of stock: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).
+		Model(&models.Session{}).
+		Where("expires_at > ?", r.clock.Now()).
+		Count(&stats.ActiveSessions).Error; err != nil {
+		return nil, fmt.Errorf("failed to count active sessions: %w", err)
+	}
+
+	return &stats, nil
+}
+
+// during is the condition that column falls between two bound times, the
+// second exclusive. Timestamps are stored with the UTC offset of the server,
+// so they are compared as julian days rather than as text, in agreement with
+// the UTC days of bucketStart.
+func during(column string) string {
+	return fmt.Sprintf("julianday(%[1]s) >= julianday(?) AND julianday(%[1]s) < julianday(?)", column)
+}
+
+// bucketStart is the SQL expression for the first day of the day, week
+// (starting Monday) or month holding column shifted by days.
+func bucketStart(column, interval string, days int) string {
+	modifiers := fmt.Sprintf("'%+d days'", days)
+	switch interval {
+	case dto.IntervalWeek:
+		modifiers += ", 'weekday 0', '-6 days'"
+	case dto.IntervalMonth:
+		modifiers += ", 'start of month'"
+	}
+	return fmt.Sprintf("date(%s, %s)", column, modifiers)
+}
+
+// analyticsSplit holds the columns and join that split a series by major or
+// book. An empty studentColumn means the students table is already queried.
+type analyticsSplit struct {
+	key, label, join string
+}
+
+// splitBy reports false when the series cannot be split by groupBy, which is
+// the case for a split by book without a bookColumn.
+func splitBy(groupBy, studentColumn, bookColumn string) (analyticsSplit, bool) {
+	switch groupBy {
+	case dto.GroupByMajor:
+		split := analyticsSplit{key: "students.major", label: "students.major"}
+		if studentColumn != "" {
+			split.join = "JOIN students ON students.id = " + studentColumn
+		}
+		return split, true
+	case dto.GroupByBook:
+		return analyticsSplit{key: "books.id", label: "books.title", join: "JOIN books ON books.id = " + bookColumn}, bookColumn != ""
+	default:
+		return analyticsSplit{key: "''", label: "''"}, true
+	}
+}
+
+func (s analyticsSplit) query(db *gorm.DB, bucket, count string, keys []string) *gorm.DB {
+	db = db.Select(fmt.Sprintf("%s as bucket, %s as group_key, %s as group_label, %s", bucket, s.key, s.label, count))
+	if s.join != "" {
+		db = db.Joins(s.join)
+	}
+	if keys != nil {
+		db = db.Where(s.key+" IN ?", keys)
+	}
+	return db.Group("bucket, group_key")
+}
+
+// GetCirculationSeries counts checkouts, returns, new students and books
+// becoming overdue per bucket between from and the exclusive to. A book
+// becomes overdue on its due date if it is not back by then. The totals have
+// an empty group key; with groupBy, rows follow for the groupLimit majors or
+// books with the most checkouts. Rolled up days are read from the daily
+// rollups, later days from the circulation tables.
+func (r reportRepository) GetCirculationSeries(ctx context.Context, from, to time.Time, interval, groupBy string, groupLimit, overduePeriod int) ([]*dto.AnalyticsRow, error) {
+	rolledUp, err := r.rolledUpThrough(ctx)
+	if err != nil {
+		return nil, err
+	}
+	split := rolledUp
+	if split.Before(from) {
+		split = from
+	}
+	if split.After(to) {
+		split = to
+	}
+
+	rows, err := r.series(ctx, from, split, to, interval, "", nil, overduePeriod)
+	if err != nil || groupBy == "" {
+		return rows, err
+	}
+
+	keys, err := r.rankGroups(ctx, from, split, to, groupBy, groupLimit)
+	if err != nil || len(keys) == 0 {
+		return rows, err
+	}
+
+	groups, err := r.series(ctx, from, split, to, interval, groupBy, keys, overduePeriod)
+	if err != nil {
+		return nil, err
+	}
+	return append(rows, groups...), nil
+}
+
+func (r reportRepository) series(ctx context.Context, from, split, to time.Time, interval, groupBy string, keys []string, overduePeriod int) ([]*dto.AnalyticsRow, error) {
+	var rows []*dto.AnalyticsRow
+
+	if from.Before(split) {
+		var rolled []*dto.AnalyticsRow
+		query := r.db.WithContext(ctx).
+			Model(&models.CirculationDaily{}).
+			Select(fmt.Sprintf(`%s as bucket, group_key, group_label,
+				SUM(checkouts) as checkouts, SUM(returns) as returns, SUM(new_students) as new_students, SUM(overdue) as overdue`,
+				bucketStart("day", interval, 0))).
+			Where("dimension = ? AND day >= ? AND day < ?", groupBy, from.Format(time.DateOnly), split.Format(time.DateOnly))
+		if keys != nil {
+			query = query.Where("group_key IN ?", keys)
+		}
+		if err := query.Group("bucket, group_key").Scan(&rolled).Error; err != nil {
+			return nil, fmt.Errorf("failed to read circulation rollups: %w", err)
+		}
+		rows = append(rows, rolled...)
+	}
+
+	if split.Before(to) {
+		raw, err := r.circulationSeries(ctx, split, to, interval, groupBy, keys, overduePeriod)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, raw...)
+	}
+
+	return rows, nil
+}
+
+// rankGroups returns the limit majors or books with the most checkouts.
+func (r reportRepository) rankGroups(ctx context.Context, from, split, to time.Time, groupBy string, limit int) ([]string, error) {
+	rolled := r.db.
+		Model(&models.CirculationDaily{}).
+		Select("group_key, group_label, checkouts").
+		Where("dimension = ? AND day >= ? AND day < ? AND checkouts > 0", groupBy, from.Format(time.DateOnly), split.Format(time.DateOnly))
+
+	s, _ := splitBy(groupBy, "carts.student_id", "rents.book_id")
+	raw := r.rentals(ctx).
+		Select(fmt.Sprintf("%s as group_key, %s as group_label, COUNT(*) as checkouts", s.key, s.label)).
+		Joins(s.join).
+		Where(during("carts.created_at"), split, to).
+		Group(s.key)
+
+	var keys []string
+	if err := r.db.WithContext(ctx).Raw(`
+		SELECT group_key FROM (SELECT * FROM (?) UNION ALL SELECT * FROM (?))
+		GROUP BY group_key
+		ORDER BY SUM(checkouts) DESC, MIN(group_label)
+		LIMIT ?
+	`, rolled, raw, limit).Scan(&keys).Error; err != nil {
+		return nil, fmt.Errorf("failed to rank circulation groups: %w", err)
+	}
+	return keys, nil
+}
+
+// rolledUpThrough returns the day after the last rolled up day, or the zero
+// time if nothing is rolled up yet.
+func (r reportRepository) rolledUpThrough(ctx context.Context) (time.Time, error) {
+	var last *string
+	if err := r.db.WithContext(ctx).
+		Model(&models.CirculationDaily{}).
+		Where("dimension = ''").
+		Select("MAX(day)").
+		Scan(&last).Error; err != nil {
+		return time.Time{}, fmt.Errorf("failed to read circulation rollup state: %w", err)
+	}
+	if last == nil {
+		return time.Time{}, nil
+	}
+
+	day, err := time.Parse(time.DateOnly, *last)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid circulation rollup day %q: %w", *last, err)
+	}
+	return day.AddDate(0, 0, 1), nil
+}
+
+// rollupChunkDays is the number of days rolled up per transaction.
+const rollupChunkDays = 31
+
+// RollupCirculation stores the daily circulation counts of the days before
+// through that are not rolled up yet, starting with the first day with any
+// activity. Every day gets a totals row, so that the last one marks progress.
+func (r reportRepository) RollupCirculation(ctx context.Context, through time.Time, overduePeriod int) error {
+	start, err := r.rolledUpThrough(ctx)
+	if err != nil {
+		return err
+	}
+	if start.IsZero() {
+		var first *string
+		if err := r.db.WithContext(ctx).Raw(`
+			SELECT MIN(day) FROM (
+				SELECT date(MIN(created_at)) AS day FROM carts
+				UNION ALL
+				SELECT date(MIN(created_at)) FROM students
+			)
+		`).Scan(&first).Error; err != nil {
+			return fmt.Errorf("failed to find first circulation day: %w", err)
+		}
+		if first == nil {
+			return nil
+		}
+		if start, err = time.Parse(time.DateOnly, *first); err != nil {
+			return fmt.Errorf("invalid first circulation day %q: %w", *first, err)
+		}
+	}
+
+	for from := start; from.Before(through); from = from.AddDate(0, 0, rollupChunkDays) {
+		to := from.AddDate(0, 0, rollupChunkDays)
+		if to.After(through) {
+			to = through
+		}
+
+		var days []*models.CirculationDaily
+		totals := make(map[string]*models.CirculationDaily)
+		for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+			total := &models.CirculationDaily{Day: day.Format(time.DateOnly)}
+			totals[total.Day] = total
+			days = append(days, total)
+		}
+
+		for _, groupBy := range []string{"", dto.GroupByMajor, dto.GroupByBook} {
+			rows, err := r.circulationSeries(ctx, from, to, dto.IntervalDay, groupBy, nil, overduePeriod)
+			if err != nil {
+				return err
+			}
+			grouped := make(map[[2]string]*models.CirculationDaily)
+			for _, row := range rows {
+				day := totals[row.Bucket]
+				if day == nil {
+					continue
+				}
+				if groupBy != "" {
+					id := [2]string{row.Bucket, row.GroupKey}
+					if day = grouped[id]; day == nil {
+						day = &models.CirculationDaily{Dimension: groupBy, Day: row.Bucket, GroupKey: row.GroupKey, GroupLabel: row.GroupLabel}
+						grouped[id] = day
+						days = append(days, day)
+					}
+				}
+				day.Checkouts += row.Checkouts
+				day.Returns += row.Returns
+				day.NewStudents += row.NewStudents
+				day.Overdue += row.Overdue
+			}
+		}
+
+		if err := r.db.WithContext(ctx).
+			Clauses(clause.OnConflict{UpdateAll: true}).
+			CreateInBatches(days, 500).Error; err != nil {
+			return fmt.Errorf("failed to store circulation rollups: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r reportRepository) rentals(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("rents").
+		Joins("JOIN carts ON rents.cart_id = carts.id").
+		Where("rents.deleted_at IS NULL AND carts.deleted_at IS NULL")
+}
+
+// rentReturnedAt is when a rent joined with its cart and return event came
+// back, or NULL while it is out. Carts returned before return events were
+// recorded count as returned when they were last updated.
+const rentReturnedAt = "COALESCE(return_events.returned_at, CASE WHEN carts.status = 'RETURNED' THEN carts.updated_at END)"
+
+func (r reportRepository) circulationSeries(ctx context.Context, from, to time.Time, interval, groupBy string, keys []string, overduePeriod int) ([]*dto.AnalyticsRow, error) {
+	var rows, batch []*dto.AnalyticsRow
+
+	split, _ := splitBy(groupBy, "carts.student_id", "rents.book_id")
+	if err := split.query(r.rentals(ctx), bucketStart("carts.created_at", interval, 0), "COUNT(*) as checkouts", keys).
+		Where(during("carts.created_at"), from, to).
+		Scan(&batch).Error; err != nil {
+		return nil, fmt.Errorf("failed to count checkouts: %w", err)
+	}
+	rows = append(rows, batch...)
+
+	// Carts returned before return events were recorded count as returned
+	// when they were last updated.
+	returned := r.db.Raw(fmt.Sprintf(`
+		SELECT returned_at, book_id, student_id FROM return_events
+		WHERE deleted_at IS NULL AND %s
+		UNION ALL
+		SELECT carts.updated_at, rents.book_id, carts.student_id FROM rents
+		JOIN carts ON rents.cart_id = carts.id
+		LEFT JOIN return_events ON return_events.rent_id = rents.id
+		WHERE rents.deleted_at IS NULL AND carts.deleted_at IS NULL
+			AND carts.status = 'RETURNED' AND return_events.id IS NULL
+			AND %s
+	`, during("returned_at"), during("carts.updated_at")), from, to, from, to)
+
+	batch = nil
+	split, _ = splitBy(groupBy, "returned.student_id", "returned.book_id")
+	if err := split.query(r.db.WithContext(ctx).Table("(?) as returned", returned), bucketStart("returned.returned_at", interval, 0), "COUNT(*) as returns", keys).
+		Scan(&batch).Error; err != nil {
+		return nil, fmt.Errorf("failed to count returns: %w", err)
+	}
+	rows = append(rows, batch...)
+
+	if split, ok := splitBy(groupBy, "", ""); ok {
+		batch = nil
+		if err := split.query(r.db.WithContext(ctx).Model(&models.Student{}), bucketStart("students.created_at", interval, 0), "COUNT(*) as new_students", keys).
+			Where(during("students.created_at"), from, to).
+			Scan(&batch).Error; err != nil {
+			return nil, fmt.Errorf("failed to count new students: %w", err)
+		}
+		rows = append(rows, batch...)
+	}
+
+	period := time.Duration(overduePeriod) * 24 * time.Hour
+
+	batch = nil
+	split, _ = splitBy(groupBy, "carts.student_id", "rents.book_id")
+	if err := split.query(r.rentals(ctx), bucketStart("carts.created_at", interval, overduePeriod), "COUNT(*) as overdue", keys).
+		Joins("LEFT JOIN return_events ON return_events.rent_id = rents.id AND return_events.deleted_at IS NULL").
+		Where(during("carts.created_at"), from.Add(-period), to.Add(-period)).
+		Where("julianday(carts.created_at) + ? < julianday(?)", overduePeriod, r.clock.Now().UTC()).
+		Where(fmt.Sprintf("%s IS NULL OR julianday(%s) > julianday(carts.created_at) + ?", rentReturnedAt, rentReturnedAt), overduePeriod).
+		Scan(&batch).Error; err != nil {
+		return nil, fmt.Errorf("failed to count overdue rentals: %w", err)
+	}
+	rows = append(rows, batch...)
+
+	return rows, nil
+}
+
+// demandUtilizationPct is the share of the period the copies of a title were
+// on loan from which demand counts as exceeding supply.
+const demandUtilizationPct = 80
+
+var collectionSortFields = pagination.Fields{
+	"title":       {Column: "title", Kind: pagination.String},
+	"copies":      {Column: "copies", Kind: pagination.Int},
+	"rentals":     {Column: "rentals", Kind: pagination.Int},
+	"utilization": {Column: "CAST(ROUND(utilization_pct * 10) AS INTEGER)", Kind: pagination.Int},
+}
+
+// collection selects the use of every book between filters.From and
+// filters.To. Loans still out count as on loan until now.
+func (r reportRepository) collection(ctx context.Context, filters dto.CollectionFilters) *gorm.DB {
+	now := r.clock.Now().UTC()
+	from, to := filters.From.UTC(), filters.To.UTC()
+	end := to
+	if now.Before(end) {
+		end = now
+	}
+	days := end.Sub(from).Hours() / 24
+
+	loans := r.rentals(ctx).
+		Select(fmt.Sprintf(`
+			rents.book_id,
+			SUM(CASE WHEN julianday(carts.created_at) >= julianday(?) THEN 1 ELSE 0 END) as rentals,
+			SUM(CASE WHEN %[2]s THEN 1 ELSE 0 END) as ended,
+			SUM(CASE WHEN %[2]s THEN julianday(%[1]s) - julianday(carts.created_at) ELSE 0 END) as ended_days,
+			SUM(MAX(0, MIN(julianday(COALESCE(%[1]s, ?)), julianday(?)) - MAX(julianday(carts.created_at), julianday(?)))) as loan_days
+		`, rentReturnedAt, during(rentReturnedAt)), from, from, to, from, to, now, end, from).
+		Joins("LEFT JOIN return_events ON return_events.rent_id = rents.id AND return_events.deleted_at IS NULL").
+		Where("julianday(carts.created_at) < julianday(?)", to).
+		// A cart returned during the period was last updated then; checking
+		// that first spares computing the return time of every older loan.
+		Where("julianday(carts.created_at) >= julianday(?) OR carts.status = ? OR julianday(carts.updated_at) >= julianday(?)", from, "RENTED", from).
+		Where(fmt.Sprintf("%[1]s IS NULL OR julianday(%[1]s) >= julianday(?)", rentReturnedAt), from).
+		Group("rents.book_id")
+
+	out := r.rentals(ctx).
+		Select("rents.book_id, COUNT(*) as out_now").
+		Where("carts.status = ?", "RENTED").
+		Group("rents.book_id")
+
+	usage := r.db.
+		Table("books").
+		Select(`
+			books.id as book_id,
+			books.title,
+			books.isbn,
+			books.barcode,
+			books.count + COALESCE(out.out_now, 0) as copies,
+			books.count as available,
+			COALESCE(loans.rentals, 0) as rentals,
+			loans.ended_days / NULLIF(loans.ended, 0) as avg_loan_days,
+			COALESCE(MIN(100.0, loans.loan_days * 100.0 / NULLIF((books.count + COALESCE(out.out_now, 0)) * ?, 0)), 0) as utilization_pct
+		`, days).
+		Joins("LEFT JOIN (?) as loans ON loans.book_id = books.id", loans).
+		Joins("LEFT JOIN (?) as out ON out.book_id = books.id", out).
+		Where("books.deleted_at IS NULL")
+
+	query := r.db.WithContext(ctx).
+		Table("(?) as usage", usage).
+		Select(`*,
+			rentals = 0 as zero_circulation,
+			(utilization_pct >= ? OR (available <= 0 AND rentals > 0)) as demand_exceeds_supply
+		`, demandUtilizationPct)
+
+	return r.db.WithContext(ctx).Table("(?) as collection", query)
+}
+
+func collectionFilters(filters dto.CollectionFilters) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if filters.ZeroCirculation != nil {
+			db = db.Where("zero_circulation = ?", *filters.ZeroCirculation)
+		}
+		if filters.DemandExceedsSupply != nil {
+			db = db.Where("demand_exceeds_supply = ?", *filters.DemandExceedsSupply)
+		}
+		return db
+	}
+}
+
+// GetCollectionReport returns a page of the books matching the filters, their
+// total and a summary of the whole collection.
+func (r reportRepository) GetCollectionReport(ctx context.Context, filters dto.CollectionFilters) ([]dto.CollectionItem, dto.CollectionSummary, int64, pagination.Links, error) {
+	var summary dto.CollectionSummary
+	var total int64
+
+	page, err := pagination.New(filters.Sort, filters.Cursor, filters.Limit, filters.Offset,
+		collectionSortFields, pagination.Sort{Key: "title"}, "book_id")
+	if err != nil {
+		return nil, summary, 0, pagination.Links{}, err
+	}
+
+	if err := r.collection(ctx, filters).
+		Select(`
+			COUNT(*) as titles,
+			COALESCE(SUM(copies), 0) as copies,
+			COALESCE(SUM(rentals), 0) as rentals,
+			COALESCE(SUM(zero_circulation), 0) as zero_circulation,
+			COALESCE(SUM(demand_exceeds_supply), 0) as demand_exceeds_supply
+		`).
+		Scan(&summary).Error; err != nil {
+		return nil, summary, 0, pagination.Links{}, fmt.Errorf("failed to summarize collection: %w", err)
+	}
+
+	if err := r.collection(ctx, filters).
+		Scopes(collectionFilters(filters)).
+		Count(&total).Error; err != nil {
+		return nil, summary, 0, pagination.Links{}, fmt.Errorf("failed to count collection: %w", err)
+	}
+
+	var items []dto.CollectionItem
+	if err := r.collection(ctx, filters).
+		Scopes(collectionFilters(filters), page.Scope).
+		Scan(&items).Error; err != nil {
+		return nil, summary, 0, pagination.Links{}, fmt.Errorf("failed to get collection: %w", err)
+	}
+
+	items, links := pagination.Window(page, items, func(item dto.CollectionItem) (any, string) {
+		return collectionSortValue(item, page.Sort.Key), item.BookId
+	})
+	return items, summary, total, links, nil
+}
+
+// ListCollection returns every book matching the filters, in the requested
+// order.
+func (r reportRepository) ListCollection(ctx context.Context, filters dto.CollectionFilters) ([]dto.CollectionItem, error) {
+	sort, err := pagination.ParseSort(filters.Sort, collectionSortFields, pagination.Sort{Key: "title"})
+	if err != nil {
+		return nil, err
+	}
+	dir := "ASC"
+	if sort.Desc {
+		dir = "DESC"
+	}
+
+	var items []dto.CollectionItem
+	if err := r.collection(ctx, filters).
+		Scopes(collectionFilters(filters)).
+		Order(fmt.Sprintf("%s %s, book_id %s", collectionSortFields[sort.Key].Column, dir, dir)).
+		Scan(&items).Error; err != nil {
+		return nil, fmt.Errorf("failed to list collection: %w", err)
+	}
+	return items, nil
+}
+
+func collectionSortValue(item dto.CollectionItem, key string) any {
+	switch key {
+	case "copies":
+		return item.Copies
+	case "rentals":
+		return item.Rentals
+	case "utilization":
+		return int64(math.Round(item.UtilizationPct * 10))
+	default:
+		return item.Title
+	}
+}
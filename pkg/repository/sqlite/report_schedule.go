@@ -0,0 +1,193 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pagination"
+	"BRSBackend/pkg/repository"
+)
+
+// Schedule and run times are stored in UTC, so that they compare as text.
+type reportScheduleRepository struct {
+	db *gorm.DB
+}
+
+func NewReportScheduleRepository(db *gorm.DB) repository.ReportScheduleRepository {
+	return &reportScheduleRepository{db: db}
+}
+
+func (r reportScheduleRepository) Create(ctx context.Context, schedule *models.ReportSchedule) error {
+	if err := r.db.WithContext(ctx).Create(schedule).Error; err != nil {
+		return fmt.Errorf("failed to create report schedule: %w", err)
+	}
+	return nil
+}
+
+func (r reportScheduleRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ReportSchedule, error) {
+	var schedule models.ReportSchedule
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&schedule).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("report_schedule_not_found", "report schedule not found")
+		}
+		return nil, fmt.Errorf("failed to get report schedule: %w", err)
+	}
+	return &schedule, nil
+}
+
+func (r reportScheduleRepository) GetAll(ctx context.Context) ([]*models.ReportSchedule, error) {
+	var schedules []*models.ReportSchedule
+	if err := r.db.WithContext(ctx).Order("name, id").Find(&schedules).Error; err != nil {
+		return nil, fmt.Errorf("failed to list report schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+func (r reportScheduleRepository) Update(ctx context.Context, schedule *models.ReportSchedule) error {
+	result := r.db.WithContext(ctx).Model(schedule).Where("id = ?", schedule.Id).
+		Select("name", "report", "params", "frequency", "weekday", "day_of_month", "hour",
+			"formats", "delivery", "recipients", "enabled", "next_run_at").
+		Updates(schedule)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update report schedule: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("report_schedule_not_found", "report schedule not found")
+	}
+	return nil
+}
+
+func (r reportScheduleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.ReportSchedule{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete report schedule: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("report_schedule_not_found", "report schedule not found")
+	}
+	return nil
+}
+
+func (r reportScheduleRepository) GetDue(ctx context.Context, now time.Time) ([]*models.ReportSchedule, error) {
+	var schedules []*models.ReportSchedule
+	err := r.db.WithContext(ctx).
+		Where("enabled AND next_run_at <= ?", now.UTC()).
+		Order("next_run_at").
+		Find(&schedules).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due report schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// Claim moves a schedule due at due on to its next run. It reports false
+// when another instance has already claimed the run.
+func (r reportScheduleRepository) Claim(ctx context.Context, id uuid.UUID, due, next, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.ReportSchedule{}).
+		Where("id = ? AND next_run_at = ?", id, due.UTC()).
+		Updates(map[string]any{"next_run_at": next.UTC(), "last_run_at": now.UTC()})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to claim report schedule: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r reportScheduleRepository) CreateRun(ctx context.Context, run *models.ReportRun) error {
+	if err := r.db.WithContext(ctx).Omit("Files").Create(run).Error; err != nil {
+		return fmt.Errorf("failed to create report run: %w", err)
+	}
+	return nil
+}
+
+// FinishRun records the outcome of a run together with its files.
+func (r reportScheduleRepository) FinishRun(ctx context.Context, run *models.ReportRun) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range run.Files {
+			run.Files[i].RunId = run.Id
+		}
+		if len(run.Files) > 0 {
+			if err := tx.Create(&run.Files).Error; err != nil {
+				return fmt.Errorf("failed to save report files: %w", err)
+			}
+		}
+		err := tx.Model(run).Omit("Files").
+			Select("status", "error", "finished_at").
+			Updates(run).Error
+		if err != nil {
+			return fmt.Errorf("failed to finish report run: %w", err)
+		}
+		return nil
+	})
+}
+
+var reportRunSortFields = pagination.Fields{
+	"started_at": {Column: "report_runs.started_at", Kind: pagination.Time},
+}
+
+func (r reportScheduleRepository) GetRuns(ctx context.Context, scheduleID uuid.UUID, params dto.PaginationParams) ([]*models.ReportRun, int64, pagination.Links, error) {
+	var runs []*models.ReportRun
+	var total int64
+
+	page, err := pagination.New(params.Sort, params.Cursor, params.Limit, params.Offset,
+		reportRunSortFields, pagination.Sort{Key: "started_at", Desc: true}, "report_runs.id")
+	if err != nil {
+		return nil, 0, pagination.Links{}, err
+	}
+
+	query := r.db.WithContext(ctx).Model(&models.ReportRun{}).Where("schedule_id = ?", scheduleID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to count report runs: %w", err)
+	}
+
+	err = query.Scopes(page.Scope).
+		Preload("Files", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "run_id", "format", "name", "content_type", "size").Order("format")
+		}).
+		Find(&runs).Error
+	if err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to get report runs: %w", err)
+	}
+
+	runs, links := pagination.Window(page, runs, func(run *models.ReportRun) (any, string) {
+		return run.StartedAt, run.Id.String()
+	})
+	return runs, total, links, nil
+}
+
+func (r reportScheduleRepository) GetFile(ctx context.Context, runID uuid.UUID, format string) (*models.ReportFile, error) {
+	var file models.ReportFile
+	if err := r.db.WithContext(ctx).Where("run_id = ? AND format = ?", runID, format).First(&file).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("report_file_not_found", "report file not found")
+		}
+		return nil, fmt.Errorf("failed to get report file: %w", err)
+	}
+	return &file, nil
+}
+
+// DeleteRunsBefore deletes the runs started before the given time, and their
+// files.
+func (r reportScheduleRepository) DeleteRunsBefore(ctx context.Context, before time.Time) (int64, error) {
+	var deleted int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		old := tx.Model(&models.ReportRun{}).Select("id").Where("started_at < ?", before.UTC())
+		if err := tx.Where("run_id IN (?)", old).Delete(&models.ReportFile{}).Error; err != nil {
+			return fmt.Errorf("failed to delete report files: %w", err)
+		}
+		result := tx.Where("started_at < ?", before.UTC()).Delete(&models.ReportRun{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete report runs: %w", result.Error)
+		}
+		deleted = result.RowsAffected
+		return nil
+	})
+	return deleted, err
+}
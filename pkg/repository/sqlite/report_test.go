@@ -0,0 +1,93 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository/sqlite"
+)
+
+// TestCirculationSeriesLocalTime checks that activity stored in local time
+// east of UTC counts on its UTC day, both in raw series and in rollups whose
+// chunks start at the local midnight before it.
+func TestCirculationSeriesLocalTime(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("UTC+2", 2*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	db := openDatabase(t)
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo := sqlite.NewRepository(db.DB, clock.System{})
+	ctx := context.Background()
+
+	day := func(s string) time.Time {
+		d, _ := time.Parse(time.DateOnly, s)
+		return d
+	}
+	// 01:00 local on October 19 is 23:00 UTC on October 18.
+	at := time.Date(2026, 10, 19, 1, 0, 0, 0, time.Local)
+	// The first activity is a rollup chunk earlier, so that a chunk starts
+	// on October 19.
+	first := &models.Student{Model: gorm.Model{CreatedAt: day("2026-09-18").Local()}, Id: uuid.New(), FirstName: "Ada", LastName: "Lovelace", CardId: "C-1", Major: "Math", Phone: "1"}
+	student := &models.Student{Model: gorm.Model{CreatedAt: at}, Id: uuid.New(), FirstName: "Alan", LastName: "Turing", CardId: "C-2", Major: "Math", Phone: "2"}
+	book := &models.Book{Id: uuid.New(), Title: "Dune", Count: 1}
+	cart := &models.Cart{Model: gorm.Model{CreatedAt: at}, Id: uuid.New(), StudentId: student.Id, Status: "RENTED"}
+	rent := &models.Rent{Model: gorm.Model{CreatedAt: at}, Id: uuid.New(), CartId: cart.Id, BookId: book.Id}
+	for _, row := range []any{first, student, book, cart, rent} {
+		if err := db.DB.Create(row).Error; err != nil {
+			t.Fatalf("failed to seed %T: %v", row, err)
+		}
+	}
+
+	counts := func(rows []*dto.AnalyticsRow) map[string]dto.AnalyticsCounts {
+		byDay := make(map[string]dto.AnalyticsCounts)
+		for _, row := range rows {
+			c := byDay[row.Bucket]
+			c.Checkouts += row.Checkouts
+			c.NewStudents += row.NewStudents
+			byDay[row.Bucket] = c
+		}
+		return byDay
+	}
+
+	rows, err := repo.Report.GetCirculationSeries(ctx, day("2026-10-18"), day("2026-10-19"), dto.IntervalDay, "", 0, 14)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c := counts(rows)["2026-10-18"]; c.Checkouts != 1 || c.NewStudents != 1 {
+		t.Errorf("expected a checkout and a new student on October 18, got %+v", c)
+	}
+	rows, err = repo.Report.GetCirculationSeries(ctx, day("2026-10-19"), day("2026-10-20"), dto.IntervalDay, "", 0, 14)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, row := range rows {
+		if row.Checkouts != 0 || row.NewStudents != 0 {
+			t.Errorf("expected nothing from October 19, got %+v", row)
+		}
+	}
+
+	if err := repo.Report.RollupCirculation(ctx, day("2026-10-20"), 14); err != nil {
+		t.Fatalf("failed to roll up: %v", err)
+	}
+	rows, err = repo.Report.GetCirculationSeries(ctx, day("2026-09-18"), day("2026-10-20"), dto.IntervalDay, "", 0, 14)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	byDay := counts(rows)
+	if c := byDay["2026-10-18"]; c.Checkouts != 1 || c.NewStudents != 1 {
+		t.Errorf("expected the rollup to keep the checkout and new student of October 18, got %+v", c)
+	}
+	if c := byDay["2026-09-18"]; c.NewStudents != 1 {
+		t.Errorf("expected the first student on September 18, got %+v", c)
+	}
+}
@@ -3,17 +3,24 @@ package sqlite
 import (
 	"gorm.io/gorm"
 
+	"BRSBackend/pkg/clock"
 	"BRSBackend/pkg/repository"
 )
 
-func NewRepository(db *gorm.DB) *repository.Repository {
+func NewRepository(db *gorm.DB, clock clock.Clock) *repository.Repository {
 	return &repository.Repository{
-		Book:      NewBookRepository(db),
-		Student:   NewStudentRepository(db),
-		Librarian: NewLibrarianRepository(db),
-		Cart:      NewCartRepository(db),
-		Rent:      NewRentRepository(db),
-		Session:   NewSessionRepository(db),
-		Report:    NewReportRepository(db),
+		Book:        NewBookRepository(db),
+		Category:    NewCategoryRepository(db),
+		Student:     NewStudentRepository(db, clock),
+		Librarian:   NewLibrarianRepository(db),
+		Cart:        NewCartRepository(db),
+		Rent:        NewRentRepository(db, clock),
+		Return:      NewReturnRepository(db),
+		Session:     NewSessionRepository(db, clock),
+		StudentAuth: NewStudentAuthRepository(db, clock),
+		Report:      NewReportRepository(db, clock),
+		Schedule:    NewReportScheduleRepository(db),
+		Kiosk:       NewKioskRepository(db),
+		Health:      NewHealthRepository(db),
 	}
 }
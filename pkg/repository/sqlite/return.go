@@ -0,0 +1,139 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pagination"
+	"BRSBackend/pkg/repository"
+)
+
+type returnRepository struct {
+	db *gorm.DB
+}
+
+func NewReturnRepository(db *gorm.DB) repository.ReturnRepository {
+	return &returnRepository{db: db}
+}
+
+// ReturnCart marks a rented cart as returned, records its return events and
+// puts the books back in stock, all at once. The status only changes while
+// the cart is rented, so of concurrent returns of a cart only one succeeds.
+func (r returnRepository) ReturnCart(ctx context.Context, cartID uuid.UUID, events []*models.ReturnEvent, bookIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Cart{}).
+			Where("id = ? AND status = ?", cartID, "RENTED").
+			Update("status", "RETURNED")
+		if result.Error != nil {
+			return fmt.Errorf("failed to update cart status: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.Conflict("cart_not_rented", "cart %s is not currently rented", cartID)
+		}
+
+		if err := tx.Create(events).Error; err != nil {
+			return fmt.Errorf("failed to record return events: %w", err)
+		}
+
+		bookCounts := make(map[uuid.UUID]int)
+		for _, bookID := range bookIDs {
+			bookCounts[bookID]++
+		}
+		for bookID, count := range bookCounts {
+			if err := tx.Model(&models.Book{}).Where("id = ?", bookID).
+				Update("count", gorm.Expr("count + ?", count)).Error; err != nil {
+				return fmt.Errorf("failed to increment book count for %s: %w", bookID, err)
+			}
+		}
+		return nil
+	})
+}
+
+var returnSortFields = pagination.Fields{
+	"returned_at": {Column: "return_events.returned_at", Kind: pagination.Time},
+	"title":       {Column: "books.title", Kind: pagination.String},
+	"name":        {Column: "students.first_name || ' ' || students.last_name", Kind: pagination.String},
+}
+
+func (r returnRepository) GetByFilters(ctx context.Context, filters dto.ReturnFilters) ([]*dto.ReturnRecord, int64, pagination.Links, error) {
+	var results []*dto.ReturnRecord
+	var total int64
+
+	page, err := pagination.New(filters.Sort, filters.Cursor, filters.Limit, filters.Offset,
+		returnSortFields, pagination.Sort{Key: "returned_at", Desc: true}, "return_events.id")
+	if err != nil {
+		return nil, 0, pagination.Links{}, err
+	}
+
+	query := r.db.WithContext(ctx).
+		Table("return_events").
+		Select(`
+			return_events.id as return_id,
+			return_events.cart_id,
+			return_events.rent_id,
+			return_events.book_id,
+			books.title as book_title,
+			return_events.student_id,
+			students.first_name || ' ' || students.last_name as student_name,
+			students.card_id,
+			return_events.librarian_id,
+			COALESCE(librarians.user, '') as librarian,
+			return_events.condition,
+			return_events.notes,
+			return_events.returned_at
+		`).
+		Joins("JOIN books ON return_events.book_id = books.id").
+		Joins("JOIN students ON return_events.student_id = students.id").
+		Joins("LEFT JOIN librarians ON return_events.librarian_id = librarians.id").
+		Where("return_events.deleted_at IS NULL")
+
+	if filters.From != nil {
+		query = query.Where("return_events.returned_at >= ?", filters.From.Truncate(24*time.Hour))
+	}
+	if filters.To != nil {
+		query = query.Where("return_events.returned_at < ?", filters.To.Truncate(24*time.Hour).Add(24*time.Hour))
+	}
+	if filters.StudentID != nil {
+		query = query.Where("return_events.student_id = ?", *filters.StudentID)
+	}
+	if filters.BookID != nil {
+		query = query.Where("return_events.book_id = ?", *filters.BookID)
+	}
+	if filters.LibrarianID != nil {
+		query = query.Where("return_events.librarian_id = ?", *filters.LibrarianID)
+	}
+	if filters.Condition != "" {
+		query = query.Where("return_events.condition = ?", filters.Condition)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to count return history: %w", err)
+	}
+
+	if err := query.Scopes(page.Scope).Find(&results).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to get return history: %w", err)
+	}
+
+	results, links := pagination.Window(page, results, func(record *dto.ReturnRecord) (any, string) {
+		return returnSortValue(record, page.Sort.Key), record.ReturnID.String()
+	})
+	return results, total, links, nil
+}
+
+func returnSortValue(record *dto.ReturnRecord, key string) any {
+	switch key {
+	case "title":
+		return record.BookTitle
+	case "name":
+		return record.StudentName
+	default:
+		return record.ReturnedAt
+	}
+}
@@ -0,0 +1,80 @@
+package sqlite_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository/sqlite"
+)
+
+func TestReturnCartConcurrently(t *testing.T) {
+	db := openDatabase(t)
+	if err := db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo := sqlite.NewRepository(db.DB, clock.System{})
+	ctx := context.Background()
+
+	book := &models.Book{Id: uuid.New(), Title: "Dune", Count: 0}
+	cart := &models.Cart{Id: uuid.New(), StudentId: uuid.New(), Status: "RENTED"}
+	rents := []*models.Rent{
+		{Id: uuid.New(), CartId: cart.Id, BookId: book.Id},
+		{Id: uuid.New(), CartId: cart.Id, BookId: book.Id},
+	}
+	for _, row := range []any{book, cart, rents} {
+		if err := db.DB.Create(row).Error; err != nil {
+			t.Fatalf("failed to seed %T: %v", row, err)
+		}
+	}
+
+	const returns = 5
+	errs := make([]error, returns)
+	var wg sync.WaitGroup
+	for i := range returns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var events []*models.ReturnEvent
+			var bookIDs []uuid.UUID
+			for _, rent := range rents {
+				events = append(events, &models.ReturnEvent{CartId: cart.Id, RentId: rent.Id, BookId: rent.BookId, StudentId: cart.StudentId, Condition: models.ConditionGood, ReturnedAt: db.DB.NowFunc()})
+				bookIDs = append(bookIDs, rent.BookId)
+			}
+			errs[i] = repo.Return.ReturnCart(ctx, cart.Id, events, bookIDs)
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		} else if appErr, ok := apperrors.As(err); !ok || appErr.Code != "cart_not_rented" {
+			t.Errorf("expected cart_not_rented, got %v", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("expected one return to succeed, got %d", succeeded)
+	}
+
+	returned, err := repo.Book.GetByID(ctx, book.Id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if returned.Count != len(rents) {
+		t.Errorf("expected count %d, got %d", len(rents), returned.Count)
+	}
+	var recorded int64
+	if err := db.DB.Model(&models.ReturnEvent{}).Where("cart_id = ?", cart.Id).Count(&recorded).Error; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if recorded != int64(len(rents)) {
+		t.Errorf("expected %d return events, got %d", len(rents), recorded)
+	}
+}
@@ -4,21 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"BRSBackend/pkg/clock"
 	"BRSBackend/pkg/models"
 	"BRSBackend/pkg/repository"
 )
 
 type sessionRepository struct {
-	db *gorm.DB
+	db    *gorm.DB
+	clock clock.Clock
 }
 
-func NewSessionRepository(db *gorm.DB) repository.SessionRepository {
-	return &sessionRepository{db: db}
+func NewSessionRepository(db *gorm.DB, clock clock.Clock) repository.SessionRepository {
+	return &sessionRepository{db: db, clock: clock}
 }
 
 func (s *sessionRepository) Create(ctx context.Context, session *models.Session) error {
@@ -31,7 +32,7 @@ func (s *sessionRepository) Create(ctx context.Context, session *models.Session)
 
 func (s *sessionRepository) GetByID(ctx context.Context, sessionId string) (*models.Session, error) {
 	var session models.Session
-	if err := s.db.WithContext(ctx).Where("id = ? AND expires_at > ?", sessionId, time.Now()).
+	if err := s.db.WithContext(ctx).Where("id = ? AND expires_at > ?", sessionId, s.clock.Now()).
 		Preload("Librarian").First(&session).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fmt.Errorf("session not found")
@@ -47,7 +48,7 @@ func (s *sessionRepository) DeleteByID(ctx context.Context, sessionId string) er
 }
 
 func (s *sessionRepository) DeleteExpired() error {
-	return s.db.Where("expires_at <= ?", time.Now()).Delete(&models.Session{}).Error
+	return s.db.Where("expires_at <= ?", s.clock.Now()).Delete(&models.Session{}).Error
 }
 
 func (s *sessionRepository) DeleteByLibrarianID(ctx context.Context, librarianId uuid.UUID) error {
@@ -4,24 +4,35 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pagination"
 	"BRSBackend/pkg/repository"
+	"BRSBackend/pkg/search"
 )
 
 type studentRepository struct {
-	db *gorm.DB
+	db    *gorm.DB
+	clock clock.Clock
 }
 
-func NewStudentRepository(db *gorm.DB) repository.StudentRepository {
-	return &studentRepository{db: db}
+func NewStudentRepository(db *gorm.DB, clock clock.Clock) repository.StudentRepository {
+	return &studentRepository{db: db, clock: clock}
 }
 
 func (s studentRepository) Create(ctx context.Context, student *models.Student) error {
 	if err := s.db.WithContext(ctx).Create(student).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return apperrors.Conflict("student_card_exists", "student with this card already exists").Wrap(err)
+		}
 		return fmt.Errorf("failed to create student: %w", err)
 	}
 
@@ -32,7 +43,7 @@ func (s studentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.S
 	var student models.Student
 	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&student).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("student not found")
+			return nil, apperrors.NotFound("student_not_found", "student not found")
 		}
 		return nil, fmt.Errorf("failed to get student: %w", err)
 	}
@@ -40,11 +51,19 @@ func (s studentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.S
 	return &student, nil
 }
 
+func (s studentRepository) GetStudentsByIDs(ctx context.Context, studentIDs []uuid.UUID) ([]*models.Student, error) {
+	var students []*models.Student
+	if err := s.db.WithContext(ctx).Where("id IN ?", studentIDs).Find(&students).Error; err != nil {
+		return nil, fmt.Errorf("failed to get students: %w", err)
+	}
+	return students, nil
+}
+
 func (s studentRepository) GetByCardID(ctx context.Context, cardID string) (*models.Student, error) {
 	var student models.Student
 	if err := s.db.WithContext(ctx).Where("card_id = ?", cardID).First(&student).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("student not found")
+			return nil, apperrors.NotFound("student_not_found", "student not found")
 		}
 		return nil, fmt.Errorf("failed to get student: %w", err)
 	}
@@ -52,31 +71,149 @@ func (s studentRepository) GetByCardID(ctx context.Context, cardID string) (*mod
 	return &student, nil
 }
 
-func (s studentRepository) GetAll(ctx context.Context, offset, limit int) ([]*models.Student, int64, error) {
+var studentSortFields = pagination.Fields{
+	"name":       {Column: "first_name || ' ' || last_name", Kind: pagination.String},
+	"major":      {Column: "major", Kind: pagination.String},
+	"created_at": {Column: "created_at", Kind: pagination.Time},
+}
+
+// prefixMatch matches rows whose folded column starts with prefix as an
+// index range scan.
+func prefixMatch(column, prefix string) (string, []any) {
+	return fmt.Sprintf("(%s >= ? AND %s < ?)", column, column), []any{prefix, search.PrefixEnd(prefix)}
+}
+
+// studentSearch matches a phone-like query against the start or end of phone
+// numbers and card ids, a single word against first name, last name or card
+// id prefixes, and several words against first or last name prefixes, all of
+// which must match.
+func studentSearch(query string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		folded := search.Fold(query)
+		if folded == "" {
+			return db
+		}
+
+		var conds []string
+		var args []any
+		add := func(column, prefix string) {
+			cond, condArgs := prefixMatch(column, prefix)
+			conds = append(conds, cond)
+			args = append(args, condArgs...)
+		}
+
+		words := strings.Fields(folded)
+		switch {
+		case search.IsPhoneLike(folded):
+			digits := search.Digits(folded)
+			add("search_phone", digits)
+			add("search_phone_rev", search.Reverse(digits))
+			add("search_card_id", folded)
+		case len(words) == 1:
+			add("search_first_name", folded)
+			add("search_last_name", folded)
+			add("search_card_id", folded)
+		default:
+			for _, word := range words {
+				first, firstArgs := prefixMatch("search_first_name", word)
+				last, lastArgs := prefixMatch("search_last_name", word)
+				db = db.Where("("+first+" OR "+last+")", append(firstArgs, lastArgs...)...)
+			}
+			return db
+		}
+		return db.Where("("+strings.Join(conds, " OR ")+")", args...)
+	}
+}
+
+func studentFilters(filters dto.StudentFilters, now time.Time) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if major := search.Fold(filters.Major); major != "" {
+			db = db.Where("search_major = ?", major)
+		}
+		if filters.HasActiveRentals != nil {
+			db = db.Where(rentingClause(*filters.HasActiveRentals, ""), "RENTED")
+		}
+		if filters.HasOverdue != nil {
+			db = db.Where(rentingClause(*filters.HasOverdue, "AND julianday(?) - julianday(created_at) > ?"),
+				"RENTED", now.UTC(), filters.OverdueDays)
+		}
+		return db
+	}
+}
+
+// rentingClause selects students with (or without) a rented cart matching
+// extra. The subquery is driven by the carts table, which holds far fewer
+// rented carts than there are students.
+func rentingClause(renting bool, extra string) string {
+	op := "IN"
+	if !renting {
+		op = "NOT IN"
+	}
+	return fmt.Sprintf(`students.id %s (
+		SELECT student_id FROM carts
+		WHERE status = ? AND deleted_at IS NULL %s
+	)`, op, extra)
+}
+
+func (s studentRepository) GetAll(ctx context.Context, params dto.PaginationParams, filters dto.StudentFilters) ([]*models.Student, int64, pagination.Links, error) {
 	var students []*models.Student
 	var total int64
 
-	if err := s.db.WithContext(ctx).
-		Model(&models.Student{}).
-		Count(&total).Error; err != nil {
-		return nil, 0, fmt.Errorf("failed to count students: %w", err)
+	page, err := pagination.New(params.Sort, params.Cursor, params.Limit, params.Offset,
+		studentSortFields, pagination.Sort{Key: "created_at", Desc: true}, "id")
+	if err != nil {
+		return nil, 0, pagination.Links{}, err
+	}
+
+	query := s.db.WithContext(ctx).Model(&models.Student{}).
+		Scopes(studentSearch(params.Query), studentFilters(filters, s.clock.Now()))
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to count students: %w", err)
 	}
 
-	if err := s.db.WithContext(ctx).
-		Order("created_at DESC").
-		Offset(offset).
-		Limit(limit).
-		Find(&students).Error; err != nil {
-		return nil, 0, fmt.Errorf("failed to get students: %w", err)
+	if err := query.Scopes(page.Scope).Find(&students).Error; err != nil {
+		return nil, 0, pagination.Links{}, fmt.Errorf("failed to get students: %w", err)
 	}
 
-	return students, total, nil
+	students, links := pagination.Window(page, students, func(student *models.Student) (any, string) {
+		return studentSortValue(student, page.Sort.Key), student.Id.String()
+	})
+	return students, total, links, nil
+}
+
+func studentSortValue(student *models.Student, key string) any {
+	switch key {
+	case "name":
+		return student.FirstName + " " + student.LastName
+	case "major":
+		return student.Major
+	default:
+		return student.CreatedAt
+	}
 }
 
 func (s studentRepository) Update(ctx context.Context, student *models.Student) error {
-	panic("implement me")
+	result := s.db.WithContext(ctx).Model(student).Where("id = ?", student.Id).
+		Select("first_name", "last_name", "major", "phone", "email",
+			"search_first_name", "search_last_name", "search_card_id", "search_phone", "search_phone_rev", "search_major").
+		Updates(student)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update student: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("student_not_found", "student not found")
+	}
+	return nil
 }
 
 func (s studentRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return s.db.WithContext(ctx).Where("id = ?", id).Delete(&models.Student{}).Error
+	result := s.db.WithContext(ctx).Where("id = ?", id).Delete(&models.Student{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete student: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("student_not_found", "student not found")
+	}
+	return nil
 }
@@ -0,0 +1,146 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+type studentAuthRepository struct {
+	db    *gorm.DB
+	clock clock.Clock
+}
+
+func NewStudentAuthRepository(db *gorm.DB, clock clock.Clock) repository.StudentAuthRepository {
+	return &studentAuthRepository{db: db, clock: clock}
+}
+
+func (s *studentAuthRepository) GetCredential(ctx context.Context, studentID uuid.UUID) (*models.StudentCredential, error) {
+	var credential models.StudentCredential
+	if err := s.db.WithContext(ctx).Where("student_id = ?", studentID).First(&credential).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("student_credential_not_found", "student has no PIN")
+		}
+		return nil, fmt.Errorf("failed to get student credential: %w", err)
+	}
+	return &credential, nil
+}
+
+// SaveCredential sets the PIN of a student and clears its failed attempts.
+func (s *studentAuthRepository) SaveCredential(ctx context.Context, credential *models.StudentCredential) error {
+	err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "student_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"pin_hash", "failed_attempts", "locked_until", "updated_at"}),
+	}).Create(credential).Error
+	if err != nil {
+		return fmt.Errorf("failed to save student credential: %w", err)
+	}
+	return nil
+}
+
+// RecordFailedAttempt counts a wrong PIN. The attempt that reaches
+// maxAttempts locks the PIN until lockUntil and starts the count over.
+func (s *studentAuthRepository) RecordFailedAttempt(ctx context.Context, studentID uuid.UUID, maxAttempts int, lockUntil time.Time) error {
+	err := s.db.WithContext(ctx).Model(&models.StudentCredential{}).
+		Where("student_id = ?", studentID).
+		Updates(map[string]any{
+			"locked_until":    gorm.Expr("CASE WHEN failed_attempts + 1 >= ? THEN ? ELSE locked_until END", maxAttempts, lockUntil),
+			"failed_attempts": gorm.Expr("CASE WHEN failed_attempts + 1 >= ? THEN 0 ELSE failed_attempts + 1 END", maxAttempts),
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to record failed attempt: %w", err)
+	}
+	return nil
+}
+
+func (s *studentAuthRepository) ResetFailedAttempts(ctx context.Context, studentID uuid.UUID) error {
+	err := s.db.WithContext(ctx).Model(&models.StudentCredential{}).
+		Where("student_id = ? AND failed_attempts > 0", studentID).
+		Update("failed_attempts", 0).Error
+	if err != nil {
+		return fmt.Errorf("failed to reset failed attempts: %w", err)
+	}
+	return nil
+}
+
+func (s *studentAuthRepository) CreateSession(ctx context.Context, session *models.StudentSession) error {
+	if err := s.db.WithContext(ctx).Create(session).Error; err != nil {
+		return fmt.Errorf("failed to create student session: %w", err)
+	}
+	return nil
+}
+
+func (s *studentAuthRepository) GetSession(ctx context.Context, sessionID string) (*models.StudentSession, error) {
+	var session models.StudentSession
+	if err := s.db.WithContext(ctx).Where("id = ? AND expires_at > ?", sessionID, s.clock.Now()).
+		Preload("Student").First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("student session not found")
+		}
+		return nil, fmt.Errorf("failed to get student session: %w", err)
+	}
+	return &session, nil
+}
+
+func (s *studentAuthRepository) DeleteSession(ctx context.Context, sessionID string) error {
+	return s.db.WithContext(ctx).Where("id = ?", sessionID).Delete(&models.StudentSession{}).Error
+}
+
+func (s *studentAuthRepository) DeleteSessionsByStudent(ctx context.Context, studentID uuid.UUID) error {
+	return s.db.WithContext(ctx).Where("student_id = ?", studentID).Delete(&models.StudentSession{}).Error
+}
+
+func (s *studentAuthRepository) CreateLoginToken(ctx context.Context, token *models.StudentLoginToken) error {
+	if err := s.db.WithContext(ctx).Create(token).Error; err != nil {
+		return fmt.Errorf("failed to create login token: %w", err)
+	}
+	return nil
+}
+
+// UseLoginToken marks an unexpired token as used and returns it. A token can
+// be used once.
+func (s *studentAuthRepository) UseLoginToken(ctx context.Context, tokenHash string) (*models.StudentLoginToken, error) {
+	now := s.clock.Now()
+	var token models.StudentLoginToken
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.StudentLoginToken{}).
+			Where("token_hash = ? AND used_at IS NULL AND expires_at > ?", tokenHash, now).
+			Update("used_at", now)
+		if result.Error != nil {
+			return fmt.Errorf("failed to use login token: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.Unauthorized("invalid_login_token", "sign-in link is invalid or expired")
+		}
+		if err := tx.Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+			return fmt.Errorf("failed to get login token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// DeleteExpired deletes the expired sessions and login tokens.
+func (s *studentAuthRepository) DeleteExpired(ctx context.Context) error {
+	now := s.clock.Now()
+	if err := s.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&models.StudentSession{}).Error; err != nil {
+		return fmt.Errorf("failed to delete expired student sessions: %w", err)
+	}
+	if err := s.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&models.StudentLoginToken{}).Error; err != nil {
+		return fmt.Errorf("failed to delete expired login tokens: %w", err)
+	}
+	return nil
+}
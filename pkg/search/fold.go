@@ -0,0 +1,69 @@
+// Package search normalizes text into keys that can be matched with plain
+// index range scans, so lookups stay fast without LIKE '%...%' table scans.
+package search
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Letters that do not decompose into a base letter and a combining mark.
+var foldReplacer = strings.NewReplacer(
+	"ß", "ss", "æ", "ae", "œ", "oe", "ø", "o", "ł", "l", "đ", "d", "ð", "d", "þ", "th", "ı", "i",
+)
+
+// Fold lowercases s, strips diacritics and collapses whitespace, so that
+// "  García " and "garcia" produce the same key.
+func Fold(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, strings.ToLower(s))
+	if err != nil {
+		folded = strings.ToLower(s)
+	}
+	return strings.Join(strings.Fields(foldReplacer.Replace(folded)), " ")
+}
+
+// Digits keeps only the ASCII digits of s.
+func Digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// Reverse reverses s byte by byte; it is meant for ASCII keys such as
+// Digits output, where a reversed column turns suffix matches into prefix
+// matches.
+func Reverse(s string) string {
+	b := []byte(s)
+	slices.Reverse(b)
+	return string(b)
+}
+
+// IsPhoneLike reports whether s looks like (part of) a phone number: digits
+// with optional separators.
+func IsPhoneLike(s string) bool {
+	if Digits(s) == "" {
+		return false
+	}
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789+-(). ", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// PrefixEnd returns the smallest string greater than every string starting
+// with prefix, so "col >= prefix AND col < PrefixEnd(prefix)" is a prefix
+// match that can use a plain (binary collated) index.
+func PrefixEnd(prefix string) string {
+	return prefix + string(unicode.MaxRune)
+}
@@ -0,0 +1,37 @@
+package search
+
+import "testing"
+
+func TestFold(t *testing.T) {
+	tests := map[string]string{
+		"García":           "garcia",
+		"  JOSÉ   Müller ": "jose muller",
+		"Łukasz Øster":     "lukasz oster",
+		"Straße":           "strasse",
+		"HVB-020":          "hvb-020",
+	}
+	for in, want := range tests {
+		if got := Fold(in); got != want {
+			t.Errorf("Fold(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestReverse(t *testing.T) {
+	if got := Reverse("5550102000"); got != "0002010555" {
+		t.Errorf("Reverse = %q", got)
+	}
+}
+
+func TestIsPhoneLike(t *testing.T) {
+	for _, s := range []string{"555", "+1 (555) 010-2000", "555.010"} {
+		if !IsPhoneLike(s) {
+			t.Errorf("expected %q to be phone-like", s)
+		}
+	}
+	for _, s := range []string{"", "garcia", "HVB020", "- ()"} {
+		if IsPhoneLike(s) {
+			t.Errorf("expected %q not to be phone-like", s)
+		}
+	}
+}
@@ -8,8 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
 	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
 	"BRSBackend/pkg/repository"
@@ -19,6 +22,7 @@ type AuthService interface {
 	Login(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, string, error)
 	ValidateSession(ctx context.Context, sessionId string) (*models.Librarian, error)
 	Logout(ctx context.Context, sessionId string) error
+	LoginExternal(ctx context.Context, identity dto.ExternalIdentity) (*dto.LoginResponse, string, error)
 	CreateLibrarian(ctx context.Context, username, password string) error
 	CleanupExpiredSessions() error
 	GetLibrarian(ctx context.Context, sessionId string) (*dto.LoginResponse, error)
@@ -27,16 +31,21 @@ type AuthService interface {
 type authService struct {
 	librarianRepo repository.LibrarianRepository
 	sessionRepo   repository.SessionRepository
+	clock         clock.Clock
 }
 
-func NewAuthService(librarianRepo repository.LibrarianRepository, sessionRepo repository.SessionRepository) AuthService {
+func NewAuthService(librarianRepo repository.LibrarianRepository, sessionRepo repository.SessionRepository, clock clock.Clock) AuthService {
 	return &authService{
 		librarianRepo: librarianRepo,
 		sessionRepo:   sessionRepo,
+		clock:         clock,
 	}
 }
 
 func (a *authService) GetLibrarian(ctx context.Context, sessionId string) (*dto.LoginResponse, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.GetLibrarian")
+	defer span.End()
+
 	if sessionId == "" {
 		return nil, errors.New("no session provided")
 	}
@@ -55,27 +64,66 @@ func (a *authService) GetLibrarian(ctx context.Context, sessionId string) (*dto.
 }
 
 func (a *authService) Login(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, string, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.Login")
+	defer span.End()
+
 	librarian, err := a.librarianRepo.GetByUsername(ctx, req.User)
 	if err != nil {
-		return nil, "", errors.New("invalid credentials")
+		return nil, "", apperrors.Unauthorized("invalid_credentials", "invalid credentials")
 	}
 	if err := bcrypt.CompareHashAndPassword(librarian.Pass, []byte(req.Pass)); err != nil {
-		return nil, "", errors.New("invalid credentials")
+		return nil, "", apperrors.Unauthorized("invalid_credentials", "invalid credentials")
 	}
 
-	sessionId, err := a.generateSessionID()
+	sessionId, err := a.createSession(ctx, librarian.Id)
 	if err != nil {
-		return nil, "", errors.New("failed to create session")
+		return nil, "", err
 	}
 
-	session := &models.Session{
-		Id:          sessionId,
+	response := &dto.LoginResponse{
+		Message:     "Login successful",
 		LibrarianId: librarian.Id,
-		ExpiresAt:   time.Now().Add(24 * time.Hour),
 	}
 
-	if err := a.sessionRepo.Create(ctx, session); err != nil {
-		return nil, "", fmt.Errorf("failed to create session: %w", err)
+	return response, sessionId, nil
+}
+
+func (a *authService) LoginExternal(ctx context.Context, identity dto.ExternalIdentity) (*dto.LoginResponse, string, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.LoginExternal")
+	defer span.End()
+
+	if identity.Subject == "" || identity.Username == "" {
+		return nil, "", errors.New("incomplete external identity")
+	}
+
+	librarian, err := a.librarianRepo.GetByOIDCSubject(ctx, identity.Subject)
+	if err != nil && !errors.Is(err, apperrors.ErrNotFound) {
+		return nil, "", fmt.Errorf("failed to look up external identity: %w", err)
+	}
+	if librarian == nil {
+		if _, err := a.librarianRepo.GetByUsername(ctx, identity.Username); err == nil {
+			return nil, "", apperrors.Conflict("librarian_exists", "librarian already exists")
+		}
+
+		subject := identity.Subject
+		librarian = &models.Librarian{
+			User:        identity.Username,
+			Pass:        []byte{},
+			Role:        identity.Role,
+			OIDCSubject: &subject,
+		}
+		if err := a.librarianRepo.Create(ctx, librarian); err != nil {
+			return nil, "", err
+		}
+	} else if librarian.Role != identity.Role {
+		if err := a.librarianRepo.UpdateRole(ctx, librarian.Id, identity.Role); err != nil {
+			return nil, "", err
+		}
+	}
+
+	sessionId, err := a.createSession(ctx, librarian.Id)
+	if err != nil {
+		return nil, "", err
 	}
 
 	response := &dto.LoginResponse{
@@ -86,7 +134,29 @@ func (a *authService) Login(ctx context.Context, req dto.LoginRequest) (*dto.Log
 	return response, sessionId, nil
 }
 
+func (a *authService) createSession(ctx context.Context, librarianId uuid.UUID) (string, error) {
+	sessionId, err := a.generateSessionID()
+	if err != nil {
+		return "", errors.New("failed to create session")
+	}
+
+	session := &models.Session{
+		Id:          sessionId,
+		LibrarianId: librarianId,
+		ExpiresAt:   a.clock.Now().Add(24 * time.Hour),
+	}
+
+	if err := a.sessionRepo.Create(ctx, session); err != nil {
+		return "", fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return sessionId, nil
+}
+
 func (a *authService) ValidateSession(ctx context.Context, sessionId string) (*models.Librarian, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.ValidateSession")
+	defer span.End()
+
 	if sessionId == "" {
 		return nil, errors.New("no session provided")
 	}
@@ -100,6 +170,9 @@ func (a *authService) ValidateSession(ctx context.Context, sessionId string) (*m
 }
 
 func (a *authService) Logout(ctx context.Context, sessionId string) error {
+	ctx, span := tracer.Start(ctx, "AuthService.Logout")
+	defer span.End()
+
 	if sessionId == "" {
 		return nil
 	}
@@ -107,8 +180,11 @@ func (a *authService) Logout(ctx context.Context, sessionId string) error {
 }
 
 func (a *authService) CreateLibrarian(ctx context.Context, username, password string) error {
+	ctx, span := tracer.Start(ctx, "AuthService.CreateLibrarian")
+	defer span.End()
+
 	if _, err := a.librarianRepo.GetByUsername(ctx, username); err == nil {
-		return errors.New("librarian already exists")
+		return apperrors.Conflict("librarian_exists", "librarian already exists")
 	}
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -2,10 +2,13 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
+	"BRSBackend/pkg/apperrors"
 	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
 	"BRSBackend/pkg/repository"
@@ -14,35 +17,101 @@ import (
 type BookService interface {
 	CreateBook(ctx context.Context, book *models.Book) error
 	GetBookByID(ctx context.Context, id string) (*models.Book, error)
-	GetAllBooks(ctx context.Context, params dto.PaginationParams) (*dto.BooksResponse, error)
+	GetAllBooks(ctx context.Context, params dto.PaginationParams, filters dto.BookFilters) (*dto.BooksResponse, error)
 	DeleteBook(ctx context.Context, id string) error
+	ClassifyBook(ctx context.Context, id string, classification dto.BookClassification) (*models.Book, error)
+	ListTags(ctx context.Context) ([]dto.TagCount, error)
 }
 
 type bookService struct {
-	repo repository.BookRepository
+	repo         repository.BookRepository
+	categoryRepo repository.CategoryRepository
 }
 
-func NewBookService(repo repository.BookRepository) BookService {
+func NewBookService(repo repository.BookRepository, categoryRepo repository.CategoryRepository) BookService {
 	return &bookService{
-		repo: repo,
+		repo:         repo,
+		categoryRepo: categoryRepo,
 	}
 }
 
 func (b *bookService) CreateBook(ctx context.Context, book *models.Book) error {
+	ctx, span := tracer.Start(ctx, "BookService.CreateBook")
+	defer span.End()
+
+	if book.Barcode != nil && models.IsGeneratedBarcode(strings.TrimSpace(*book.Barcode)) {
+		return apperrors.Validation("reserved_barcode", "barcodes starting with "+models.BookBarcodePrefix+" are generated",
+			apperrors.FieldError{Field: "barcode", Code: "reserved_barcode", Message: "barcodes starting with " + models.BookBarcodePrefix + " are reserved for generated ones; leave barcode empty to get one"})
+	}
+	if err := b.checkCategory(ctx, book.CategoryId); err != nil {
+		return err
+	}
 	return b.repo.Create(ctx, book)
 }
 
+// checkCategory fails with a validation error when the book is filed under a
+// category that does not exist.
+func (b *bookService) checkCategory(ctx context.Context, id *uuid.UUID) error {
+	if id == nil {
+		return nil
+	}
+	if _, err := b.categoryRepo.GetByID(ctx, *id); err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return apperrors.Validation("category_not_found", "category does not exist",
+				apperrors.FieldError{Field: "category_id", Code: "category_not_found", Message: fmt.Sprintf("no category with id %s", id)})
+		}
+		return err
+	}
+	return nil
+}
+
+func (b *bookService) ClassifyBook(ctx context.Context, uid string, classification dto.BookClassification) (*models.Book, error) {
+	ctx, span := tracer.Start(ctx, "BookService.ClassifyBook")
+	defer span.End()
+
+	id, err := uuid.Parse(uid)
+	if err != nil {
+		return nil, apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	book, err := b.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.checkCategory(ctx, classification.CategoryID); err != nil {
+		return nil, err
+	}
+
+	book.CategoryId = classification.CategoryID
+	book.CallNumber = classification.CallNumber
+	book.Tags = classification.Tags
+	if err := b.repo.UpdateClassification(ctx, book); err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+func (b *bookService) ListTags(ctx context.Context) ([]dto.TagCount, error) {
+	ctx, span := tracer.Start(ctx, "BookService.ListTags")
+	defer span.End()
+
+	return b.repo.GetTags(ctx)
+}
+
 func (b *bookService) GetBookByID(ctx context.Context, uid string) (*models.Book, error) {
+	ctx, span := tracer.Start(ctx, "BookService.GetBookByID")
+	defer span.End()
 
 	id, err := uuid.Parse(uid)
 	if err != nil {
-		return nil, fmt.Errorf("invalid id format: %w", err)
+		return nil, apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
 	}
 
 	return b.repo.GetByID(ctx, id)
 }
 
-func (b *bookService) GetAllBooks(ctx context.Context, params dto.PaginationParams) (*dto.BooksResponse, error) {
+func (b *bookService) GetAllBooks(ctx context.Context, params dto.PaginationParams, filters dto.BookFilters) (*dto.BooksResponse, error) {
+	ctx, span := tracer.Start(ctx, "BookService.GetAllBooks")
+	defer span.End()
 
 	if params.Limit <= 0 {
 		params.Limit = 10
@@ -54,32 +123,65 @@ func (b *bookService) GetAllBooks(ctx context.Context, params dto.PaginationPara
 		params.Offset = 0
 	}
 
-	books, total, err := b.repo.GetAll(ctx, params)
+	if err := validateBookFilters(filters); err != nil {
+		return nil, err
+	}
+
+	books, total, links, err := b.repo.GetAll(ctx, params, filters)
 	if err != nil {
 		return nil, err
 	}
 
-	pagination := dto.PaginationInfo{
-		Offset:      params.Offset,
-		Limit:       params.Limit,
-		Total:       int(total),
-		HasNext:     int64(params.Offset+params.Limit) < total,
-		HasPrevious: params.Offset > 0,
+	facets, err := b.repo.GetFacets(ctx, params.Query, filters)
+	if err != nil {
+		return nil, err
 	}
 
 	response := &dto.BooksResponse{
 		Results:    books,
-		Pagination: pagination,
+		Pagination: dto.NewPaginationInfo(params.Offset, params.Limit, total, links),
+		Facets:     *facets,
 	}
 
 	return response, nil
 }
 
+func validateBookFilters(filters dto.BookFilters) error {
+	var fields []apperrors.FieldError
+
+	switch filters.Availability {
+	case "", dto.AvailabilityAvailable, dto.AvailabilityOutOfStock:
+	default:
+		fields = append(fields, apperrors.FieldError{
+			Field:   "availability",
+			Code:    "oneof",
+			Message: "availability must be one of available, out_of_stock",
+		})
+	}
+	if filters.MinCount != nil && *filters.MinCount < 0 {
+		fields = append(fields, apperrors.FieldError{Field: "min_count", Code: "min", Message: "min_count must be 0 or greater"})
+	}
+	if filters.MinCount != nil && filters.MaxCount != nil && *filters.MinCount > *filters.MaxCount {
+		fields = append(fields, apperrors.FieldError{Field: "max_count", Code: "gtefield", Message: "max_count must be greater than or equal to min_count"})
+	}
+	if filters.AddedFrom != nil && filters.AddedTo != nil && filters.AddedTo.Before(*filters.AddedFrom) {
+		fields = append(fields, apperrors.FieldError{Field: "added_to", Code: "gtefield", Message: "added_to must not be before added_from"})
+	}
+
+	if len(fields) > 0 {
+		return apperrors.Validation("invalid_filter", "book filters are invalid", fields...)
+	}
+	return nil
+}
+
 func (b *bookService) DeleteBook(ctx context.Context, uid string) error {
+	ctx, span := tracer.Start(ctx, "BookService.DeleteBook")
+	defer span.End()
+
 	id, err := uuid.Parse(uid)
 
 	if err != nil {
-		return fmt.Errorf("invalid uuid format: %w", err)
+		return apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
 	}
 
 	return b.repo.Delete(ctx, id)
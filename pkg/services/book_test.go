@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/models"
+)
+
+func (b *bookRepository) Create(ctx context.Context, book *models.Book) error {
+	b.books = append(b.books, book)
+	return nil
+}
+
+func TestCreateBookBarcode(t *testing.T) {
+	tests := []struct {
+		name     string
+		barcode  string
+		reserved bool
+	}{
+		{"generated form", "BRS00000042", true},
+		{"prefix with spaces", " brs-7 ", true},
+		{"EAN-8", "96385074", false},
+		{"EAN-13", "9780441172719", false},
+		{"letters", "LIB-0042", false},
+	}
+	for _, tt := range tests {
+		repo := &bookRepository{}
+		svc := NewBookService(repo, &categoryRepository{})
+
+		barcode := tt.barcode
+		err := svc.CreateBook(context.Background(), &models.Book{Title: "Dune", Barcode: &barcode})
+		appErr, ok := apperrors.As(err)
+		if tt.reserved && (!ok || appErr.Code != "reserved_barcode" || len(repo.books) != 0) {
+			t.Errorf("%s: expected reserved_barcode, got %v", tt.name, err)
+		}
+		if !tt.reserved && (err != nil || len(repo.books) != 1) {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+// CategoryService manages the category tree books are filed under. Browsing
+// returns the tree with the books and copies of every subtree.
+type CategoryService interface {
+	ListCategories(ctx context.Context) ([]*dto.CategoryNode, error)
+	GetCategory(ctx context.Context, id string) (*dto.CategoryNode, error)
+	CreateCategory(ctx context.Context, req dto.CategoryRequest) (*models.Category, error)
+	UpdateCategory(ctx context.Context, id string, req dto.CategoryRequest) (*models.Category, error)
+	DeleteCategory(ctx context.Context, id string) error
+}
+
+type categoryService struct {
+	repo repository.CategoryRepository
+}
+
+func NewCategoryService(repo repository.CategoryRepository) CategoryService {
+	return &categoryService{repo: repo}
+}
+
+func (s *categoryService) ListCategories(ctx context.Context) ([]*dto.CategoryNode, error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.ListCategories")
+	defer span.End()
+
+	roots, _, err := s.tree(ctx)
+	return roots, err
+}
+
+func (s *categoryService) GetCategory(ctx context.Context, id string) (*dto.CategoryNode, error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.GetCategory")
+	defer span.End()
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	_, nodes, err := s.tree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	node, ok := nodes[uid]
+	if !ok {
+		return nil, apperrors.NotFound("category_not_found", "category not found")
+	}
+	return node, nil
+}
+
+// tree builds the category tree, returning its roots and every node by id.
+// Siblings keep the order of the repository, by name.
+func (s *categoryService) tree(ctx context.Context) ([]*dto.CategoryNode, map[uuid.UUID]*dto.CategoryNode, error) {
+	categories, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	counts, err := s.repo.GetCounts(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodes := make(map[uuid.UUID]*dto.CategoryNode, len(categories))
+	for _, c := range categories {
+		nodes[c.Id] = &dto.CategoryNode{ID: c.Id, Name: c.Name, ParentID: c.ParentId, Children: []*dto.CategoryNode{}}
+	}
+	roots := []*dto.CategoryNode{}
+	for _, c := range categories {
+		if c.ParentId != nil && nodes[*c.ParentId] != nil {
+			parent := nodes[*c.ParentId]
+			parent.Children = append(parent.Children, nodes[c.Id])
+		} else {
+			roots = append(roots, nodes[c.Id])
+		}
+	}
+
+	// The books of a category count towards all its ancestors.
+	for _, count := range counts {
+		for node := nodes[count.CategoryID]; node != nil; {
+			node.Books += count.Books
+			node.Copies += count.Copies
+			node.Available += count.Available
+			if node.ParentID == nil {
+				break
+			}
+			node = nodes[*node.ParentID]
+		}
+	}
+	return roots, nodes, nil
+}
+
+func (s *categoryService) CreateCategory(ctx context.Context, req dto.CategoryRequest) (*models.Category, error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.CreateCategory")
+	defer span.End()
+
+	category := &models.Category{}
+	if err := s.apply(ctx, category, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, category); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+func (s *categoryService) UpdateCategory(ctx context.Context, id string, req dto.CategoryRequest) (*models.Category, error) {
+	ctx, span := tracer.Start(ctx, "CategoryService.UpdateCategory")
+	defer span.End()
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	category, err := s.repo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.apply(ctx, category, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, category); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// apply sets the name and parent of the category after checking that the
+// parent exists and is not the category itself or one of its descendants.
+func (s *categoryService) apply(ctx context.Context, category *models.Category, req dto.CategoryRequest) error {
+	name := strings.Join(strings.Fields(req.Name), " ")
+	if name == "" {
+		return apperrors.Validation("invalid_name", "category name is required",
+			apperrors.FieldError{Field: "name", Code: "required", Message: "name must not be blank"})
+	}
+
+	for parentID := req.ParentID; parentID != nil; {
+		if *parentID == category.Id {
+			return apperrors.Validation("category_cycle", "a category cannot be moved under itself",
+				apperrors.FieldError{Field: "parent_id", Code: "category_cycle", Message: fmt.Sprintf("category %s is the category or one of its subcategories", req.ParentID)})
+		}
+		parent, err := s.repo.GetByID(ctx, *parentID)
+		if err != nil {
+			if errors.Is(err, apperrors.ErrNotFound) {
+				return apperrors.Validation("parent_not_found", "parent category does not exist",
+					apperrors.FieldError{Field: "parent_id", Code: "parent_not_found", Message: fmt.Sprintf("no category with id %s", parentID)})
+			}
+			return err
+		}
+		parentID = parent.ParentId
+	}
+
+	category.Name = name
+	category.ParentId = req.ParentID
+	return nil
+}
+
+func (s *categoryService) DeleteCategory(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "CategoryService.DeleteCategory")
+	defer span.End()
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	return s.repo.Delete(ctx, uid)
+}
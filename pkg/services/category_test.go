@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+type categoryRepository struct {
+	repository.CategoryRepository
+	categories []*models.Category
+	counts     []dto.CategoryCount
+	updated    *models.Category
+}
+
+func (c *categoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Category, error) {
+	for _, category := range c.categories {
+		if category.Id == id {
+			copied := *category
+			return &copied, nil
+		}
+	}
+	return nil, apperrors.NotFound("category_not_found", "category not found")
+}
+
+func (c *categoryRepository) GetAll(ctx context.Context) ([]*models.Category, error) {
+	return c.categories, nil
+}
+
+func (c *categoryRepository) GetCounts(ctx context.Context) ([]dto.CategoryCount, error) {
+	return c.counts, nil
+}
+
+func (c *categoryRepository) Update(ctx context.Context, category *models.Category) error {
+	c.updated = category
+	return nil
+}
+
+func (b *bookRepository) UpdateClassification(ctx context.Context, book *models.Book) error {
+	return nil
+}
+
+// categoryTree builds Fiction > Science Fiction > Cyberpunk and a separate root,
+// History.
+func categoryTree() (repo *categoryRepository, fiction, scifi, cyberpunk, history uuid.UUID) {
+	fiction, scifi, cyberpunk, history = uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	repo = &categoryRepository{categories: []*models.Category{
+		{Id: cyberpunk, Name: "Cyberpunk", ParentId: &scifi},
+		{Id: fiction, Name: "Fiction"},
+		{Id: history, Name: "History"},
+		{Id: scifi, Name: "Science Fiction", ParentId: &fiction},
+	}}
+	return
+}
+
+func TestListCategories(t *testing.T) {
+	repo, fictionID, scifiID, cyberpunkID, historyID := categoryTree()
+	repo.counts = []dto.CategoryCount{
+		{CategoryID: fictionID, Books: 2, Copies: 5, Available: 4},
+		{CategoryID: scifiID, Books: 1, Copies: 3, Available: 1},
+		{CategoryID: cyberpunkID, Books: 1, Copies: 2, Available: 2},
+	}
+	svc := NewCategoryService(repo)
+
+	roots, err := svc.ListCategories(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(roots) != 2 || roots[0].ID != fictionID || roots[1].ID != historyID {
+		t.Fatalf("expected roots Fiction and History, got %+v", roots)
+	}
+
+	fiction := roots[0]
+	if fiction.Books != 4 || fiction.Copies != 10 || fiction.Available != 7 {
+		t.Errorf("expected Fiction to count its subtree, got %d books, %d copies, %d available", fiction.Books, fiction.Copies, fiction.Available)
+	}
+	if len(fiction.Children) != 1 || fiction.Children[0].ID != scifiID {
+		t.Fatalf("expected Science Fiction under Fiction, got %+v", fiction.Children)
+	}
+	if scifi := fiction.Children[0]; scifi.Books != 2 || scifi.Copies != 5 || scifi.Available != 3 {
+		t.Errorf("unexpected Science Fiction counts %+v", scifi)
+	}
+	if history := roots[1]; history.Books != 0 || history.Children == nil {
+		t.Errorf("expected an empty History with no children, got %+v", history)
+	}
+
+	node, err := svc.GetCategory(context.Background(), scifiID.String())
+	if err != nil || node.Children[0].ID != cyberpunkID {
+		t.Errorf("expected the Science Fiction subtree, got %+v, %v", node, err)
+	}
+}
+
+func TestUpdateCategory(t *testing.T) {
+	t.Run("moves a category", func(t *testing.T) {
+		repo, _, scifiID, _, historyID := categoryTree()
+		svc := NewCategoryService(repo)
+
+		category, err := svc.UpdateCategory(context.Background(), scifiID.String(), dto.CategoryRequest{Name: " Alternate  History ", ParentID: &historyID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if category.Name != "Alternate History" || *category.ParentId != historyID || repo.updated != category {
+			t.Errorf("unexpected update %+v", category)
+		}
+	})
+
+	t.Run("rejects a cycle", func(t *testing.T) {
+		repo, fictionID, _, cyberpunkID, _ := categoryTree()
+		svc := NewCategoryService(repo)
+
+		_, err := svc.UpdateCategory(context.Background(), fictionID.String(), dto.CategoryRequest{Name: "Fiction", ParentID: &cyberpunkID})
+		appErr, ok := apperrors.As(err)
+		if !ok || appErr.Code != "category_cycle" || repo.updated != nil {
+			t.Errorf("expected category_cycle, got %v", err)
+		}
+	})
+
+	t.Run("rejects a missing parent", func(t *testing.T) {
+		repo, fictionID, _, _, _ := categoryTree()
+		svc := NewCategoryService(repo)
+
+		parentID := uuid.New()
+		_, err := svc.UpdateCategory(context.Background(), fictionID.String(), dto.CategoryRequest{Name: "Fiction", ParentID: &parentID})
+		if !errors.Is(err, apperrors.ErrValidation) {
+			t.Errorf("expected a validation error, got %v", err)
+		}
+	})
+}
+
+func TestClassifyBook(t *testing.T) {
+	repo, fictionID, _, _, _ := categoryTree()
+	book := &models.Book{Id: uuid.New(), Title: "Dune"}
+	svc := NewBookService(&bookRepository{books: []*models.Book{book}}, repo)
+
+	classified, err := svc.ClassifyBook(context.Background(), book.Id.String(), dto.BookClassification{CategoryID: &fictionID, Tags: []string{"classic"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *classified.CategoryId != fictionID || len(classified.Tags) != 1 {
+		t.Errorf("unexpected classification %+v", classified)
+	}
+
+	missing := uuid.New()
+	_, err = svc.ClassifyBook(context.Background(), book.Id.String(), dto.BookClassification{CategoryID: &missing})
+	if appErr, ok := apperrors.As(err); !ok || appErr.Code != "category_not_found" || appErr.Fields[0].Field != "category_id" {
+		t.Errorf("expected category_not_found, got %v", err)
+	}
+}
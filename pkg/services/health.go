@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+type HealthService interface {
+	Readiness(ctx context.Context) *dto.HealthResponse
+	WatchJob(name string, interval time.Duration)
+	Heartbeat(name string)
+}
+
+type job struct {
+	interval time.Duration
+	lastRun  time.Time
+}
+
+type healthService struct {
+	repo repository.HealthRepository
+	now  func() time.Time
+
+	mu   sync.Mutex
+	jobs map[string]*job
+}
+
+func NewHealthService(repo repository.HealthRepository) HealthService {
+	return &healthService{
+		repo: repo,
+		now:  time.Now,
+		jobs: make(map[string]*job),
+	}
+}
+
+func (h *healthService) WatchJob(name string, interval time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.jobs[name] = &job{interval: interval, lastRun: h.now()}
+}
+
+func (h *healthService) Heartbeat(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if j, ok := h.jobs[name]; ok {
+		j.lastRun = h.now()
+	}
+}
+
+func (h *healthService) Readiness(ctx context.Context) *dto.HealthResponse {
+	ctx, span := tracer.Start(ctx, "HealthService.Readiness")
+	defer span.End()
+
+	checks := map[string]dto.HealthCheck{
+		"database":  h.checkDatabase(ctx),
+		"schema":    h.checkSchema(ctx),
+		"scheduler": h.checkScheduler(),
+	}
+
+	status := dto.HealthStatusOK
+	for _, check := range checks {
+		if check.Status != dto.HealthStatusOK {
+			status = dto.HealthStatusFail
+		}
+	}
+
+	return &dto.HealthResponse{Status: status, Checks: checks}
+}
+
+func (h *healthService) checkDatabase(ctx context.Context) dto.HealthCheck {
+	if err := h.repo.Ping(ctx); err != nil {
+		return dto.HealthCheck{Status: dto.HealthStatusFail, Message: err.Error()}
+	}
+	return dto.HealthCheck{Status: dto.HealthStatusOK}
+}
+
+func (h *healthService) checkSchema(ctx context.Context) dto.HealthCheck {
+	version, err := h.repo.GetSchemaVersion(ctx)
+	if err != nil {
+		return dto.HealthCheck{Status: dto.HealthStatusFail, Message: err.Error()}
+	}
+	if version != models.SchemaVersion {
+		return dto.HealthCheck{
+			Status:  dto.HealthStatusFail,
+			Message: fmt.Sprintf("schema version %d, expected %d", version, models.SchemaVersion),
+		}
+	}
+	return dto.HealthCheck{Status: dto.HealthStatusOK}
+}
+
+func (h *healthService) checkScheduler() dto.HealthCheck {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var stale []string
+	for name, j := range h.jobs {
+		if h.now().Sub(j.lastRun) > 2*j.interval {
+			stale = append(stale, fmt.Sprintf("%s last ran at %s", name, j.lastRun.Format(time.RFC3339)))
+		}
+	}
+	if len(stale) > 0 {
+		slices.Sort(stale)
+		return dto.HealthCheck{Status: dto.HealthStatusFail, Message: strings.Join(stale, "; ")}
+	}
+	return dto.HealthCheck{Status: dto.HealthStatusOK}
+}
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+// KioskService registers self-checkout kiosks and serves the few operations
+// a kiosk may perform. Checkouts and returns go through the RentService, so
+// the kiosk is held to the same rules as the desk, and every attempt is
+// recorded as a kiosk transaction.
+type KioskService interface {
+	RegisterKiosk(ctx context.Context, req dto.KioskRegistrationRequest, librarianID *uuid.UUID) (*dto.KioskRegistration, error)
+	ListKiosks(ctx context.Context) ([]*models.KioskDevice, error)
+	RevokeKiosk(ctx context.Context, id string) error
+	GetTransactions(ctx context.Context, id string, params dto.PaginationParams) (*dto.KioskTransactionsResponse, error)
+	Authenticate(ctx context.Context, key string) (*models.KioskDevice, error)
+	Identify(ctx context.Context, cardID string) (*dto.KioskStudent, error)
+	Checkout(ctx context.Context, kiosk *models.KioskDevice, req dto.KioskRequest) (*dto.Receipt, error)
+	Return(ctx context.Context, kiosk *models.KioskDevice, req dto.KioskRequest) (*dto.Receipt, error)
+}
+
+// kioskSeenInterval limits how often the last seen time of a kiosk is
+// written.
+const kioskSeenInterval = time.Minute
+
+type kioskService struct {
+	kioskRepo      repository.KioskRepository
+	studentRepo    repository.StudentRepository
+	rentRepo       repository.RentRepository
+	rentService    RentService
+	receiptService ReceiptService
+	overduePeriod  int
+	clock          clock.Clock
+}
+
+func NewKioskService(kioskRepo repository.KioskRepository, studentRepo repository.StudentRepository, rentRepo repository.RentRepository, rentService RentService, receiptService ReceiptService, overduePeriod int, clock clock.Clock) KioskService {
+	return &kioskService{
+		kioskRepo:      kioskRepo,
+		studentRepo:    studentRepo,
+		rentRepo:       rentRepo,
+		rentService:    rentService,
+		receiptService: receiptService,
+		overduePeriod:  overduePeriod,
+		clock:          clock,
+	}
+}
+
+func (k *kioskService) RegisterKiosk(ctx context.Context, req dto.KioskRegistrationRequest, librarianID *uuid.UUID) (*dto.KioskRegistration, error) {
+	ctx, span := tracer.Start(ctx, "KioskService.RegisterKiosk")
+	defer span.End()
+
+	key, err := randomToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate kiosk key: %w", err)
+	}
+
+	device := &models.KioskDevice{
+		Name:         req.Name,
+		KeyHash:      hashToken(key),
+		RegisteredBy: librarianID,
+		CreatedAt:    k.clock.Now().UTC(),
+	}
+	if err := k.kioskRepo.Create(ctx, device); err != nil {
+		return nil, err
+	}
+
+	return &dto.KioskRegistration{KioskDevice: device, Key: key}, nil
+}
+
+func (k *kioskService) ListKiosks(ctx context.Context) ([]*models.KioskDevice, error) {
+	ctx, span := tracer.Start(ctx, "KioskService.ListKiosks")
+	defer span.End()
+
+	return k.kioskRepo.GetAll(ctx)
+}
+
+func (k *kioskService) RevokeKiosk(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "KioskService.RevokeKiosk")
+	defer span.End()
+
+	kioskID, err := uuid.Parse(id)
+	if err != nil {
+		return apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	return k.kioskRepo.Revoke(ctx, kioskID, k.clock.Now())
+}
+
+func (k *kioskService) GetTransactions(ctx context.Context, id string, params dto.PaginationParams) (*dto.KioskTransactionsResponse, error) {
+	ctx, span := tracer.Start(ctx, "KioskService.GetTransactions")
+	defer span.End()
+
+	kioskID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	if _, err := k.kioskRepo.GetByID(ctx, kioskID); err != nil {
+		return nil, err
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+	if params.Limit > 100 {
+		params.Limit = 100
+	}
+	if params.Offset < 0 {
+		params.Offset = 0
+	}
+
+	transactions, total, links, err := k.kioskRepo.GetTransactions(ctx, kioskID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.KioskTransactionsResponse{
+		Results:    transactions,
+		Pagination: dto.NewPaginationInfo(params.Offset, params.Limit, total, links),
+	}, nil
+}
+
+func (k *kioskService) Authenticate(ctx context.Context, key string) (*models.KioskDevice, error) {
+	ctx, span := tracer.Start(ctx, "KioskService.Authenticate")
+	defer span.End()
+
+	if key == "" {
+		return nil, apperrors.Unauthorized("invalid_kiosk_key", "no kiosk key provided")
+	}
+
+	device, err := k.kioskRepo.GetActiveByKeyHash(ctx, hashToken(key))
+	if err != nil {
+		return nil, err
+	}
+
+	now := k.clock.Now()
+	if device.LastSeenAt == nil || now.Sub(*device.LastSeenAt) >= kioskSeenInterval {
+		if err := k.kioskRepo.Touch(ctx, device.Id, now); err != nil {
+			return nil, err
+		}
+		device.LastSeenAt = &now
+	}
+	return device, nil
+}
+
+func (k *kioskService) Identify(ctx context.Context, cardID string) (*dto.KioskStudent, error) {
+	ctx, span := tracer.Start(ctx, "KioskService.Identify")
+	defer span.End()
+
+	student, err := k.studentRepo.GetByCardID(ctx, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	loans, err := k.rentRepo.GetLoansByStudent(ctx, student.Id)
+	if err != nil {
+		return nil, err
+	}
+	now := k.clock.Now()
+	for _, loan := range loans {
+		applyDueDate(loan, k.overduePeriod, now)
+	}
+
+	return &dto.KioskStudent{
+		StudentID: student.Id,
+		FirstName: student.FirstName,
+		LastName:  student.LastName,
+		Loans:     loans,
+	}, nil
+}
+
+func (k *kioskService) Checkout(ctx context.Context, kiosk *models.KioskDevice, req dto.KioskRequest) (*dto.Receipt, error) {
+	ctx, span := tracer.Start(ctx, "KioskService.Checkout")
+	defer span.End()
+
+	response, err := k.rentService.CreateRentTransaction(ctx, dto.CreateRentRequest{
+		CardID:    req.CardId,
+		BookIDs:   req.BookIDs,
+		BookCodes: req.BookCodes,
+	})
+	if err != nil {
+		return nil, k.record(ctx, kiosk, models.KioskCheckout, req, nil, err)
+	}
+	k.record(ctx, kiosk, models.KioskCheckout, req, &response.CartID, nil)
+
+	return k.receipt(ctx, kiosk, response.CartID)
+}
+
+// Return returns the scanned books. They must make up a whole rented cart of
+// the student, since a kiosk cannot tell a forgotten book from a lost one.
+func (k *kioskService) Return(ctx context.Context, kiosk *models.KioskDevice, req dto.KioskRequest) (*dto.Receipt, error) {
+	ctx, span := tracer.Start(ctx, "KioskService.Return")
+	defer span.End()
+
+	items := make([]dto.ReturnItem, 0, len(req.BookIDs)+len(req.BookCodes))
+	for _, bookID := range req.BookIDs {
+		items = append(items, dto.ReturnItem{BookID: bookID})
+	}
+	for _, code := range req.BookCodes {
+		items = append(items, dto.ReturnItem{BookCode: code})
+	}
+	if len(items) == 0 {
+		err := apperrors.Validation("book_ids_required", "at least one book is required")
+		return nil, k.record(ctx, kiosk, models.KioskReturn, req, nil, err)
+	}
+
+	response, err := k.rentService.ReturnBooks(ctx, dto.ReturnBooksRequest{
+		CardID:   req.CardId,
+		Items:    items,
+		Complete: true,
+	})
+	if err != nil {
+		return nil, k.record(ctx, kiosk, models.KioskReturn, req, nil, err)
+	}
+	k.record(ctx, kiosk, models.KioskReturn, req, &response.CartID, nil)
+
+	return k.receipt(ctx, kiosk, response.CartID)
+}
+
+func (k *kioskService) receipt(ctx context.Context, kiosk *models.KioskDevice, cartID uuid.UUID) (*dto.Receipt, error) {
+	receipt, err := k.receiptService.GetReceipt(ctx, cartID.String())
+	if err != nil {
+		return nil, err
+	}
+	receipt.Kiosk = kiosk.Name
+	return receipt, nil
+}
+
+// record saves a kiosk transaction that succeeded or failed with opErr, and
+// returns opErr. The student is recorded when the card is known. By then a
+// checkout or return is committed, so failing to save the transaction is only
+// logged rather than reported to the kiosk as a failed operation.
+func (k *kioskService) record(ctx context.Context, kiosk *models.KioskDevice, action string, req dto.KioskRequest, cartID *uuid.UUID, opErr error) error {
+	books := make([]string, 0, len(req.BookIDs)+len(req.BookCodes))
+	for _, bookID := range req.BookIDs {
+		books = append(books, bookID.String())
+	}
+	books = append(books, req.BookCodes...)
+
+	transaction := &models.KioskTransaction{
+		KioskId:   kiosk.Id,
+		Action:    action,
+		CardId:    req.CardId,
+		Books:     books,
+		CartId:    cartID,
+		Status:    models.KioskSucceeded,
+		CreatedAt: k.clock.Now().UTC(),
+	}
+	if opErr != nil {
+		transaction.Status = models.KioskFailed
+		transaction.ErrorCode = "internal_error"
+		if appErr, ok := apperrors.As(opErr); ok && appErr.Code != "" {
+			transaction.ErrorCode = appErr.Code
+		}
+	}
+	if student, err := k.studentRepo.GetByCardID(ctx, req.CardId); err == nil {
+		transaction.StudentId = &student.Id
+	}
+
+	if err := k.kioskRepo.CreateTransaction(ctx, transaction); err != nil {
+		slog.ErrorContext(ctx, "Failed to record kiosk transaction", "kiosk", kiosk.Id, "action", action, "error", err)
+	}
+	return opErr
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+type kioskRepository struct {
+	repository.KioskRepository
+	device       *models.KioskDevice
+	touches      int
+	transactions []*models.KioskTransaction
+	writeErr     error
+}
+
+func (k *kioskRepository) GetActiveByKeyHash(ctx context.Context, keyHash string) (*models.KioskDevice, error) {
+	if k.device.KeyHash != keyHash || k.device.RevokedAt != nil {
+		return nil, apperrors.Unauthorized("invalid_kiosk_key", "invalid or revoked kiosk key")
+	}
+	device := *k.device
+	return &device, nil
+}
+
+func (k *kioskRepository) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	k.touches++
+	k.device.LastSeenAt = &at
+	return nil
+}
+
+func (k *kioskRepository) CreateTransaction(ctx context.Context, transaction *models.KioskTransaction) error {
+	if k.writeErr != nil {
+		return k.writeErr
+	}
+	k.transactions = append(k.transactions, transaction)
+	return nil
+}
+
+func newTestKioskService(rentService RentService, clk *clock.Fake) (KioskService, *kioskRepository, *models.Student) {
+	student := &models.Student{Id: uuid.New(), CardId: "C-100", FirstName: "Ada", LastName: "Lovelace"}
+	kiosks := &kioskRepository{device: &models.KioskDevice{Id: uuid.New(), Name: "Lobby", KeyHash: hashToken("kiosk-key")}}
+	students := &cardRepository{students: []*models.Student{student}}
+	receipts := &MockReceiptService{
+		GetReceiptFunc: func(ctx context.Context, cartID string) (*dto.Receipt, error) {
+			return &dto.Receipt{CartID: uuid.MustParse(cartID)}, nil
+		},
+	}
+	return NewKioskService(kiosks, students, nil, rentService, receipts, 14, clk), kiosks, student
+}
+
+func TestKioskAuthenticate(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(now)
+	service, kiosks, _ := newTestKioskService(nil, fake)
+
+	if _, err := service.Authenticate(context.Background(), "wrong-key"); !errors.Is(err, apperrors.ErrUnauthorized) {
+		t.Fatalf("expected unauthorized for a wrong key, got %v", err)
+	}
+
+	for range 3 {
+		if _, err := service.Authenticate(context.Background(), "kiosk-key"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if kiosks.touches != 1 {
+		t.Errorf("expected the last seen time to be written once, got %d", kiosks.touches)
+	}
+
+	fake.Advance(kioskSeenInterval)
+	if _, err := service.Authenticate(context.Background(), "kiosk-key"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if kiosks.touches != 2 {
+		t.Errorf("expected the last seen time to be written again, got %d", kiosks.touches)
+	}
+}
+
+func TestKioskCheckout(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("successful checkout returns a receipt", func(t *testing.T) {
+		cartID := uuid.New()
+		rentService := &MockRentService{
+			CreateRentTransactionFunc: func(ctx context.Context, req dto.CreateRentRequest) (*dto.CreateRentResponse, error) {
+				return &dto.CreateRentResponse{CartID: cartID}, nil
+			},
+		}
+		service, kiosks, student := newTestKioskService(rentService, clock.NewFake(now))
+		kiosk := kiosks.device
+
+		receipt, err := service.Checkout(context.Background(), kiosk, dto.KioskRequest{CardId: student.CardId, BookCodes: []string{"B-1"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if receipt.CartID != cartID || receipt.Kiosk != "Lobby" {
+			t.Errorf("expected the receipt of the cart at the kiosk, got %+v", receipt)
+		}
+
+		if len(kiosks.transactions) != 1 {
+			t.Fatalf("expected one transaction, got %d", len(kiosks.transactions))
+		}
+		transaction := kiosks.transactions[0]
+		if transaction.Status != models.KioskSucceeded || transaction.StudentId == nil || *transaction.StudentId != student.Id {
+			t.Errorf("unexpected transaction %+v", transaction)
+		}
+	})
+
+	t.Run("receipt survives a failed audit write", func(t *testing.T) {
+		cartID := uuid.New()
+		rentService := &MockRentService{
+			CreateRentTransactionFunc: func(ctx context.Context, req dto.CreateRentRequest) (*dto.CreateRentResponse, error) {
+				return &dto.CreateRentResponse{CartID: cartID}, nil
+			},
+		}
+		service, kiosks, student := newTestKioskService(rentService, clock.NewFake(now))
+		kiosks.writeErr = errors.New("database is locked")
+
+		receipt, err := service.Checkout(context.Background(), kiosks.device, dto.KioskRequest{CardId: student.CardId, BookCodes: []string{"B-1"}})
+		if err != nil {
+			t.Fatalf("expected the committed checkout to succeed, got %v", err)
+		}
+		if receipt.CartID != cartID {
+			t.Errorf("expected the receipt of cart %s, got %+v", cartID, receipt)
+		}
+	})
+
+	t.Run("failed checkout is recorded with its code", func(t *testing.T) {
+		rentService := &MockRentService{
+			CreateRentTransactionFunc: func(ctx context.Context, req dto.CreateRentRequest) (*dto.CreateRentResponse, error) {
+				return nil, apperrors.Conflict("book_not_available", "book is not available")
+			},
+		}
+		service, kiosks, student := newTestKioskService(rentService, clock.NewFake(now))
+
+		_, err := service.Checkout(context.Background(), kiosks.device, dto.KioskRequest{CardId: student.CardId, BookCodes: []string{"B-1"}})
+		if !errors.Is(err, apperrors.ErrConflict) {
+			t.Fatalf("expected the rent error, got %v", err)
+		}
+		if len(kiosks.transactions) != 1 {
+			t.Fatalf("expected one transaction, got %d", len(kiosks.transactions))
+		}
+		transaction := kiosks.transactions[0]
+		if transaction.Status != models.KioskFailed || transaction.ErrorCode != "book_not_available" {
+			t.Errorf("unexpected transaction %+v", transaction)
+		}
+	})
+}
+
+func TestKioskReturn(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("whole cart is required", func(t *testing.T) {
+		rentService := &MockRentService{
+			ReturnBooksFunc: func(ctx context.Context, req dto.ReturnBooksRequest) (*dto.ReturnBooksResponse, error) {
+				if !req.Complete {
+					t.Errorf("expected a complete return")
+				}
+				return &dto.ReturnBooksResponse{CartID: uuid.New()}, nil
+			},
+		}
+		service, kiosks, student := newTestKioskService(rentService, clock.NewFake(now))
+
+		if _, err := service.Return(context.Background(), kiosks.device, dto.KioskRequest{CardId: student.CardId, BookCodes: []string{"B-1"}}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("no books", func(t *testing.T) {
+		service, kiosks, student := newTestKioskService(&MockRentService{}, clock.NewFake(now))
+
+		_, err := service.Return(context.Background(), kiosks.device, dto.KioskRequest{CardId: student.CardId})
+		if !errors.Is(err, apperrors.ErrValidation) {
+			t.Fatalf("expected a validation error, got %v", err)
+		}
+		if len(kiosks.transactions) != 1 || kiosks.transactions[0].ErrorCode != "book_ids_required" {
+			t.Errorf("expected the failed attempt to be recorded, got %+v", kiosks.transactions)
+		}
+	})
+}
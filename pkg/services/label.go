@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/barcode"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+// LabelService encodes the barcodes of books and student cards, alone or with
+// lines of text for label sheets. Book barcodes encode the barcode of the
+// book or its id, card barcodes the card id.
+type LabelService interface {
+	GetBookBarcode(ctx context.Context, id string, symbology barcode.Symbology, value string) (*barcode.Symbol, error)
+	GetStudentBarcode(ctx context.Context, id string, symbology barcode.Symbology) (*barcode.Symbol, error)
+	GetBookLabels(ctx context.Context, req dto.BookLabelRequest) ([]dto.Label, error)
+	GetStudentLabels(ctx context.Context, req dto.StudentLabelRequest) ([]dto.Label, error)
+}
+
+type labelService struct {
+	bookRepo    repository.BookRepository
+	studentRepo repository.StudentRepository
+}
+
+func NewLabelService(bookRepo repository.BookRepository, studentRepo repository.StudentRepository) LabelService {
+	return &labelService{
+		bookRepo:    bookRepo,
+		studentRepo: studentRepo,
+	}
+}
+
+func (s *labelService) GetBookBarcode(ctx context.Context, id string, symbology barcode.Symbology, value string) (*barcode.Symbol, error) {
+	ctx, span := tracer.Start(ctx, "LabelService.GetBookBarcode")
+	defer span.End()
+
+	bookID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	book, err := s.bookRepo.GetByID(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := bookCode(book, value)
+	if !ok {
+		return nil, apperrors.Validation("missing_barcode", "book has no barcode")
+	}
+	symbol, err := barcode.Encode(orCode128(symbology), data)
+	if err != nil {
+		return nil, apperrors.Validation("unencodable_value", err.Error()).Wrap(err)
+	}
+	return symbol, nil
+}
+
+func (s *labelService) GetStudentBarcode(ctx context.Context, id string, symbology barcode.Symbology) (*barcode.Symbol, error) {
+	ctx, span := tracer.Start(ctx, "LabelService.GetStudentBarcode")
+	defer span.End()
+
+	studentID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	student, err := s.studentRepo.GetByID(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if student.CardId == "" {
+		return nil, apperrors.Validation("missing_card_id", "student has no card id")
+	}
+	symbol, err := barcode.Encode(orCode128(symbology), student.CardId)
+	if err != nil {
+		return nil, apperrors.Validation("unencodable_value", err.Error()).Wrap(err)
+	}
+	return symbol, nil
+}
+
+func (s *labelService) GetBookLabels(ctx context.Context, req dto.BookLabelRequest) ([]dto.Label, error) {
+	ctx, span := tracer.Start(ctx, "LabelService.GetBookLabels")
+	defer span.End()
+
+	books, err := s.bookRepo.GetBooksByIDs(ctx, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*models.Book, len(books))
+	for _, book := range books {
+		byID[book.Id] = book
+	}
+
+	value := req.Value
+	if value == "" {
+		value = dto.LabelValueBarcode
+	}
+	fields := req.Fields
+	if len(fields) == 0 {
+		fields = []string{"title", value}
+	}
+
+	var missing identifiers
+	var invalid []apperrors.FieldError
+	labels := make([]dto.Label, 0, len(req.IDs))
+	for i, id := range req.IDs {
+		field := fmt.Sprintf("ids[%d]", i)
+		book, ok := byID[id]
+		if !ok {
+			missing.book(field, "no book with id %s", id)
+			continue
+		}
+		data, ok := bookCode(book, value)
+		if !ok {
+			invalid = append(invalid, apperrors.FieldError{Field: field, Code: "missing_barcode", Message: fmt.Sprintf("book %s has no barcode", id)})
+			continue
+		}
+		symbol, err := barcode.Encode(orCode128(req.Symbology), data)
+		if err != nil {
+			invalid = append(invalid, apperrors.FieldError{Field: field, Code: "unencodable_value", Message: err.Error()})
+			continue
+		}
+
+		label := dto.Label{Symbol: symbol}
+		for _, f := range fields {
+			var line string
+			switch f {
+			case "title":
+				line = book.Title
+			case "barcode":
+				line, _ = bookCode(book, dto.LabelValueBarcode)
+			case "isbn":
+				if book.Isbn != nil {
+					line = "ISBN " + *book.Isbn
+				}
+			case "id":
+				line = book.Id.String()
+			}
+			label.Lines = append(label.Lines, line)
+		}
+		labels = append(labels, label)
+	}
+
+	if err := missing.err(); err != nil {
+		return nil, err
+	}
+	if len(invalid) > 0 {
+		return nil, apperrors.Validation(invalid[0].Code, fmt.Sprintf("%d book(s) cannot be labeled", len(invalid)), invalid...)
+	}
+	return labels, nil
+}
+
+func (s *labelService) GetStudentLabels(ctx context.Context, req dto.StudentLabelRequest) ([]dto.Label, error) {
+	ctx, span := tracer.Start(ctx, "LabelService.GetStudentLabels")
+	defer span.End()
+
+	students, err := s.studentRepo.GetStudentsByIDs(ctx, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*models.Student, len(students))
+	for _, student := range students {
+		byID[student.Id] = student
+	}
+
+	fields := req.Fields
+	if len(fields) == 0 {
+		fields = []string{"name", "card_id"}
+	}
+
+	var missing identifiers
+	var invalid []apperrors.FieldError
+	labels := make([]dto.Label, 0, len(req.IDs))
+	for i, id := range req.IDs {
+		field := fmt.Sprintf("ids[%d]", i)
+		student, ok := byID[id]
+		if !ok {
+			missing.student(field, "no student with id %s", id)
+			continue
+		}
+		if student.CardId == "" {
+			invalid = append(invalid, apperrors.FieldError{Field: field, Code: "missing_card_id", Message: fmt.Sprintf("student %s has no card id", id)})
+			continue
+		}
+		symbol, err := barcode.Encode(orCode128(req.Symbology), student.CardId)
+		if err != nil {
+			invalid = append(invalid, apperrors.FieldError{Field: field, Code: "unencodable_value", Message: err.Error()})
+			continue
+		}
+
+		label := dto.Label{Symbol: symbol}
+		for _, f := range fields {
+			var line string
+			switch f {
+			case "name":
+				line = strings.TrimSpace(student.FirstName + " " + student.LastName)
+			case "card_id":
+				line = student.CardId
+			case "major":
+				line = student.Major
+			}
+			label.Lines = append(label.Lines, line)
+		}
+		labels = append(labels, label)
+	}
+
+	if err := missing.err(); err != nil {
+		return nil, err
+	}
+	if len(invalid) > 0 {
+		return nil, apperrors.Validation(invalid[0].Code, fmt.Sprintf("%d student(s) cannot be labeled", len(invalid)), invalid...)
+	}
+	return labels, nil
+}
+
+// bookCode returns what the barcode of the book encodes, and false when the
+// book has no barcode to encode.
+func bookCode(book *models.Book, value string) (string, bool) {
+	if value == dto.LabelValueID {
+		return book.Id.String(), true
+	}
+	if book.Barcode == nil || *book.Barcode == "" {
+		return "", false
+	}
+	return *book.Barcode, true
+}
+
+func orCode128(symbology barcode.Symbology) barcode.Symbology {
+	if symbology == "" {
+		return barcode.Code128
+	}
+	return symbology
+}
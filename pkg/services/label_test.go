@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/barcode"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+type bookRepository struct {
+	repository.BookRepository
+	books []*models.Book
+}
+
+func (b *bookRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Book, error) {
+	for _, book := range b.books {
+		if book.Id == id {
+			return book, nil
+		}
+	}
+	return nil, apperrors.NotFound("book_not_found", "book not found")
+}
+
+func (b *bookRepository) GetBooksByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Book, error) {
+	var books []*models.Book
+	for _, book := range b.books {
+		if slices.Contains(ids, book.Id) {
+			books = append(books, book)
+		}
+	}
+	return books, nil
+}
+
+func TestGetBookLabels(t *testing.T) {
+	code, isbn := "00000042", "9780441013593"
+	dune := &models.Book{Id: uuid.New(), Title: "Dune", Barcode: &code, Isbn: &isbn}
+	emma := &models.Book{Id: uuid.New(), Title: "Emma"}
+	service := NewLabelService(&bookRepository{books: []*models.Book{dune, emma}}, &cardRepository{})
+
+	t.Run("barcodes with the default fields", func(t *testing.T) {
+		labels, err := service.GetBookLabels(context.Background(), dto.BookLabelRequest{IDs: []uuid.UUID{dune.Id, dune.Id}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(labels) != 2 {
+			t.Fatalf("expected a label per id, got %d", len(labels))
+		}
+		if labels[0].Symbol.Symbology != barcode.Code128 || !slices.Equal(labels[0].Lines, []string{"Dune", "00000042"}) {
+			t.Errorf("unexpected label %+v", labels[0])
+		}
+	})
+
+	t.Run("ids in QR codes", func(t *testing.T) {
+		labels, err := service.GetBookLabels(context.Background(), dto.BookLabelRequest{
+			IDs:        []uuid.UUID{emma.Id},
+			Value:      dto.LabelValueID,
+			Fields:     []string{"isbn", "title"},
+			LabelSheet: dto.LabelSheet{Symbology: barcode.QR},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if labels[0].Symbol.Symbology != barcode.QR || !slices.Equal(labels[0].Lines, []string{"", "Emma"}) {
+			t.Errorf("unexpected label %+v", labels[0])
+		}
+	})
+
+	t.Run("book without a barcode", func(t *testing.T) {
+		_, err := service.GetBookLabels(context.Background(), dto.BookLabelRequest{IDs: []uuid.UUID{dune.Id, emma.Id}})
+		appErr, ok := apperrors.As(err)
+		if !ok || !errors.Is(err, apperrors.ErrValidation) {
+			t.Fatalf("expected a validation error, got %v", err)
+		}
+		if len(appErr.Fields) != 1 || appErr.Fields[0].Field != "ids[1]" || appErr.Fields[0].Code != "missing_barcode" {
+			t.Errorf("expected ids[1] to be reported, got %+v", appErr.Fields)
+		}
+	})
+
+	t.Run("unknown book", func(t *testing.T) {
+		_, err := service.GetBookLabels(context.Background(), dto.BookLabelRequest{IDs: []uuid.UUID{uuid.New()}})
+		if !errors.Is(err, apperrors.ErrNotFound) {
+			t.Errorf("expected not found, got %v", err)
+		}
+	})
+}
+
+func TestGetStudentLabels(t *testing.T) {
+	ada := &models.Student{Id: uuid.New(), FirstName: "Ada", LastName: "Lovelace", CardId: "C-100", Major: "Mathematics"}
+	grace := &models.Student{Id: uuid.New(), FirstName: "Grace", LastName: "Hopper", CardId: "C-10é"}
+	service := NewLabelService(&bookRepository{}, &cardRepository{students: []*models.Student{ada, grace}})
+
+	labels, err := service.GetStudentLabels(context.Background(), dto.StudentLabelRequest{
+		IDs:    []uuid.UUID{ada.Id},
+		Fields: []string{"name", "major"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(labels[0].Lines, []string{"Ada Lovelace", "Mathematics"}) {
+		t.Errorf("unexpected lines %q", labels[0].Lines)
+	}
+
+	// Code 128 has no accented letters, QR codes do.
+	_, err = service.GetStudentLabels(context.Background(), dto.StudentLabelRequest{IDs: []uuid.UUID{ada.Id, grace.Id}})
+	if appErr, ok := apperrors.As(err); !ok || appErr.Code != "unencodable_value" || appErr.Fields[0].Field != "ids[1]" {
+		t.Errorf("expected ids[1] to be unencodable, got %v", err)
+	}
+	if _, err := service.GetStudentBarcode(context.Background(), grace.Id.String(), barcode.QR); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
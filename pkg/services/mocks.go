@@ -2,10 +2,13 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
+	"BRSBackend/pkg/barcode"
 	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/export"
 	"BRSBackend/pkg/models"
 )
 
@@ -13,6 +16,7 @@ type MockAuthService struct {
 	LoginFunc                  func(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, string, error)
 	ValidateSessionFunc        func(ctx context.Context, sessionId string) (*models.Librarian, error)
 	LogoutFunc                 func(ctx context.Context, sessionId string) error
+	LoginExternalFunc          func(ctx context.Context, identity dto.ExternalIdentity) (*dto.LoginResponse, string, error)
 	CreateLibrarianFunc        func(ctx context.Context, username, password string) error
 	CleanupExpiredSessionsFunc func() error
 	GetLibrarianFunc           func(ctx context.Context, sessionID string) (*dto.LoginResponse, error)
@@ -34,6 +38,10 @@ func (m *MockAuthService) Logout(ctx context.Context, sessionId string) error {
 	return m.LogoutFunc(ctx, sessionId)
 }
 
+func (m *MockAuthService) LoginExternal(ctx context.Context, identity dto.ExternalIdentity) (*dto.LoginResponse, string, error) {
+	return m.LoginExternalFunc(ctx, identity)
+}
+
 func (m *MockAuthService) CreateLibrarian(ctx context.Context, username, password string) error {
 	return m.CreateLibrarianFunc(ctx, username, password)
 }
@@ -43,10 +51,12 @@ func (m *MockAuthService) CleanupExpiredSessions() error {
 }
 
 type MockBookService struct {
-	CreateBookFunc  func(ctx context.Context, book *models.Book) error
-	GetBookByIDFunc func(ctx context.Context, id string) (*models.Book, error)
-	GetAllBooksFunc func(ctx context.Context, params dto.PaginationParams) (*dto.BooksResponse, error)
-	DeleteBookFunc  func(ctx context.Context, id string) error
+	CreateBookFunc   func(ctx context.Context, book *models.Book) error
+	GetBookByIDFunc  func(ctx context.Context, id string) (*models.Book, error)
+	GetAllBooksFunc  func(ctx context.Context, params dto.PaginationParams, filters dto.BookFilters) (*dto.BooksResponse, error)
+	DeleteBookFunc   func(ctx context.Context, id string) error
+	ClassifyBookFunc func(ctx context.Context, id string, classification dto.BookClassification) (*models.Book, error)
+	ListTagsFunc     func(ctx context.Context) ([]dto.TagCount, error)
 }
 
 func (m *MockBookService) CreateBook(ctx context.Context, book *models.Book) error {
@@ -57,20 +67,30 @@ func (m *MockBookService) GetBookByID(ctx context.Context, id string) (*models.B
 	return m.GetBookByIDFunc(ctx, id)
 }
 
-func (m *MockBookService) GetAllBooks(ctx context.Context, params dto.PaginationParams) (*dto.BooksResponse, error) {
-	return m.GetAllBooksFunc(ctx, params)
+func (m *MockBookService) GetAllBooks(ctx context.Context, params dto.PaginationParams, filters dto.BookFilters) (*dto.BooksResponse, error) {
+	return m.GetAllBooksFunc(ctx, params, filters)
 }
 
 func (m *MockBookService) DeleteBook(ctx context.Context, id string) error {
 	return m.DeleteBookFunc(ctx, id)
 }
 
+func (m *MockBookService) ClassifyBook(ctx context.Context, id string, classification dto.BookClassification) (*models.Book, error) {
+	return m.ClassifyBookFunc(ctx, id, classification)
+}
+
+func (m *MockBookService) ListTags(ctx context.Context) ([]dto.TagCount, error) {
+	return m.ListTagsFunc(ctx)
+}
+
 type MockStudentService struct {
 	CreateStudentFunc          func(ctx context.Context, student *models.Student) error
 	GetStudentByIDFunc         func(ctx context.Context, id string) (*models.Student, error)
 	GetStudentByCardNumberFunc func(ctx context.Context, number string) (*models.Student, error)
-	GetAllStudentsFunc         func(ctx context.Context, params dto.PaginationParams) (*dto.StudentsResponse, error)
+	GetAllStudentsFunc         func(ctx context.Context, params dto.PaginationParams, filters dto.StudentFilters) (*dto.StudentsResponse, error)
 	DeleteStudentFunc          func(ctx context.Context, id string) error
+	GetStudentHistoryFunc      func(ctx context.Context, id string, params dto.PaginationParams) (*dto.StudentHistoryResponse, error)
+	GetStudentSummaryFunc      func(ctx context.Context, id string) (*dto.StudentSummary, error)
 }
 
 func (m *MockStudentService) DeleteStudent(ctx context.Context, id string) error {
@@ -89,15 +109,24 @@ func (m *MockStudentService) GetStudentByCardNumber(ctx context.Context, number
 	return m.GetStudentByCardNumberFunc(ctx, number)
 }
 
-func (m *MockStudentService) GetAllStudents(ctx context.Context, params dto.PaginationParams) (*dto.StudentsResponse, error) {
-	return m.GetAllStudentsFunc(ctx, params)
+func (m *MockStudentService) GetAllStudents(ctx context.Context, params dto.PaginationParams, filters dto.StudentFilters) (*dto.StudentsResponse, error) {
+	return m.GetAllStudentsFunc(ctx, params, filters)
+}
+
+func (m *MockStudentService) GetStudentHistory(ctx context.Context, id string, params dto.PaginationParams) (*dto.StudentHistoryResponse, error) {
+	return m.GetStudentHistoryFunc(ctx, id, params)
+}
+
+func (m *MockStudentService) GetStudentSummary(ctx context.Context, id string) (*dto.StudentSummary, error) {
+	return m.GetStudentSummaryFunc(ctx, id)
 }
 
 type MockRentService struct {
 	CreateRentTransactionFunc   func(ctx context.Context, req dto.CreateRentRequest) (*dto.CreateRentResponse, error)
 	GetRentsFunc                func(ctx context.Context, filters dto.RentFilters) (*dto.GetRentedBooksResponse, error)
 	GetRentedBooksByStudentFunc func(ctx context.Context, studentCardID *string) (*dto.GetRentedBooksResponse, error)
-	ReturnBooksFunc             func(ctx context.Context, cartID uuid.UUID) (*dto.ReturnBooksResponse, error)
+	ReturnBooksFunc             func(ctx context.Context, req dto.ReturnBooksRequest) (*dto.ReturnBooksResponse, error)
+	GetReturnHistoryFunc        func(ctx context.Context, filters dto.ReturnFilters) (*dto.ReturnHistoryResponse, error)
 }
 
 func (m *MockRentService) CreateRentTransaction(ctx context.Context, req dto.CreateRentRequest) (*dto.CreateRentResponse, error) {
@@ -112,19 +141,297 @@ func (m *MockRentService) GetRentedBooksByStudent(ctx context.Context, studentCa
 	return m.GetRentedBooksByStudentFunc(ctx, studentCardID)
 }
 
-func (m *MockRentService) ReturnBooks(ctx context.Context, cartID uuid.UUID) (*dto.ReturnBooksResponse, error) {
-	return m.ReturnBooksFunc(ctx, cartID)
+func (m *MockRentService) ReturnBooks(ctx context.Context, req dto.ReturnBooksRequest) (*dto.ReturnBooksResponse, error) {
+	return m.ReturnBooksFunc(ctx, req)
+}
+
+func (m *MockRentService) GetReturnHistory(ctx context.Context, filters dto.ReturnFilters) (*dto.ReturnHistoryResponse, error) {
+	return m.GetReturnHistoryFunc(ctx, filters)
 }
 
 type MockReportService struct {
-	GetOverdueRentalsFunc func(ctx context.Context, studentCardID *string, limit, offset int) (*dto.OverdueResponse, error)
-	GetRentalReportFunc   func(ctx context.Context, limit, offset int) (*dto.RentReport, error)
+	GetOverdueRentalsFunc       func(ctx context.Context, studentCardID *string, params dto.PaginationParams) (*dto.OverdueResponse, error)
+	GetRentalReportFunc         func(ctx context.Context, limit, offset int) (*dto.RentReport, error)
+	GetCirculationStatsFunc     func(ctx context.Context) (*dto.CirculationStats, error)
+	GetCirculationAnalyticsFunc func(ctx context.Context, params dto.AnalyticsParams) (*dto.AnalyticsResponse, error)
+	RollupCirculationFunc       func(ctx context.Context) error
+	GetCollectionReportFunc     func(ctx context.Context, filters dto.CollectionFilters, from, to *time.Time) (*dto.CollectionReport, error)
+	ExportCollectionReportFunc  func(ctx context.Context, filters dto.CollectionFilters, from, to *time.Time) (*export.Table, error)
+	ExportOverdueRentalsFunc    func(ctx context.Context, studentCardID *string) (*export.Table, error)
+	ExportRentalReportFunc      func(ctx context.Context, limit int) (*export.Table, error)
 }
 
-func (m *MockReportService) GetOverdueRentals(ctx context.Context, studentCardID *string, limit, offset int) (*dto.OverdueResponse, error) {
-	return m.GetOverdueRentalsFunc(ctx, studentCardID, limit, offset)
+func (m *MockReportService) GetOverdueRentals(ctx context.Context, studentCardID *string, params dto.PaginationParams) (*dto.OverdueResponse, error) {
+	return m.GetOverdueRentalsFunc(ctx, studentCardID, params)
 }
 
 func (m *MockReportService) GetRentalReport(ctx context.Context, limit, offset int) (*dto.RentReport, error) {
 	return m.GetRentalReportFunc(ctx, limit, offset)
 }
+
+func (m *MockReportService) GetCirculationStats(ctx context.Context) (*dto.CirculationStats, error) {
+	return m.GetCirculationStatsFunc(ctx)
+}
+
+func (m *MockReportService) GetCirculationAnalytics(ctx context.Context, params dto.AnalyticsParams) (*dto.AnalyticsResponse, error) {
+	return m.GetCirculationAnalyticsFunc(ctx, params)
+}
+
+func (m *MockReportService) RollupCirculation(ctx context.Context) error {
+	return m.RollupCirculationFunc(ctx)
+}
+
+func (m *MockReportService) GetCollectionReport(ctx context.Context, filters dto.CollectionFilters, from, to *time.Time) (*dto.CollectionReport, error) {
+	return m.GetCollectionReportFunc(ctx, filters, from, to)
+}
+
+func (m *MockReportService) ExportCollectionReport(ctx context.Context, filters dto.CollectionFilters, from, to *time.Time) (*export.Table, error) {
+	return m.ExportCollectionReportFunc(ctx, filters, from, to)
+}
+
+func (m *MockReportService) ExportOverdueRentals(ctx context.Context, studentCardID *string) (*export.Table, error) {
+	return m.ExportOverdueRentalsFunc(ctx, studentCardID)
+}
+
+func (m *MockReportService) ExportRentalReport(ctx context.Context, limit int) (*export.Table, error) {
+	return m.ExportRentalReportFunc(ctx, limit)
+}
+
+type MockReportScheduleService struct {
+	CreateScheduleFunc  func(ctx context.Context, req dto.ReportScheduleRequest) (*models.ReportSchedule, error)
+	GetScheduleFunc     func(ctx context.Context, id string) (*models.ReportSchedule, error)
+	ListSchedulesFunc   func(ctx context.Context) ([]*models.ReportSchedule, error)
+	UpdateScheduleFunc  func(ctx context.Context, id string, req dto.ReportScheduleRequest) (*models.ReportSchedule, error)
+	DeleteScheduleFunc  func(ctx context.Context, id string) error
+	RunScheduleFunc     func(ctx context.Context, id string) (*models.ReportRun, error)
+	GetRunsFunc         func(ctx context.Context, id string, params dto.PaginationParams) (*dto.ReportRunsResponse, error)
+	GetRunFileFunc      func(ctx context.Context, runID, format string) (*models.ReportFile, error)
+	RunDueSchedulesFunc func(ctx context.Context) ([]*models.ReportRun, error)
+	PurgeRunsFunc       func(ctx context.Context) (int64, error)
+}
+
+func (m *MockReportScheduleService) CreateSchedule(ctx context.Context, req dto.ReportScheduleRequest) (*models.ReportSchedule, error) {
+	return m.CreateScheduleFunc(ctx, req)
+}
+
+func (m *MockReportScheduleService) GetSchedule(ctx context.Context, id string) (*models.ReportSchedule, error) {
+	return m.GetScheduleFunc(ctx, id)
+}
+
+func (m *MockReportScheduleService) ListSchedules(ctx context.Context) ([]*models.ReportSchedule, error) {
+	return m.ListSchedulesFunc(ctx)
+}
+
+func (m *MockReportScheduleService) UpdateSchedule(ctx context.Context, id string, req dto.ReportScheduleRequest) (*models.ReportSchedule, error) {
+	return m.UpdateScheduleFunc(ctx, id, req)
+}
+
+func (m *MockReportScheduleService) DeleteSchedule(ctx context.Context, id string) error {
+	return m.DeleteScheduleFunc(ctx, id)
+}
+
+func (m *MockReportScheduleService) RunSchedule(ctx context.Context, id string) (*models.ReportRun, error) {
+	return m.RunScheduleFunc(ctx, id)
+}
+
+func (m *MockReportScheduleService) GetRuns(ctx context.Context, id string, params dto.PaginationParams) (*dto.ReportRunsResponse, error) {
+	return m.GetRunsFunc(ctx, id, params)
+}
+
+func (m *MockReportScheduleService) GetRunFile(ctx context.Context, runID, format string) (*models.ReportFile, error) {
+	return m.GetRunFileFunc(ctx, runID, format)
+}
+
+func (m *MockReportScheduleService) RunDueSchedules(ctx context.Context) ([]*models.ReportRun, error) {
+	return m.RunDueSchedulesFunc(ctx)
+}
+
+func (m *MockReportScheduleService) PurgeRuns(ctx context.Context) (int64, error) {
+	return m.PurgeRunsFunc(ctx)
+}
+
+type MockOIDCService struct {
+	BeginLoginFunc    func(ctx context.Context) (string, string, error)
+	CompleteLoginFunc func(ctx context.Context, state, code string) (*dto.OIDCLoginResult, error)
+}
+
+func (m *MockOIDCService) BeginLogin(ctx context.Context) (string, string, error) {
+	return m.BeginLoginFunc(ctx)
+}
+
+func (m *MockOIDCService) CompleteLogin(ctx context.Context, state, code string) (*dto.OIDCLoginResult, error) {
+	return m.CompleteLoginFunc(ctx, state, code)
+}
+
+type MockHealthService struct {
+	ReadinessFunc func(ctx context.Context) *dto.HealthResponse
+	WatchJobFunc  func(name string, interval time.Duration)
+	HeartbeatFunc func(name string)
+}
+
+func (m *MockHealthService) Readiness(ctx context.Context) *dto.HealthResponse {
+	return m.ReadinessFunc(ctx)
+}
+
+func (m *MockHealthService) WatchJob(name string, interval time.Duration) {
+	m.WatchJobFunc(name, interval)
+}
+
+func (m *MockHealthService) Heartbeat(name string) {
+	m.HeartbeatFunc(name)
+}
+
+type MockPortalService struct {
+	LoginFunc           func(ctx context.Context, req dto.PortalLoginRequest) (*dto.PortalLoginResponse, string, error)
+	SendLoginLinkFunc   func(ctx context.Context, cardID string) error
+	LoginWithTokenFunc  func(ctx context.Context, token string) (*dto.PortalLoginResponse, string, error)
+	ValidateSessionFunc func(ctx context.Context, sessionID string) (*models.Student, error)
+	LogoutFunc          func(ctx context.Context, sessionID string) error
+	GetLoansFunc        func(ctx context.Context, student *models.Student) (*dto.StudentLoansResponse, error)
+	GetHistoryFunc      func(ctx context.Context, student *models.Student, params dto.PaginationParams) (*dto.StudentHistoryResponse, error)
+	UpdateContactFunc   func(ctx context.Context, student *models.Student, req dto.StudentContactRequest) (*models.Student, error)
+	SetPinFunc          func(ctx context.Context, studentID, pin string) error
+	CleanupExpiredFunc  func(ctx context.Context) error
+}
+
+func (m *MockPortalService) Login(ctx context.Context, req dto.PortalLoginRequest) (*dto.PortalLoginResponse, string, error) {
+	return m.LoginFunc(ctx, req)
+}
+
+func (m *MockPortalService) SendLoginLink(ctx context.Context, cardID string) error {
+	return m.SendLoginLinkFunc(ctx, cardID)
+}
+
+func (m *MockPortalService) LoginWithToken(ctx context.Context, token string) (*dto.PortalLoginResponse, string, error) {
+	return m.LoginWithTokenFunc(ctx, token)
+}
+
+func (m *MockPortalService) ValidateSession(ctx context.Context, sessionID string) (*models.Student, error) {
+	return m.ValidateSessionFunc(ctx, sessionID)
+}
+
+func (m *MockPortalService) Logout(ctx context.Context, sessionID string) error {
+	return m.LogoutFunc(ctx, sessionID)
+}
+
+func (m *MockPortalService) GetLoans(ctx context.Context, student *models.Student) (*dto.StudentLoansResponse, error) {
+	return m.GetLoansFunc(ctx, student)
+}
+
+func (m *MockPortalService) GetHistory(ctx context.Context, student *models.Student, params dto.PaginationParams) (*dto.StudentHistoryResponse, error) {
+	return m.GetHistoryFunc(ctx, student, params)
+}
+
+func (m *MockPortalService) UpdateContact(ctx context.Context, student *models.Student, req dto.StudentContactRequest) (*models.Student, error) {
+	return m.UpdateContactFunc(ctx, student, req)
+}
+
+func (m *MockPortalService) SetPin(ctx context.Context, studentID, pin string) error {
+	return m.SetPinFunc(ctx, studentID, pin)
+}
+
+func (m *MockPortalService) CleanupExpired(ctx context.Context) error {
+	return m.CleanupExpiredFunc(ctx)
+}
+
+type MockKioskService struct {
+	RegisterKioskFunc   func(ctx context.Context, req dto.KioskRegistrationRequest, librarianID *uuid.UUID) (*dto.KioskRegistration, error)
+	ListKiosksFunc      func(ctx context.Context) ([]*models.KioskDevice, error)
+	RevokeKioskFunc     func(ctx context.Context, id string) error
+	GetTransactionsFunc func(ctx context.Context, id string, params dto.PaginationParams) (*dto.KioskTransactionsResponse, error)
+	AuthenticateFunc    func(ctx context.Context, key string) (*models.KioskDevice, error)
+	IdentifyFunc        func(ctx context.Context, cardID string) (*dto.KioskStudent, error)
+	CheckoutFunc        func(ctx context.Context, kiosk *models.KioskDevice, req dto.KioskRequest) (*dto.Receipt, error)
+	ReturnFunc          func(ctx context.Context, kiosk *models.KioskDevice, req dto.KioskRequest) (*dto.Receipt, error)
+}
+
+func (m *MockKioskService) RegisterKiosk(ctx context.Context, req dto.KioskRegistrationRequest, librarianID *uuid.UUID) (*dto.KioskRegistration, error) {
+	return m.RegisterKioskFunc(ctx, req, librarianID)
+}
+
+func (m *MockKioskService) ListKiosks(ctx context.Context) ([]*models.KioskDevice, error) {
+	return m.ListKiosksFunc(ctx)
+}
+
+func (m *MockKioskService) RevokeKiosk(ctx context.Context, id string) error {
+	return m.RevokeKioskFunc(ctx, id)
+}
+
+func (m *MockKioskService) GetTransactions(ctx context.Context, id string, params dto.PaginationParams) (*dto.KioskTransactionsResponse, error) {
+	return m.GetTransactionsFunc(ctx, id, params)
+}
+
+func (m *MockKioskService) Authenticate(ctx context.Context, key string) (*models.KioskDevice, error) {
+	return m.AuthenticateFunc(ctx, key)
+}
+
+func (m *MockKioskService) Identify(ctx context.Context, cardID string) (*dto.KioskStudent, error) {
+	return m.IdentifyFunc(ctx, cardID)
+}
+
+func (m *MockKioskService) Checkout(ctx context.Context, kiosk *models.KioskDevice, req dto.KioskRequest) (*dto.Receipt, error) {
+	return m.CheckoutFunc(ctx, kiosk, req)
+}
+
+func (m *MockKioskService) Return(ctx context.Context, kiosk *models.KioskDevice, req dto.KioskRequest) (*dto.Receipt, error) {
+	return m.ReturnFunc(ctx, kiosk, req)
+}
+
+type MockReceiptService struct {
+	GetReceiptFunc func(ctx context.Context, cartID string) (*dto.Receipt, error)
+}
+
+func (m *MockReceiptService) GetReceipt(ctx context.Context, cartID string) (*dto.Receipt, error) {
+	return m.GetReceiptFunc(ctx, cartID)
+}
+
+type MockLabelService struct {
+	GetBookBarcodeFunc    func(ctx context.Context, id string, symbology barcode.Symbology, value string) (*barcode.Symbol, error)
+	GetStudentBarcodeFunc func(ctx context.Context, id string, symbology barcode.Symbology) (*barcode.Symbol, error)
+	GetBookLabelsFunc     func(ctx context.Context, req dto.BookLabelRequest) ([]dto.Label, error)
+	GetStudentLabelsFunc  func(ctx context.Context, req dto.StudentLabelRequest) ([]dto.Label, error)
+}
+
+func (m *MockLabelService) GetBookBarcode(ctx context.Context, id string, symbology barcode.Symbology, value string) (*barcode.Symbol, error) {
+	return m.GetBookBarcodeFunc(ctx, id, symbology, value)
+}
+
+func (m *MockLabelService) GetStudentBarcode(ctx context.Context, id string, symbology barcode.Symbology) (*barcode.Symbol, error) {
+	return m.GetStudentBarcodeFunc(ctx, id, symbology)
+}
+
+func (m *MockLabelService) GetBookLabels(ctx context.Context, req dto.BookLabelRequest) ([]dto.Label, error) {
+	return m.GetBookLabelsFunc(ctx, req)
+}
+
+func (m *MockLabelService) GetStudentLabels(ctx context.Context, req dto.StudentLabelRequest) ([]dto.Label, error) {
+	return m.GetStudentLabelsFunc(ctx, req)
+}
+
+type MockCategoryService struct {
+	ListCategoriesFunc func(ctx context.Context) ([]*dto.CategoryNode, error)
+	GetCategoryFunc    func(ctx context.Context, id string) (*dto.CategoryNode, error)
+	CreateCategoryFunc func(ctx context.Context, req dto.CategoryRequest) (*models.Category, error)
+	UpdateCategoryFunc func(ctx context.Context, id string, req dto.CategoryRequest) (*models.Category, error)
+	DeleteCategoryFunc func(ctx context.Context, id string) error
+}
+
+func (m *MockCategoryService) ListCategories(ctx context.Context) ([]*dto.CategoryNode, error) {
+	return m.ListCategoriesFunc(ctx)
+}
+
+func (m *MockCategoryService) GetCategory(ctx context.Context, id string) (*dto.CategoryNode, error) {
+	return m.GetCategoryFunc(ctx, id)
+}
+
+func (m *MockCategoryService) CreateCategory(ctx context.Context, req dto.CategoryRequest) (*models.Category, error) {
+	return m.CreateCategoryFunc(ctx, req)
+}
+
+func (m *MockCategoryService) UpdateCategory(ctx context.Context, id string, req dto.CategoryRequest) (*models.Category, error) {
+	return m.UpdateCategoryFunc(ctx, id, req)
+}
+
+func (m *MockCategoryService) DeleteCategory(ctx context.Context, id string) error {
+	return m.DeleteCategoryFunc(ctx, id)
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+)
+
+const oidcLoginTTL = 10 * time.Minute
+
+var (
+	ErrOIDCInvalidState = errors.New("invalid or expired login state")
+	ErrOIDCAccessDenied = errors.New("account is not allowed to access the library")
+)
+
+type OIDCOptions struct {
+	Issuer            string
+	ClientID          string
+	ClientSecret      string
+	RedirectURL       string
+	PostLoginRedirect string
+	Scopes            []string
+	UsernameClaim     string
+	GroupsClaim       string
+	RoleMapping       map[string]string
+	DefaultRole       string
+}
+
+type OIDCService interface {
+	BeginLogin(ctx context.Context) (string, string, error)
+	CompleteLogin(ctx context.Context, state, code string) (*dto.OIDCLoginResult, error)
+}
+
+type pendingLogin struct {
+	verifier  string
+	nonce     string
+	expiresAt time.Time
+}
+
+type oidcService struct {
+	authService AuthService
+	oauth       *oauth2.Config
+	verifier    *oidc.IDTokenVerifier
+	opts        OIDCOptions
+
+	mu      sync.Mutex
+	pending map[string]pendingLogin
+}
+
+func NewOIDCService(ctx context.Context, authService AuthService, opts OIDCOptions) (OIDCService, error) {
+	provider, err := oidc.NewProvider(ctx, opts.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover oidc provider: %w", err)
+	}
+
+	scopes := opts.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{oidc.ScopeOpenID}
+	}
+
+	return &oidcService{
+		authService: authService,
+		oauth: &oauth2.Config{
+			ClientID:     opts.ClientID,
+			ClientSecret: opts.ClientSecret,
+			RedirectURL:  opts.RedirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       scopes,
+		},
+		verifier: provider.Verifier(&oidc.Config{ClientID: opts.ClientID}),
+		opts:     opts,
+		pending:  make(map[string]pendingLogin),
+	}, nil
+}
+
+func (o *oidcService) BeginLogin(ctx context.Context) (string, string, error) {
+	ctx, span := tracer.Start(ctx, "OIDCService.BeginLogin")
+	defer span.End()
+
+	state, err := randomToken()
+	if err != nil {
+		return "", "", errors.New("failed to create login state")
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return "", "", errors.New("failed to create login nonce")
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	o.mu.Lock()
+	now := time.Now()
+	for key, login := range o.pending {
+		if now.After(login.expiresAt) {
+			delete(o.pending, key)
+		}
+	}
+	o.pending[state] = pendingLogin{
+		verifier:  verifier,
+		nonce:     nonce,
+		expiresAt: now.Add(oidcLoginTTL),
+	}
+	o.mu.Unlock()
+
+	authURL := o.oauth.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier))
+	return authURL, state, nil
+}
+
+func (o *oidcService) CompleteLogin(ctx context.Context, state, code string) (*dto.OIDCLoginResult, error) {
+	ctx, span := tracer.Start(ctx, "OIDCService.CompleteLogin")
+	defer span.End()
+
+	o.mu.Lock()
+	login, ok := o.pending[state]
+	delete(o.pending, state)
+	o.mu.Unlock()
+
+	if !ok || time.Now().After(login.expiresAt) {
+		return nil, ErrOIDCInvalidState
+	}
+
+	token, err := o.oauth.Exchange(ctx, code, oauth2.VerifierOption(login.verifier))
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("token response did not include an id_token")
+	}
+
+	idToken, err := o.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify id_token: %w", err)
+	}
+	if idToken.Nonce != login.nonce {
+		return nil, errors.New("id_token nonce mismatch")
+	}
+
+	var claims map[string]any
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("failed to parse id_token claims: %w", err)
+	}
+
+	identity, err := o.mapClaims(idToken.Subject, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	response, sessionId, err := o.authService.LoginExternal(ctx, *identity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.OIDCLoginResult{
+		Response:  response,
+		SessionId: sessionId,
+		Redirect:  o.opts.PostLoginRedirect,
+	}, nil
+}
+
+func (o *oidcService) mapClaims(subject string, claims map[string]any) (*dto.ExternalIdentity, error) {
+	username, _ := claims[o.opts.UsernameClaim].(string)
+	if username == "" {
+		username, _ = claims["email"].(string)
+	}
+	if username == "" {
+		return nil, fmt.Errorf("id_token is missing the %q claim", o.opts.UsernameClaim)
+	}
+
+	role := o.opts.DefaultRole
+	for _, group := range claimStrings(claims[o.opts.GroupsClaim]) {
+		mapped, ok := o.opts.RoleMapping[strings.ToLower(group)]
+		if !ok {
+			continue
+		}
+		if mapped == models.RoleAdmin || role == "" {
+			role = mapped
+		}
+	}
+	if role == "" {
+		return nil, ErrOIDCAccessDenied
+	}
+
+	return &dto.ExternalIdentity{
+		Subject:  subject,
+		Username: username,
+		Role:     role,
+	}, nil
+}
+
+func claimStrings(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+func randomToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,306 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+type mockIdP struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]any
+	nonces map[string]string
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	idp := &mockIdP{key: key, nonces: make(map[string]string)}
+	mux := http.NewServeMux()
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                idp.server.URL,
+			"authorization_endpoint":                idp.server.URL + "/authorize",
+			"token_endpoint":                        idp.server.URL + "/token",
+			"jwks_uri":                              idp.server.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &idp.key.PublicKey, KeyID: "test", Algorithm: "RS256", Use: "sig"},
+		}})
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("code_verifier") == "" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+
+		claims := map[string]any{
+			"iss":   idp.server.URL,
+			"aud":   "brs",
+			"sub":   "user-123",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"iat":   time.Now().Unix(),
+			"nonce": idp.nonces[r.Form.Get("code")],
+		}
+		for k, v := range idp.claims {
+			claims[k] = v
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     idp.sign(t, claims),
+		})
+	})
+
+	return idp
+}
+
+func (idp *mockIdP) sign(t *testing.T, claims map[string]any) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: idp.key},
+		(&jose.SignerOptions{}).WithHeader("kid", "test"))
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+
+	payload, _ := json.Marshal(claims)
+	signed, err := signer.Sign(payload)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	token, err := signed.CompactSerialize()
+	if err != nil {
+		t.Fatalf("failed to serialize token: %v", err)
+	}
+	return token
+}
+
+func (idp *mockIdP) authorize(t *testing.T, authURL, code string) string {
+	t.Helper()
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("invalid auth url: %v", err)
+	}
+
+	query := u.Query()
+	if query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+		t.Fatalf("expected PKCE challenge in auth url, got %s", authURL)
+	}
+	idp.nonces[code] = query.Get("nonce")
+	return query.Get("state")
+}
+
+func newTestOIDCService(t *testing.T, idp *mockIdP, authService AuthService) OIDCService {
+	t.Helper()
+
+	svc, err := NewOIDCService(context.Background(), authService, OIDCOptions{
+		Issuer:            idp.server.URL,
+		ClientID:          "brs",
+		ClientSecret:      "secret",
+		RedirectURL:       "http://localhost:8080/login/oidc/callback",
+		PostLoginRedirect: "/dashboard",
+		Scopes:            []string{"openid", "profile"},
+		UsernameClaim:     "preferred_username",
+		GroupsClaim:       "groups",
+		RoleMapping:       map[string]string{"library-staff": "librarian", "library-admins": "admin"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create oidc service: %v", err)
+	}
+	return svc
+}
+
+func TestOIDCLogin(t *testing.T) {
+	t.Run("provisions librarian with mapped role", func(t *testing.T) {
+		idp := newMockIdP(t)
+		idp.claims = map[string]any{
+			"preferred_username": "jdoe",
+			"groups":             []string{"Library-Staff", "library-admins"},
+		}
+
+		librarianId := uuid.New()
+		var identity dto.ExternalIdentity
+		mockAuthService := &MockAuthService{
+			LoginExternalFunc: func(ctx context.Context, id dto.ExternalIdentity) (*dto.LoginResponse, string, error) {
+				identity = id
+				return &dto.LoginResponse{Message: "Login successful", LibrarianId: librarianId}, "session-id", nil
+			},
+		}
+
+		svc := newTestOIDCService(t, idp, mockAuthService)
+
+		authURL, state, err := svc.BeginLogin(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := idp.authorize(t, authURL, "code-1"); got != state {
+			t.Fatalf("expected state %q in auth url, got %q", state, got)
+		}
+
+		result, err := svc.CompleteLogin(context.Background(), state, "code-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if result.SessionId != "session-id" {
+			t.Errorf("expected session id 'session-id', got '%s'", result.SessionId)
+		}
+		if result.Redirect != "/dashboard" {
+			t.Errorf("expected redirect '/dashboard', got '%s'", result.Redirect)
+		}
+		if identity.Subject != "user-123" || identity.Username != "jdoe" {
+			t.Errorf("unexpected identity: %+v", identity)
+		}
+		if identity.Role != "admin" {
+			t.Errorf("expected role 'admin', got '%s'", identity.Role)
+		}
+	})
+
+	t.Run("denies accounts without a mapped group", func(t *testing.T) {
+		idp := newMockIdP(t)
+		idp.claims = map[string]any{
+			"preferred_username": "student",
+			"groups":             []string{"students"},
+		}
+
+		svc := newTestOIDCService(t, idp, &MockAuthService{})
+
+		authURL, state, _ := svc.BeginLogin(context.Background())
+		idp.authorize(t, authURL, "code-2")
+
+		_, err := svc.CompleteLogin(context.Background(), state, "code-2")
+		if !errors.Is(err, ErrOIDCAccessDenied) {
+			t.Errorf("expected access denied error, got %v", err)
+		}
+	})
+
+	t.Run("rejects unknown state", func(t *testing.T) {
+		idp := newMockIdP(t)
+		svc := newTestOIDCService(t, idp, &MockAuthService{})
+
+		_, err := svc.CompleteLogin(context.Background(), "unknown", "code")
+		if !errors.Is(err, ErrOIDCInvalidState) {
+			t.Errorf("expected invalid state error, got %v", err)
+		}
+	})
+
+	t.Run("state can only be used once", func(t *testing.T) {
+		idp := newMockIdP(t)
+		idp.claims = map[string]any{
+			"preferred_username": "jdoe",
+			"groups":             "library-staff",
+		}
+
+		mockAuthService := &MockAuthService{
+			LoginExternalFunc: func(ctx context.Context, id dto.ExternalIdentity) (*dto.LoginResponse, string, error) {
+				return &dto.LoginResponse{}, "session-id", nil
+			},
+		}
+		svc := newTestOIDCService(t, idp, mockAuthService)
+
+		authURL, state, _ := svc.BeginLogin(context.Background())
+		idp.authorize(t, authURL, "code-3")
+
+		if _, err := svc.CompleteLogin(context.Background(), state, "code-3"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.CompleteLogin(context.Background(), state, "code-3"); !errors.Is(err, ErrOIDCInvalidState) {
+			t.Errorf("expected invalid state error on replay, got %v", err)
+		}
+	})
+}
+
+type librarianRepository struct {
+	repository.LibrarianRepository
+	subjectErr error
+	created    []*models.Librarian
+}
+
+func (l *librarianRepository) GetByOIDCSubject(ctx context.Context, subject string) (*models.Librarian, error) {
+	if l.subjectErr != nil {
+		return nil, l.subjectErr
+	}
+	return nil, apperrors.NotFound("librarian_not_found", "librarian not found")
+}
+
+func (l *librarianRepository) GetByUsername(ctx context.Context, username string) (*models.Librarian, error) {
+	return nil, errors.New("librarian not found")
+}
+
+func (l *librarianRepository) Create(ctx context.Context, librarian *models.Librarian) error {
+	l.created = append(l.created, librarian)
+	return nil
+}
+
+type sessionRepository struct {
+	repository.SessionRepository
+}
+
+func (s *sessionRepository) Create(ctx context.Context, session *models.Session) error {
+	return nil
+}
+
+func TestLoginExternal(t *testing.T) {
+	identity := dto.ExternalIdentity{Subject: "user-123", Username: "jdoe", Role: "librarian"}
+
+	t.Run("provisions unlinked subjects", func(t *testing.T) {
+		repo := &librarianRepository{}
+		svc := NewAuthService(repo, &sessionRepository{}, clock.System{})
+
+		if _, _, err := svc.LoginExternal(context.Background(), identity); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(repo.created) != 1 || repo.created[0].User != "jdoe" {
+			t.Errorf("expected jdoe to be provisioned, got %v", repo.created)
+		}
+	})
+
+	t.Run("fails when the lookup fails", func(t *testing.T) {
+		lookupErr := errors.New("database is locked")
+		repo := &librarianRepository{subjectErr: lookupErr}
+		svc := NewAuthService(repo, &sessionRepository{}, clock.System{})
+
+		if _, _, err := svc.LoginExternal(context.Background(), identity); !errors.Is(err, lookupErr) {
+			t.Errorf("expected the lookup error, got %v", err)
+		}
+		if len(repo.created) != 0 {
+			t.Errorf("expected no librarian to be provisioned, got %v", repo.created)
+		}
+	})
+}
@@ -0,0 +1,317 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/delivery"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+// PortalService signs students in to the self-service portal and serves
+// their own loans and contact details.
+type PortalService interface {
+	Login(ctx context.Context, req dto.PortalLoginRequest) (*dto.PortalLoginResponse, string, error)
+	SendLoginLink(ctx context.Context, cardID string) error
+	LoginWithToken(ctx context.Context, token string) (*dto.PortalLoginResponse, string, error)
+	ValidateSession(ctx context.Context, sessionID string) (*models.Student, error)
+	Logout(ctx context.Context, sessionID string) error
+	GetLoans(ctx context.Context, student *models.Student) (*dto.StudentLoansResponse, error)
+	GetHistory(ctx context.Context, student *models.Student, params dto.PaginationParams) (*dto.StudentHistoryResponse, error)
+	UpdateContact(ctx context.Context, student *models.Student, req dto.StudentContactRequest) (*models.Student, error)
+	SetPin(ctx context.Context, studentID, pin string) error
+	CleanupExpired(ctx context.Context) error
+}
+
+// PortalOptions configure the student sign-in. Sign-in links are sent only
+// when both Mailer and LinkURL are set.
+type PortalOptions struct {
+	SessionTTL     time.Duration
+	MaxPinAttempts int
+	Lockout        time.Duration
+	Mailer         delivery.Mailer
+	LinkURL        string
+	LinkTTL        time.Duration
+}
+
+// dummyPinHash is compared against when a card has no PIN, so that unknown
+// cards take as long to reject as wrong PINs.
+var dummyPinHash, _ = bcrypt.GenerateFromPassword([]byte("0000"), bcrypt.DefaultCost)
+
+type portalService struct {
+	authRepo      repository.StudentAuthRepository
+	studentRepo   repository.StudentRepository
+	rentRepo      repository.RentRepository
+	overduePeriod int
+	opts          PortalOptions
+	clock         clock.Clock
+}
+
+func NewPortalService(authRepo repository.StudentAuthRepository, studentRepo repository.StudentRepository, rentRepo repository.RentRepository, overduePeriod int, opts PortalOptions, clock clock.Clock) PortalService {
+	return &portalService{
+		authRepo:      authRepo,
+		studentRepo:   studentRepo,
+		rentRepo:      rentRepo,
+		overduePeriod: overduePeriod,
+		opts:          opts,
+		clock:         clock,
+	}
+}
+
+func (p *portalService) Login(ctx context.Context, req dto.PortalLoginRequest) (*dto.PortalLoginResponse, string, error) {
+	ctx, span := tracer.Start(ctx, "PortalService.Login")
+	defer span.End()
+
+	invalid := apperrors.Unauthorized("invalid_credentials", "invalid card or PIN")
+
+	student, err := p.studentRepo.GetByCardID(ctx, strings.TrimSpace(req.CardId))
+	var credential *models.StudentCredential
+	if err == nil {
+		credential, err = p.authRepo.GetCredential(ctx, student.Id)
+	}
+	if err != nil {
+		if !errors.Is(err, apperrors.ErrNotFound) {
+			return nil, "", err
+		}
+		_ = bcrypt.CompareHashAndPassword(dummyPinHash, []byte(req.Pin))
+		return nil, "", invalid
+	}
+
+	now := p.clock.Now()
+	if credential.LockedUntil != nil && credential.LockedUntil.After(now) {
+		return nil, "", apperrors.TooManyRequests("pin_locked", "too many wrong PINs, try again after %s", credential.LockedUntil.UTC().Format(time.RFC3339))
+	}
+	if err := bcrypt.CompareHashAndPassword(credential.PinHash, []byte(req.Pin)); err != nil {
+		if err := p.authRepo.RecordFailedAttempt(ctx, student.Id, p.opts.MaxPinAttempts, now.Add(p.opts.Lockout)); err != nil {
+			return nil, "", err
+		}
+		return nil, "", invalid
+	}
+	if err := p.authRepo.ResetFailedAttempts(ctx, student.Id); err != nil {
+		return nil, "", err
+	}
+
+	return p.createSession(ctx, student.Id)
+}
+
+// SendLoginLink emails a sign-in link to the student with the card. Nothing
+// is sent, and no error returned, when the card is unknown or has no email,
+// so that the response does not tell which cards exist.
+func (p *portalService) SendLoginLink(ctx context.Context, cardID string) error {
+	ctx, span := tracer.Start(ctx, "PortalService.SendLoginLink")
+	defer span.End()
+
+	if p.opts.Mailer == nil || p.opts.LinkURL == "" {
+		return apperrors.NotFound("login_link_unavailable", "sign-in links are not configured")
+	}
+
+	student, err := p.studentRepo.GetByCardID(ctx, strings.TrimSpace(cardID))
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if student.Email == "" {
+		return nil
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate login token: %w", err)
+	}
+	now := p.clock.Now()
+	err = p.authRepo.CreateLoginToken(ctx, &models.StudentLoginToken{
+		TokenHash: hashToken(token),
+		StudentId: student.Id,
+		ExpiresAt: now.Add(p.opts.LinkTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	link, err := url.Parse(p.opts.LinkURL)
+	if err != nil {
+		return fmt.Errorf("invalid sign-in link URL: %w", err)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return p.opts.Mailer.Send(ctx, delivery.Message{
+		To:      []string{student.Email},
+		Subject: "Sign in to the library",
+		Text: fmt.Sprintf("Hello %s,\n\nUse this link to sign in to the library within %s:\n\n%s\n\nThe link works once. If you did not ask for it, ignore this email.\n",
+			student.FirstName, p.opts.LinkTTL, link),
+		Date: now,
+	})
+}
+
+func (p *portalService) LoginWithToken(ctx context.Context, token string) (*dto.PortalLoginResponse, string, error) {
+	ctx, span := tracer.Start(ctx, "PortalService.LoginWithToken")
+	defer span.End()
+
+	loginToken, err := p.authRepo.UseLoginToken(ctx, hashToken(token))
+	if err != nil {
+		return nil, "", err
+	}
+	return p.createSession(ctx, loginToken.StudentId)
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (p *portalService) createSession(ctx context.Context, studentID uuid.UUID) (*dto.PortalLoginResponse, string, error) {
+	sessionID, err := randomToken()
+	if err != nil {
+		return nil, "", errors.New("failed to create session")
+	}
+
+	session := &models.StudentSession{
+		Id:        sessionID,
+		StudentId: studentID,
+		ExpiresAt: p.clock.Now().Add(p.opts.SessionTTL),
+	}
+	if err := p.authRepo.CreateSession(ctx, session); err != nil {
+		return nil, "", err
+	}
+
+	return &dto.PortalLoginResponse{Message: "Login successful", StudentId: studentID}, sessionID, nil
+}
+
+func (p *portalService) ValidateSession(ctx context.Context, sessionID string) (*models.Student, error) {
+	ctx, span := tracer.Start(ctx, "PortalService.ValidateSession")
+	defer span.End()
+
+	if sessionID == "" {
+		return nil, errors.New("no session provided")
+	}
+
+	session, err := p.authRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session provided: %w", err)
+	}
+	if session.Student.Id == uuid.Nil {
+		return nil, errors.New("student of the session no longer exists")
+	}
+
+	return &session.Student, nil
+}
+
+func (p *portalService) Logout(ctx context.Context, sessionID string) error {
+	ctx, span := tracer.Start(ctx, "PortalService.Logout")
+	defer span.End()
+
+	if sessionID == "" {
+		return nil
+	}
+	return p.authRepo.DeleteSession(ctx, sessionID)
+}
+
+func (p *portalService) GetLoans(ctx context.Context, student *models.Student) (*dto.StudentLoansResponse, error) {
+	ctx, span := tracer.Start(ctx, "PortalService.GetLoans")
+	defer span.End()
+
+	loans, err := p.rentRepo.GetLoansByStudent(ctx, student.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := p.clock.Now()
+	for _, loan := range loans {
+		applyDueDate(loan, p.overduePeriod, now)
+	}
+
+	return &dto.StudentLoansResponse{Results: loans}, nil
+}
+
+func (p *portalService) GetHistory(ctx context.Context, student *models.Student, params dto.PaginationParams) (*dto.StudentHistoryResponse, error) {
+	ctx, span := tracer.Start(ctx, "PortalService.GetHistory")
+	defer span.End()
+
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+	if params.Limit > 100 {
+		params.Limit = 100
+	}
+	if params.Offset < 0 {
+		params.Offset = 0
+	}
+
+	rentals, total, links, err := p.rentRepo.GetHistoryByStudent(ctx, student.Id, params)
+	if err != nil {
+		return nil, err
+	}
+
+	now := p.clock.Now()
+	for _, rental := range rentals {
+		applyDueDate(rental, p.overduePeriod, now)
+	}
+
+	return &dto.StudentHistoryResponse{
+		Results:    rentals,
+		Pagination: dto.NewPaginationInfo(params.Offset, params.Limit, total, links),
+	}, nil
+}
+
+func (p *portalService) UpdateContact(ctx context.Context, student *models.Student, req dto.StudentContactRequest) (*models.Student, error) {
+	ctx, span := tracer.Start(ctx, "PortalService.UpdateContact")
+	defer span.End()
+
+	updated := *student
+	updated.Phone = strings.TrimSpace(req.Phone)
+	updated.Email = strings.TrimSpace(req.Email)
+	if err := p.studentRepo.Update(ctx, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// SetPin sets the portal PIN of a student, lifts any lockout and signs the
+// student out of the portal everywhere.
+func (p *portalService) SetPin(ctx context.Context, studentID, pin string) error {
+	ctx, span := tracer.Start(ctx, "PortalService.SetPin")
+	defer span.End()
+
+	id, err := uuid.Parse(studentID)
+	if err != nil {
+		return apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	student, err := p.studentRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(pin), bcrypt.DefaultCost)
+	if err != nil {
+		return errors.New("failed to hash PIN")
+	}
+	err = p.authRepo.SaveCredential(ctx, &models.StudentCredential{StudentId: student.Id, PinHash: hash})
+	if err != nil {
+		return err
+	}
+	return p.authRepo.DeleteSessionsByStudent(ctx, student.Id)
+}
+
+func (p *portalService) CleanupExpired(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "PortalService.CleanupExpired")
+	defer span.End()
+
+	return p.authRepo.DeleteExpired(ctx)
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+type cardRepository struct {
+	repository.StudentRepository
+	students []*models.Student
+}
+
+func (c *cardRepository) GetByCardID(ctx context.Context, cardID string) (*models.Student, error) {
+	for _, student := range c.students {
+		if student.CardId == cardID {
+			return student, nil
+		}
+	}
+	return nil, apperrors.NotFound("student_not_found", "student not found")
+}
+
+func (c *cardRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Student, error) {
+	for _, student := range c.students {
+		if student.Id == id {
+			return student, nil
+		}
+	}
+	return nil, apperrors.NotFound("student_not_found", "student not found")
+}
+
+func (c *cardRepository) GetStudentsByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Student, error) {
+	var students []*models.Student
+	for _, student := range c.students {
+		if slices.Contains(ids, student.Id) {
+			students = append(students, student)
+		}
+	}
+	return students, nil
+}
+
+type studentAuthRepository struct {
+	repository.StudentAuthRepository
+	credentials map[uuid.UUID]*models.StudentCredential
+	sessions    []*models.StudentSession
+	tokens      map[string]*models.StudentLoginToken
+}
+
+func (s *studentAuthRepository) GetCredential(ctx context.Context, studentID uuid.UUID) (*models.StudentCredential, error) {
+	credential, ok := s.credentials[studentID]
+	if !ok {
+		return nil, apperrors.NotFound("credential_not_found", "no PIN set")
+	}
+	return credential, nil
+}
+
+func (s *studentAuthRepository) RecordFailedAttempt(ctx context.Context, studentID uuid.UUID, maxAttempts int, lockUntil time.Time) error {
+	credential := s.credentials[studentID]
+	credential.FailedAttempts++
+	if credential.FailedAttempts >= maxAttempts {
+		credential.FailedAttempts = 0
+		credential.LockedUntil = &lockUntil
+	}
+	return nil
+}
+
+func (s *studentAuthRepository) ResetFailedAttempts(ctx context.Context, studentID uuid.UUID) error {
+	s.credentials[studentID].FailedAttempts = 0
+	return nil
+}
+
+func (s *studentAuthRepository) CreateSession(ctx context.Context, session *models.StudentSession) error {
+	s.sessions = append(s.sessions, session)
+	return nil
+}
+
+func (s *studentAuthRepository) CreateLoginToken(ctx context.Context, token *models.StudentLoginToken) error {
+	s.tokens[token.TokenHash] = token
+	return nil
+}
+
+func (s *studentAuthRepository) UseLoginToken(ctx context.Context, tokenHash string) (*models.StudentLoginToken, error) {
+	token, ok := s.tokens[tokenHash]
+	if !ok {
+		return nil, apperrors.Unauthorized("invalid_login_token", "invalid or expired sign-in link")
+	}
+	delete(s.tokens, tokenHash)
+	return token, nil
+}
+
+func newPortalTestService(t *testing.T, now time.Time, opts PortalOptions) (PortalService, *studentAuthRepository, *models.Student) {
+	t.Helper()
+
+	student := &models.Student{Id: uuid.New(), FirstName: "Ada", CardId: "C-100", Email: "ada@example.com"}
+	hash, err := bcrypt.GenerateFromPassword([]byte("1234"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash PIN: %v", err)
+	}
+	auth := &studentAuthRepository{
+		credentials: map[uuid.UUID]*models.StudentCredential{student.Id: {StudentId: student.Id, PinHash: hash}},
+		tokens:      map[string]*models.StudentLoginToken{},
+	}
+	students := &cardRepository{students: []*models.Student{student}}
+
+	opts.SessionTTL = time.Hour
+	opts.MaxPinAttempts = 3
+	opts.Lockout = 15 * time.Minute
+	opts.LinkTTL = 15 * time.Minute
+	return NewPortalService(auth, students, nil, 14, opts, clock.NewFake(now)), auth, student
+}
+
+func TestPortalLogin(t *testing.T) {
+	now := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	t.Run("correct PIN creates a session", func(t *testing.T) {
+		svc, auth, student := newPortalTestService(t, now, PortalOptions{})
+
+		response, sessionID, err := svc.Login(ctx, dto.PortalLoginRequest{CardId: "C-100", Pin: "1234"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if response.StudentId != student.Id || sessionID == "" {
+			t.Errorf("expected a session for %s, got %+v %q", student.Id, response, sessionID)
+		}
+		if len(auth.sessions) != 1 || !auth.sessions[0].ExpiresAt.Equal(now.Add(time.Hour)) {
+			t.Errorf("expected one session expiring in an hour, got %+v", auth.sessions)
+		}
+	})
+
+	t.Run("unknown card and wrong PIN look the same", func(t *testing.T) {
+		svc, _, _ := newPortalTestService(t, now, PortalOptions{})
+
+		_, _, unknown := svc.Login(ctx, dto.PortalLoginRequest{CardId: "C-999", Pin: "1234"})
+		_, _, wrong := svc.Login(ctx, dto.PortalLoginRequest{CardId: "C-100", Pin: "0000"})
+		for _, err := range []error{unknown, wrong} {
+			var appErr *apperrors.Error
+			if !errors.As(err, &appErr) || appErr.Code != "invalid_credentials" {
+				t.Errorf("expected invalid_credentials, got %v", err)
+			}
+		}
+	})
+
+	t.Run("too many wrong PINs lock the card", func(t *testing.T) {
+		svc, auth, student := newPortalTestService(t, now, PortalOptions{})
+
+		for i := 0; i < 3; i++ {
+			if _, _, err := svc.Login(ctx, dto.PortalLoginRequest{CardId: "C-100", Pin: "0000"}); !errors.Is(err, apperrors.ErrUnauthorized) {
+				t.Fatalf("attempt %d: expected unauthorized, got %v", i+1, err)
+			}
+		}
+
+		_, _, err := svc.Login(ctx, dto.PortalLoginRequest{CardId: "C-100", Pin: "1234"})
+		if !errors.Is(err, apperrors.ErrTooManyRequests) {
+			t.Fatalf("expected the correct PIN to be refused while locked, got %v", err)
+		}
+		if locked := auth.credentials[student.Id].LockedUntil; locked == nil || !locked.Equal(now.Add(15*time.Minute)) {
+			t.Errorf("expected lock until %s, got %v", now.Add(15*time.Minute), locked)
+		}
+		if len(auth.sessions) != 0 {
+			t.Errorf("expected no session, got %d", len(auth.sessions))
+		}
+	})
+}
+
+func TestSendLoginLink(t *testing.T) {
+	now := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	t.Run("not configured", func(t *testing.T) {
+		svc, _, _ := newPortalTestService(t, now, PortalOptions{})
+
+		if err := svc.SendLoginLink(ctx, "C-100"); !errors.Is(err, apperrors.ErrNotFound) {
+			t.Errorf("expected not found, got %v", err)
+		}
+	})
+
+	t.Run("unknown card sends nothing", func(t *testing.T) {
+		mailer := &fakeMailer{}
+		svc, auth, _ := newPortalTestService(t, now, PortalOptions{Mailer: mailer, LinkURL: "https://library.example.com/portal"})
+
+		if err := svc.SendLoginLink(ctx, "C-999"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(mailer.sent) != 0 || len(auth.tokens) != 0 {
+			t.Errorf("expected nothing sent, got %d messages and %d tokens", len(mailer.sent), len(auth.tokens))
+		}
+	})
+
+	t.Run("link signs in once", func(t *testing.T) {
+		mailer := &fakeMailer{}
+		svc, auth, student := newPortalTestService(t, now, PortalOptions{Mailer: mailer, LinkURL: "https://library.example.com/portal?lang=en"})
+
+		if err := svc.SendLoginLink(ctx, "C-100"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(mailer.sent) != 1 || mailer.sent[0].To[0] != student.Email {
+			t.Fatalf("expected one message to %s, got %+v", student.Email, mailer.sent)
+		}
+
+		var token string
+		for _, field := range strings.Fields(mailer.sent[0].Text) {
+			if link, err := url.Parse(field); err == nil && link.Query().Get("token") != "" {
+				if link.Query().Get("lang") != "en" {
+					t.Errorf("expected the configured query to be kept, got %s", link)
+				}
+				token = link.Query().Get("token")
+			}
+		}
+		if token == "" {
+			t.Fatalf("expected a sign-in link in %q", mailer.sent[0].Text)
+		}
+		if _, ok := auth.tokens[token]; ok {
+			t.Errorf("expected the token to be stored hashed")
+		}
+
+		response, sessionID, err := svc.LoginWithToken(ctx, token)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if response.StudentId != student.Id || sessionID == "" {
+			t.Errorf("expected a session for %s, got %+v %q", student.Id, response, sessionID)
+		}
+		if _, _, err := svc.LoginWithToken(ctx, token); !errors.Is(err, apperrors.ErrUnauthorized) {
+			t.Errorf("expected a used link to be refused, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+// ReceiptService lists the books of a cart for a printed receipt. A rented
+// cart gets a checkout receipt with due dates, a returned one a return
+// receipt with the condition of each book.
+type ReceiptService interface {
+	GetReceipt(ctx context.Context, cartID string) (*dto.Receipt, error)
+}
+
+type receiptService struct {
+	cartRepo      repository.CartRepository
+	studentRepo   repository.StudentRepository
+	rentRepo      repository.RentRepository
+	overduePeriod int
+	clock         clock.Clock
+}
+
+func NewReceiptService(cartRepo repository.CartRepository, studentRepo repository.StudentRepository, rentRepo repository.RentRepository, overduePeriod int, clock clock.Clock) ReceiptService {
+	return &receiptService{
+		cartRepo:      cartRepo,
+		studentRepo:   studentRepo,
+		rentRepo:      rentRepo,
+		overduePeriod: overduePeriod,
+		clock:         clock,
+	}
+}
+
+func (s *receiptService) GetReceipt(ctx context.Context, cartID string) (*dto.Receipt, error) {
+	ctx, span := tracer.Start(ctx, "ReceiptService.GetReceipt")
+	defer span.End()
+
+	id, err := uuid.Parse(cartID)
+	if err != nil {
+		return nil, apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+
+	cart, err := s.cartRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	student, err := s.studentRepo.GetByID(ctx, cart.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	rentals, err := s.rentRepo.GetRentalsByCart(ctx, cart.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := dto.ReceiptCheckout
+	if cart.Status == "RETURNED" {
+		kind = dto.ReceiptReturn
+	}
+	receipt := newReceipt(kind, student, rentals, s.overduePeriod, s.clock.Now())
+	receipt.CartID = cart.Id
+	return receipt, nil
+}
+
+// newReceipt lists the rentals of a cart with their due dates, overduePeriod
+// days after the rental.
+func newReceipt(kind string, student *models.Student, rentals []*dto.StudentRental, overduePeriod int, now time.Time) *dto.Receipt {
+	receipt := &dto.Receipt{
+		Kind: kind,
+		Student: dto.ReceiptStudent{
+			ID:     student.Id,
+			Name:   strings.TrimSpace(student.FirstName + " " + student.LastName),
+			CardID: student.CardId,
+		},
+		Items:    make([]dto.ReceiptItem, len(rentals)),
+		IssuedAt: now,
+	}
+	for i, rental := range rentals {
+		applyDueDate(rental, overduePeriod, now)
+		receipt.CartID = rental.CartID
+		receipt.Items[i] = dto.ReceiptItem{
+			BookID:       rental.BookID,
+			Title:        rental.BookTitle,
+			DueDate:      rental.DueDate,
+			ReturnedDate: rental.ReturnedDate,
+			Condition:    rental.Condition,
+			OverdueDays:  rental.OverdueDays,
+		}
+	}
+	return receipt
+}
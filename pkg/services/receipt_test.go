@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+type cartRepository struct {
+	repository.CartRepository
+	carts []*models.Cart
+}
+
+func (c *cartRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Cart, error) {
+	for _, cart := range c.carts {
+		if cart.Id == id {
+			return cart, nil
+		}
+	}
+	return nil, apperrors.NotFound("cart_not_found", "cart not found")
+}
+
+type cartRentRepository struct {
+	repository.RentRepository
+	rentals map[uuid.UUID][]*dto.StudentRental
+}
+
+func (c *cartRentRepository) GetRentalsByCart(ctx context.Context, cartID uuid.UUID) ([]*dto.StudentRental, error) {
+	return c.rentals[cartID], nil
+}
+
+func TestGetReceipt(t *testing.T) {
+	now := time.Date(2025, 3, 20, 9, 0, 0, 0, time.UTC)
+	rentedAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	returnedAt := time.Date(2025, 3, 18, 9, 0, 0, 0, time.UTC)
+
+	student := &models.Student{Id: uuid.New(), CardId: "C-100", FirstName: "Ada", LastName: "Lovelace"}
+	rented := &models.Cart{Id: uuid.New(), StudentId: student.Id, Status: "RENTED"}
+	returned := &models.Cart{Id: uuid.New(), StudentId: student.Id, Status: "RETURNED"}
+	rents := &cartRentRepository{rentals: map[uuid.UUID][]*dto.StudentRental{
+		rented.Id: {{CartID: rented.Id, BookTitle: "Dune", RentedDate: now}},
+		returned.Id: {{
+			CartID: returned.Id, BookTitle: "Emma", RentedDate: rentedAt,
+			ReturnedDate: &returnedAt, Condition: models.ConditionDamaged,
+		}},
+	}}
+	service := NewReceiptService(
+		&cartRepository{carts: []*models.Cart{rented, returned}},
+		&cardRepository{students: []*models.Student{student}},
+		rents, 14, clock.NewFake(now))
+
+	t.Run("rented cart", func(t *testing.T) {
+		receipt, err := service.GetReceipt(context.Background(), rented.Id.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if receipt.Kind != dto.ReceiptCheckout || receipt.CartID != rented.Id || receipt.Student.Name != "Ada Lovelace" {
+			t.Errorf("unexpected receipt %+v", receipt)
+		}
+		if len(receipt.Items) != 1 || !receipt.Items[0].DueDate.Equal(now.AddDate(0, 0, 14)) {
+			t.Errorf("expected the book due in 14 days, got %+v", receipt.Items)
+		}
+	})
+
+	t.Run("returned cart", func(t *testing.T) {
+		receipt, err := service.GetReceipt(context.Background(), returned.Id.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if receipt.Kind != dto.ReceiptReturn {
+			t.Errorf("expected a return receipt, got %q", receipt.Kind)
+		}
+		item := receipt.Items[0]
+		if item.OverdueDays != 3 || item.Condition != models.ConditionDamaged {
+			t.Errorf("expected a damaged book 3 days late, got %+v", item)
+		}
+	})
+
+	t.Run("unknown cart", func(t *testing.T) {
+		if _, err := service.GetReceipt(context.Background(), uuid.NewString()); !errors.Is(err, apperrors.ErrNotFound) {
+			t.Errorf("expected not found, got %v", err)
+		}
+	})
+}
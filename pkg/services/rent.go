@@ -2,10 +2,18 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
 	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
 	"BRSBackend/pkg/repository"
@@ -15,7 +23,8 @@ type RentService interface {
 	CreateRentTransaction(ctx context.Context, req dto.CreateRentRequest) (*dto.CreateRentResponse, error)
 	GetRents(ctx context.Context, filters dto.RentFilters) (*dto.GetRentedBooksResponse, error)
 	GetRentedBooksByStudent(ctx context.Context, studentCardID *string) (*dto.GetRentedBooksResponse, error)
-	ReturnBooks(ctx context.Context, cartID uuid.UUID) (*dto.ReturnBooksResponse, error)
+	ReturnBooks(ctx context.Context, req dto.ReturnBooksRequest) (*dto.ReturnBooksResponse, error)
+	GetReturnHistory(ctx context.Context, filters dto.ReturnFilters) (*dto.ReturnHistoryResponse, error)
 }
 
 type rentService struct {
@@ -23,6 +32,8 @@ type rentService struct {
 	cartRepo    repository.CartRepository
 	bookRepo    repository.BookRepository
 	studentRepo repository.StudentRepository
+	returnRepo  repository.ReturnRepository
+	clock       clock.Clock
 }
 
 func NewRentService(
@@ -30,51 +41,90 @@ func NewRentService(
 	cartRepo repository.CartRepository,
 	bookRepo repository.BookRepository,
 	studentRepo repository.StudentRepository,
+	returnRepo repository.ReturnRepository,
+	clock clock.Clock,
 ) RentService {
 	return &rentService{
 		rentRepo:    rentRepo,
 		cartRepo:    cartRepo,
 		bookRepo:    bookRepo,
 		studentRepo: studentRepo,
+		returnRepo:  returnRepo,
+		clock:       clock,
 	}
 }
 
 func (r *rentService) CreateRentTransaction(ctx context.Context, req dto.CreateRentRequest) (*dto.CreateRentResponse, error) {
-	student, err := r.studentRepo.GetByID(ctx, req.StudentID)
-	if err != nil {
-		return nil, fmt.Errorf("student not found: %w", err)
+	ctx, span := tracer.Start(ctx, "RentService.CreateRentTransaction",
+		trace.WithAttributes(attribute.Int("rent.book_count", len(req.BookIDs)+len(req.BookCodes))))
+	defer span.End()
+
+	if len(req.BookIDs)+len(req.BookCodes) == 0 {
+		return nil, apperrors.Validation("book_ids_required", "at least one book is required")
 	}
-	if student == nil {
-		return nil, fmt.Errorf("student with ID %s not found", req.StudentID)
+
+	var missing identifiers
+
+	student, err := r.findStudent(ctx, req.StudentID, req.CardID, &missing)
+	if err != nil {
+		return nil, err
 	}
 
 	books, err := r.bookRepo.GetBooksByIDs(ctx, req.BookIDs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch books: %w", err)
 	}
-	if len(books) != len(req.BookIDs) {
-		return nil, fmt.Errorf("one or more books not found")
+	byID := make(map[uuid.UUID]*models.Book, len(books))
+	for _, book := range books {
+		byID[book.Id] = book
+	}
+
+	byCode, err := r.findBooksByCode(ctx, req.BookCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	var bookIDs []uuid.UUID
+	for i, bookID := range req.BookIDs {
+		if _, ok := byID[bookID]; !ok {
+			missing.book(fmt.Sprintf("book_ids[%d]", i), "no book with id %s", bookID)
+			continue
+		}
+		bookIDs = append(bookIDs, bookID)
+	}
+	for i, code := range req.BookCodes {
+		book, ok := byCode[code]
+		if !ok {
+			missing.book(fmt.Sprintf("book_codes[%d]", i), "no book with barcode or ISBN %q", code)
+			continue
+		}
+		byID[book.Id] = book
+		bookIDs = append(bookIDs, book.Id)
+	}
+
+	if err := missing.err(); err != nil {
+		return nil, err
 	}
 
 	bookCounts := make(map[uuid.UUID]int)
-	for _, bookID := range req.BookIDs {
+	for _, bookID := range bookIDs {
 		bookCounts[bookID]++
 	}
 
-	if (bookCounts == nil) || (len(bookCounts) == 0 || len(bookCounts) > 3) {
-		return nil, fmt.Errorf("invalid book counts: %d", len(bookCounts))
+	if len(bookCounts) > 3 {
+		return nil, apperrors.RentalLimit("a cart may contain at most 3 distinct books, got %d", len(bookCounts))
 	}
 
-	for _, book := range books {
-		requestedCount := bookCounts[book.Id]
+	for bookID, requestedCount := range bookCounts {
+		book := byID[bookID]
 		if book.Count < requestedCount {
-			return nil, fmt.Errorf("insufficient copies of book '%s': available=%d, requested=%d",
+			return nil, apperrors.InsufficientStock("insufficient copies of book '%s': available=%d, requested=%d",
 				book.Title, book.Count, requestedCount)
 		}
 	}
 
 	cart := &models.Cart{
-		StudentId: req.StudentID,
+		StudentId: student.Id,
 		Status:    "RENTED",
 	}
 
@@ -82,7 +132,7 @@ func (r *rentService) CreateRentTransaction(ctx context.Context, req dto.CreateR
 		return nil, fmt.Errorf("failed to create cart: %w", err)
 	}
 
-	for _, bookID := range req.BookIDs {
+	for _, bookID := range bookIDs {
 		rent := &models.Rent{
 			CartId: cart.Id,
 			BookId: bookID,
@@ -93,58 +143,137 @@ func (r *rentService) CreateRentTransaction(ctx context.Context, req dto.CreateR
 		}
 	}
 
-	if err := r.bookRepo.DecrementMultipleBooks(ctx, req.BookIDs); err != nil {
+	if err := r.bookRepo.DecrementMultipleBooks(ctx, bookIDs); err != nil {
 		return nil, fmt.Errorf("failed to update book counts: %w", err)
 	}
 
 	return &dto.CreateRentResponse{
-		CartID:  cart.Id,
-		Message: "Books rented successfully",
+		CartID:    cart.Id,
+		StudentID: student.Id,
+		BookIDs:   bookIDs,
+		Message:   "Books rented successfully",
 	}, nil
 }
 
-func (r *rentService) GetRents(ctx context.Context, filters dto.RentFilters) (*dto.GetRentedBooksResponse, error) {
+// identifiers collects the request identifiers that match no record, so that
+// a desk scanning several items learns about all of them at once.
+type identifiers struct {
+	fields         []apperrors.FieldError
+	studentMissing bool
+}
 
-	if filters.Limit <= 0 {
-		filters.Limit = 10
+func (m *identifiers) student(field, format string, args ...any) {
+	m.studentMissing = true
+	m.fields = append(m.fields, apperrors.FieldError{Field: field, Code: "student_not_found", Message: fmt.Sprintf(format, args...)})
+}
+
+func (m *identifiers) book(field, format string, args ...any) {
+	m.fields = append(m.fields, apperrors.FieldError{Field: field, Code: "book_not_found", Message: fmt.Sprintf(format, args...)})
+}
+
+func (m *identifiers) err() error {
+	if len(m.fields) == 0 {
+		return nil
+	}
+	code := "book_not_found"
+	if m.studentMissing {
+		code = "student_not_found"
+	}
+	return apperrors.NotFound(code, "%d identifier(s) not found", len(m.fields)).WithFields(m.fields...)
+}
+
+// findStudent looks the student up by id, or by card id when no id is given.
+// A student that does not exist is recorded in missing and returned as nil.
+func (r *rentService) findStudent(ctx context.Context, id uuid.UUID, cardID string, missing *identifiers) (*models.Student, error) {
+	var student *models.Student
+	var err error
+	if id != uuid.Nil {
+		student, err = r.studentRepo.GetByID(ctx, id)
+	} else {
+		student, err = r.studentRepo.GetByCardID(ctx, cardID)
 	}
 
-	if filters.Offset < 0 {
-		filters.Offset = 0
+	switch {
+	case errors.Is(err, apperrors.ErrNotFound) && id != uuid.Nil:
+		missing.student("student_id", "no student with id %s", id)
+	case errors.Is(err, apperrors.ErrNotFound):
+		missing.student("card_id", "no student with card %q", cardID)
+	case err != nil:
+		return nil, fmt.Errorf("failed to get student: %w", err)
 	}
+	return student, nil
+}
 
-	repoFilters := dto.RentFilters{
-		BookName:    filters.BookName,
-		StudentName: filters.StudentName,
-		Date:        filters.Date,
-		Limit:       filters.Limit,
-		Offset:      filters.Offset,
+// findBooksByCode maps each code to the book with that barcode or, failing
+// that, that ISBN. Codes matching no book are left out.
+func (r *rentService) findBooksByCode(ctx context.Context, codes []string) (map[string]*models.Book, error) {
+	if len(codes) == 0 {
+		return nil, nil
 	}
 
-	rents, totalRents, err := r.rentRepo.GetRentsByFilters(ctx, repoFilters)
+	books, err := r.bookRepo.GetBooksByCodes(ctx, codes)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get rents: %w", err)
+		return nil, err
+	}
+
+	byBarcode := make(map[string]*models.Book, len(books))
+	byISBN := make(map[string]*models.Book, len(books))
+	for _, book := range books {
+		if book.Barcode != nil {
+			byBarcode[*book.Barcode] = book
+		}
+		if book.Isbn != nil {
+			byISBN[*book.Isbn] = book
+		}
+	}
+
+	byCode := make(map[string]*models.Book, len(codes))
+	for _, code := range codes {
+		if book, ok := byBarcode[strings.TrimSpace(code)]; ok {
+			byCode[code] = book
+		} else if book, ok := byISBN[models.NormalizeISBN(code)]; ok {
+			byCode[code] = book
+		}
+	}
+	return byCode, nil
+}
+
+func (r *rentService) GetRents(ctx context.Context, filters dto.RentFilters) (*dto.GetRentedBooksResponse, error) {
+	ctx, span := tracer.Start(ctx, "RentService.GetRents")
+	defer span.End()
+
+	if filters.Limit <= 0 {
+		filters.Limit = 10
 	}
 
 	if filters.Limit > 100 {
 		filters.Limit = 100
 	}
 
-	pagination := dto.PaginationInfo{
-		Total:       int(totalRents),
-		Limit:       filters.Limit,
-		Offset:      filters.Offset,
-		HasNext:     filters.Offset+filters.Limit < int(totalRents),
-		HasPrevious: filters.Offset > 0,
+	if filters.Offset < 0 {
+		filters.Offset = 0
+	}
+
+	if filters.Date == nil {
+		today := r.clock.Now()
+		filters.Date = &today
+	}
+
+	rents, totalRents, links, err := r.rentRepo.GetRentsByFilters(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rents: %w", err)
 	}
 
 	return &dto.GetRentedBooksResponse{
 		Results:    rents,
-		Pagination: pagination,
+		Pagination: dto.NewPaginationInfo(filters.Offset, filters.Limit, totalRents, links),
 	}, nil
 }
 
 func (r *rentService) GetRentedBooksByStudent(ctx context.Context, studentCardID *string) (*dto.GetRentedBooksResponse, error) {
+	ctx, span := tracer.Start(ctx, "RentService.GetRentedBooksByStudent")
+	defer span.End()
+
 	cardID := ""
 	if studentCardID != nil {
 		cardID = *studentCardID
@@ -167,14 +296,42 @@ func (r *rentService) GetRentedBooksByStudent(ctx context.Context, studentCardID
 	}, nil
 }
 
-func (r *rentService) ReturnBooks(ctx context.Context, cartID uuid.UUID) (*dto.ReturnBooksResponse, error) {
-	cart, err := r.cartRepo.GetByID(ctx, cartID)
+func (r *rentService) ReturnBooks(ctx context.Context, req dto.ReturnBooksRequest) (*dto.ReturnBooksResponse, error) {
+	ctx, span := tracer.Start(ctx, "RentService.ReturnBooks",
+		trace.WithAttributes(attribute.Int("return.item_count", len(req.Items))))
+	defer span.End()
+
+	var missing identifiers
+
+	items, err := r.resolveReturnItems(ctx, req.Items, &missing)
 	if err != nil {
+		return nil, err
+	}
+	req.Items = items
+
+	var student *models.Student
+	if req.CartID == uuid.Nil {
+		if student, err = r.findStudent(ctx, uuid.Nil, req.CardID, &missing); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := missing.err(); err != nil {
+		return nil, err
+	}
+
+	var cart *models.Cart
+	if student != nil {
+		if cart, err = r.findRentedCart(ctx, student, req.Items); err != nil {
+			return nil, err
+		}
+		req.CartID = cart.Id
+	} else if cart, err = r.cartRepo.GetByID(ctx, req.CartID); err != nil {
 		return nil, fmt.Errorf("cart not found: %w", err)
 	}
 
 	if cart.Status != "RENTED" {
-		return nil, fmt.Errorf("cart %s is not currently rented (status: %s)", cartID, cart.Status)
+		return nil, apperrors.Conflict("cart_not_rented", "cart %s is not currently rented (status: %s)", req.CartID, cart.Status)
 	}
 
 	rents, err := r.rentRepo.GetRentsByCartID(ctx, cart.Id)
@@ -186,21 +343,224 @@ func (r *rentService) ReturnBooks(ctx context.Context, cartID uuid.UUID) (*dto.R
 		return nil, fmt.Errorf("no rent records found for cart")
 	}
 
+	returnedAt := r.clock.Now()
+	events, err := returnEvents(cart, rents, req, returnedAt)
+	if err != nil {
+		return nil, err
+	}
+
 	var bookIDs []uuid.UUID
+	for _, event := range events {
+		if event.Condition != models.ConditionLost {
+			bookIDs = append(bookIDs, event.BookId)
+		}
+	}
+
+	if err := r.returnRepo.ReturnCart(ctx, cart.Id, events, bookIDs); err != nil {
+		return nil, err
+	}
+
+	return &dto.ReturnBooksResponse{
+		Message:    "Cart marked as returned",
+		CartID:     req.CartID,
+		ReturnedAt: returnedAt,
+	}, nil
+}
+
+// resolveReturnItems fills in the book id of items identified by barcode or
+// ISBN, recording the codes that match no book in missing.
+func (r *rentService) resolveReturnItems(ctx context.Context, items []dto.ReturnItem, missing *identifiers) ([]dto.ReturnItem, error) {
+	var codes []string
+	for _, item := range items {
+		if item.BookID == uuid.Nil {
+			codes = append(codes, item.BookCode)
+		}
+	}
+
+	byCode, err := r.findBooksByCode(ctx, codes)
+	if err != nil {
+		return nil, err
+	}
+
+	items = slices.Clone(items)
+	for i, item := range items {
+		if item.BookID != uuid.Nil {
+			continue
+		}
+		book, ok := byCode[item.BookCode]
+		if !ok {
+			missing.book(fmt.Sprintf("items[%d].book_code", i), "no book with barcode or ISBN %q", item.BookCode)
+			continue
+		}
+		items[i].BookID = book.Id
+	}
+	return items, nil
+}
+
+// findRentedCart picks the rented cart of a student that is being returned:
+// the only one, or the only one holding every listed book.
+func (r *rentService) findRentedCart(ctx context.Context, student *models.Student, items []dto.ReturnItem) (*models.Cart, error) {
+	carts, err := r.cartRepo.GetCartsByStudentID(ctx, student.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student carts: %w", err)
+	}
+	carts = slices.DeleteFunc(carts, func(cart *models.Cart) bool { return cart.Status != "RENTED" })
+
+	if len(carts) == 0 {
+		return nil, apperrors.NotFound("cart_not_found", "student with card %s has no rented books", student.CardId)
+	}
+	if len(carts) == 1 {
+		return carts[0], nil
+	}
+
+	var matches []*models.Cart
+	if len(items) > 0 {
+		for _, cart := range carts {
+			rents, err := r.rentRepo.GetRentsByCartID(ctx, cart.Id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get rent records: %w", err)
+			}
+			if holdsItems(rents, items) {
+				matches = append(matches, cart)
+			}
+		}
+	}
+	if len(matches) != 1 {
+		return nil, apperrors.Conflict("cart_ambiguous",
+			"student with card %s has %d rented carts; pass cart_id or list books that belong to a single cart", student.CardId, len(carts))
+	}
+	return matches[0], nil
+}
+
+func holdsItems(rents []*models.Rent, items []dto.ReturnItem) bool {
+	copies := make(map[uuid.UUID]int)
 	for _, rent := range rents {
-		bookIDs = append(bookIDs, rent.BookId)
+		copies[rent.BookId]++
 	}
+	for _, item := range items {
+		if copies[item.BookID] == 0 {
+			return false
+		}
+		copies[item.BookID]--
+	}
+	return true
+}
 
-	if err := r.bookRepo.IncrementMultipleBooks(ctx, bookIDs); err != nil {
-		return nil, fmt.Errorf("failed to update book counts: %w", err)
+var returnConditions = []string{models.ConditionGood, models.ConditionDamaged, models.ConditionLost}
+
+// returnEvents builds one event per rent of the cart, taking the condition
+// from the matching request item. A book rented twice in the same cart may be
+// listed twice; items that match no remaining rent are rejected, and so are
+// unlisted rents of a complete return.
+func returnEvents(cart *models.Cart, rents []*models.Rent, req dto.ReturnBooksRequest, returnedAt time.Time) ([]*models.ReturnEvent, error) {
+	var fields []apperrors.FieldError
+
+	pending := make(map[uuid.UUID][]int)
+	for i, item := range req.Items {
+		if item.Condition != "" && !slices.Contains(returnConditions, item.Condition) {
+			fields = append(fields, apperrors.FieldError{
+				Field:   fmt.Sprintf("items[%d].condition", i),
+				Code:    "oneof",
+				Message: "condition must be one of " + strings.Join(returnConditions, ", "),
+			})
+		}
+		pending[item.BookID] = append(pending[item.BookID], i)
 	}
 
-	if err := r.cartRepo.UpdateStatus(ctx, cartID, "RETURNED"); err != nil {
-		return nil, fmt.Errorf("failed to update cart status: %w", err)
+	unlisted := 0
+	events := make([]*models.ReturnEvent, 0, len(rents))
+	for _, rent := range rents {
+		event := &models.ReturnEvent{
+			CartId:      cart.Id,
+			RentId:      rent.Id,
+			BookId:      rent.BookId,
+			StudentId:   cart.StudentId,
+			LibrarianId: req.LibrarianID,
+			Condition:   models.ConditionGood,
+			ReturnedAt:  returnedAt,
+		}
+		if indexes := pending[rent.BookId]; len(indexes) > 0 {
+			item := req.Items[indexes[0]]
+			if item.Condition != "" {
+				event.Condition = item.Condition
+			}
+			event.Notes = item.Notes
+			pending[rent.BookId] = indexes[1:]
+		} else {
+			unlisted++
+		}
+		events = append(events, event)
 	}
 
-	return &dto.ReturnBooksResponse{
-		Message: "Cart marked as returned",
-		CartID:  cartID,
+	var unmatched []int
+	for _, indexes := range pending {
+		unmatched = append(unmatched, indexes...)
+	}
+	slices.Sort(unmatched)
+	for _, i := range unmatched {
+		fields = append(fields, apperrors.FieldError{
+			Field:   fmt.Sprintf("items[%d].book_id", i),
+			Code:    "not_in_cart",
+			Message: "book is not part of this cart, or is listed more often than it was rented",
+		})
+	}
+
+	if len(fields) > 0 {
+		return nil, apperrors.Validation("invalid_return_item", "return items do not match the cart", fields...)
+	}
+	if req.Complete && unlisted > 0 {
+		return nil, apperrors.Conflict("return_incomplete", "%d book(s) of the cart were not listed; the whole cart must be returned at once", unlisted)
+	}
+	return events, nil
+}
+
+func (r *rentService) GetReturnHistory(ctx context.Context, filters dto.ReturnFilters) (*dto.ReturnHistoryResponse, error) {
+	ctx, span := tracer.Start(ctx, "RentService.GetReturnHistory")
+	defer span.End()
+
+	if filters.Limit <= 0 {
+		filters.Limit = 10
+	}
+
+	if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+
+	if filters.Offset < 0 {
+		filters.Offset = 0
+	}
+
+	if err := validateReturnFilters(filters); err != nil {
+		return nil, err
+	}
+
+	records, total, links, err := r.returnRepo.GetByFilters(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ReturnHistoryResponse{
+		Results:    records,
+		Pagination: dto.NewPaginationInfo(filters.Offset, filters.Limit, total, links),
 	}, nil
 }
+
+func validateReturnFilters(filters dto.ReturnFilters) error {
+	var fields []apperrors.FieldError
+
+	if filters.Condition != "" && !slices.Contains(returnConditions, filters.Condition) {
+		fields = append(fields, apperrors.FieldError{
+			Field:   "condition",
+			Code:    "oneof",
+			Message: "condition must be one of " + strings.Join(returnConditions, ", "),
+		})
+	}
+	if filters.From != nil && filters.To != nil && filters.To.Before(*filters.From) {
+		fields = append(fields, apperrors.FieldError{Field: "to", Code: "gtefield", Message: "to must not be before from"})
+	}
+
+	if len(fields) > 0 {
+		return apperrors.Validation("invalid_filter", "return filters are invalid", fields...)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/pagination"
+	"BRSBackend/pkg/repository"
+)
+
+func TestReturnEvents(t *testing.T) {
+	cart := &models.Cart{Id: uuid.New(), StudentId: uuid.New()}
+	dune, emma := uuid.New(), uuid.New()
+	rents := []*models.Rent{
+		{Id: uuid.New(), CartId: cart.Id, BookId: dune},
+		{Id: uuid.New(), CartId: cart.Id, BookId: dune},
+		{Id: uuid.New(), CartId: cart.Id, BookId: emma},
+	}
+	librarianID := uuid.New()
+	returnedAt := time.Now()
+
+	t.Run("matches items to rents", func(t *testing.T) {
+		events, err := returnEvents(cart, rents, dto.ReturnBooksRequest{
+			CartID:      cart.Id,
+			LibrarianID: &librarianID,
+			Items: []dto.ReturnItem{
+				{BookID: dune, Condition: models.ConditionLost, Notes: "left on bus"},
+				{BookID: emma, Condition: models.ConditionDamaged},
+			},
+		}, returnedAt)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := []string{models.ConditionLost, models.ConditionGood, models.ConditionDamaged}
+		for i, event := range events {
+			if event.Condition != want[i] {
+				t.Errorf("event %d: expected condition %q, got %q", i, want[i], event.Condition)
+			}
+			if event.RentId != rents[i].Id || event.StudentId != cart.StudentId || *event.LibrarianId != librarianID || !event.ReturnedAt.Equal(returnedAt) {
+				t.Errorf("event %d: unexpected event %+v", i, event)
+			}
+		}
+		if events[0].Notes != "left on bus" {
+			t.Errorf("expected notes on the first event, got %q", events[0].Notes)
+		}
+	})
+
+	t.Run("rejects items not in the cart", func(t *testing.T) {
+		_, err := returnEvents(cart, rents, dto.ReturnBooksRequest{
+			CartID: cart.Id,
+			Items: []dto.ReturnItem{
+				{BookID: emma},
+				{BookID: emma},
+				{BookID: uuid.New(), Condition: "soggy"},
+			},
+		}, returnedAt)
+
+		var appErr *apperrors.Error
+		if !errors.Is(err, apperrors.ErrValidation) || !errors.As(err, &appErr) {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+		fields := make([]string, len(appErr.Fields))
+		for i, field := range appErr.Fields {
+			fields[i] = field.Field
+		}
+		want := []string{"items[2].condition", "items[1].book_id", "items[2].book_id"}
+		if len(fields) != len(want) {
+			t.Fatalf("expected fields %v, got %v", want, fields)
+		}
+		for i := range want {
+			if fields[i] != want[i] {
+				t.Errorf("expected fields %v, got %v", want, fields)
+				break
+			}
+		}
+	})
+
+	t.Run("complete return lists every book", func(t *testing.T) {
+		req := dto.ReturnBooksRequest{
+			CartID:   cart.Id,
+			Items:    []dto.ReturnItem{{BookID: dune}, {BookID: emma}},
+			Complete: true,
+		}
+		if _, err := returnEvents(cart, rents, req, returnedAt); !errors.Is(err, apperrors.ErrConflict) {
+			t.Fatalf("expected conflict for an unlisted copy, got %v", err)
+		}
+
+		req.Items = append(req.Items, dto.ReturnItem{BookID: dune})
+		if _, err := returnEvents(cart, rents, req, returnedAt); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestIdentifiersErr(t *testing.T) {
+	var missing identifiers
+	if err := missing.err(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	missing.book("book_codes[1]", "no book with barcode or ISBN %q", "0004417")
+	if err := missing.err(); !errors.Is(err, apperrors.NotFound("book_not_found", "")) {
+		t.Errorf("expected book_not_found, got %v", err)
+	}
+
+	missing.student("card_id", "no student with card %q", "S-1024")
+	err := missing.err()
+	if !errors.Is(err, apperrors.NotFound("student_not_found", "")) {
+		t.Fatalf("expected student_not_found, got %v", err)
+	}
+	appErr, _ := apperrors.As(err)
+	if len(appErr.Fields) != 2 || appErr.Fields[0].Field != "book_codes[1]" || appErr.Fields[1].Code != "student_not_found" {
+		t.Errorf("unexpected fields %+v", appErr.Fields)
+	}
+}
+
+type filterRentRepository struct {
+	repository.RentRepository
+	filters dto.RentFilters
+}
+
+func (f *filterRentRepository) GetRentsByFilters(ctx context.Context, filters dto.RentFilters) ([]*dto.RentSummary, int64, pagination.Links, error) {
+	f.filters = filters
+	return nil, 0, pagination.Links{}, nil
+}
+
+func TestGetRentsDefaultsToToday(t *testing.T) {
+	now := time.Date(2026, 10, 14, 16, 30, 0, 0, time.UTC)
+	repo := &filterRentRepository{}
+	svc := NewRentService(repo, nil, nil, nil, nil, clock.NewFake(now))
+
+	if _, err := svc.GetRents(context.Background(), dto.RentFilters{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.filters.Date == nil || !repo.filters.Date.Equal(now) {
+		t.Errorf("expected rents of %s, got %v", now, repo.filters.Date)
+	}
+}
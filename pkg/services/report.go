@@ -3,58 +3,129 @@ package services
 import (
 	"context"
 	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
 	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/export"
 	"BRSBackend/pkg/repository"
 )
 
 type ReportService interface {
-	GetOverdueRentals(ctx context.Context, studentCardID *string, limit, offset int) (*dto.OverdueResponse, error)
+	GetOverdueRentals(ctx context.Context, studentCardID *string, params dto.PaginationParams) (*dto.OverdueResponse, error)
 	GetRentalReport(ctx context.Context, limit, offset int) (*dto.RentReport, error)
+	GetCirculationStats(ctx context.Context) (*dto.CirculationStats, error)
+	GetCirculationAnalytics(ctx context.Context, params dto.AnalyticsParams) (*dto.AnalyticsResponse, error)
+	RollupCirculation(ctx context.Context) error
+	GetCollectionReport(ctx context.Context, filters dto.CollectionFilters, from, to *time.Time) (*dto.CollectionReport, error)
+	ExportCollectionReport(ctx context.Context, filters dto.CollectionFilters, from, to *time.Time) (*export.Table, error)
+	ExportOverdueRentals(ctx context.Context, studentCardID *string) (*export.Table, error)
+	ExportRentalReport(ctx context.Context, limit int) (*export.Table, error)
+}
+
+// maxAnalyticsBuckets bounds the range of an analytics request, a year of
+// daily buckets.
+const maxAnalyticsBuckets = 366
+
+// overdueAging are the buckets of days past due the overdue report counts.
+var overdueAging = []dto.AgingBucket{
+	{Label: "1-7", MinDays: 1, MaxDays: intPtr(7)},
+	{Label: "8-30", MinDays: 8, MaxDays: intPtr(30)},
+	{Label: "31-90", MinDays: 31, MaxDays: intPtr(90)},
+	{Label: "90+", MinDays: 91},
 }
 
 type reportService struct {
 	repo          repository.ReportRepository
 	overduePeriod int
+	clock         clock.Clock
 }
 
-func NewReportService(repo repository.ReportRepository, overduePeriod int) ReportService {
+func NewReportService(repo repository.ReportRepository, overduePeriod int, clock clock.Clock) ReportService {
 	return &reportService{
 		repo:          repo,
 		overduePeriod: overduePeriod,
+		clock:         clock,
 	}
 }
 
-func (r *reportService) GetOverdueRentals(ctx context.Context, studentCardID *string, limit, offset int) (*dto.OverdueResponse, error) {
-	if limit <= 0 {
-		limit = 10
+func (r *reportService) GetOverdueRentals(ctx context.Context, studentCardID *string, params dto.PaginationParams) (*dto.OverdueResponse, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetOverdueRentals")
+	defer span.End()
+
+	if params.Limit <= 0 {
+		params.Limit = 10
 	}
-	if limit > 100 {
-		limit = 100
+	if params.Limit > 100 {
+		params.Limit = 100
 	}
-	if offset < 0 {
-		offset = 0
+	if params.Offset < 0 {
+		params.Offset = 0
 	}
 
-	overdueUsers, total, err := r.repo.GetOverdueRentals(ctx, studentCardID, limit, offset, r.overduePeriod)
+	now := r.clock.Now()
+	overdueUsers, total, links, err := r.repo.GetOverdueRentals(ctx, studentCardID, params, now, r.overduePeriod)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get overdue rentals: %w", err)
 	}
+	r.applyOverdue(overdueUsers, now)
+
+	aging, err := r.repo.GetOverdueAging(ctx, studentCardID, overdueAging, now, r.overduePeriod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdue aging: %w", err)
+	}
 
 	return &dto.OverdueResponse{
-		Results: overdueUsers,
-		Pagination: dto.PaginationInfo{
-			Offset:      offset,
-			Limit:       limit,
-			Total:       int(total),
-			HasNext:     offset+limit < len(overdueUsers),
-			HasPrevious: offset > 0,
-		},
+		Results:    overdueUsers,
+		Aging:      aging,
+		Pagination: dto.NewPaginationInfo(params.Offset, params.Limit, total, links),
 	}, nil
+}
 
+// applyOverdue sets the due dates and days past due of the overdue books and
+// of their students.
+func (r *reportService) applyOverdue(users []dto.OverdueUser, now time.Time) {
+	for i := range users {
+		user := &users[i]
+		for j := range user.Items {
+			item := &user.Items[j]
+			item.DueDate = item.RentedAt.AddDate(0, 0, r.overduePeriod)
+			item.DaysOverdue = daysPastDue(item.DueDate, now)
+		}
+		user.DaysOverdue = daysPastDue(user.DateRented.AddDate(0, 0, r.overduePeriod), now)
+	}
+}
+
+// daysPastDue counts every started day after due, so a book is one day
+// overdue as soon as it is past due.
+func daysPastDue(due, now time.Time) int {
+	late := now.Sub(due)
+	if late <= 0 {
+		return 0
+	}
+	return int((late + 24*time.Hour - 1) / (24 * time.Hour))
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
 }
 
 func (r *reportService) GetRentalReport(ctx context.Context, limit, offset int) (*dto.RentReport, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetRentalReport")
+	defer span.End()
+
 	if limit <= 0 {
 		limit = 10
 	}
@@ -65,10 +136,439 @@ func (r *reportService) GetRentalReport(ctx context.Context, limit, offset int)
 		offset = 0
 	}
 
-	report, err := r.repo.GetRentalReport(ctx, limit, offset, r.overduePeriod)
+	now := r.clock.Now()
+	report, err := r.repo.GetRentalReport(ctx, limit, offset, now, r.overduePeriod)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get rental report: %w", err)
 	}
+	r.applyOverdue(report.TopOverdue, now)
 
 	return report, nil
 }
+
+// ExportOverdueRentals returns every overdue book, with the student holding
+// it, as a table summarized by the aging buckets.
+func (r *reportService) ExportOverdueRentals(ctx context.Context, studentCardID *string) (*export.Table, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.ExportOverdueRentals")
+	defer span.End()
+
+	now := r.clock.Now()
+	var users []dto.OverdueUser
+	for params := (dto.PaginationParams{Limit: 100}); ; params.Offset += params.Limit {
+		page, total, _, err := r.repo.GetOverdueRentals(ctx, studentCardID, params, now, r.overduePeriod)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export overdue rentals: %w", err)
+		}
+		users = append(users, page...)
+		if len(page) == 0 || int64(params.Offset+params.Limit) >= total {
+			break
+		}
+	}
+	r.applyOverdue(users, now)
+
+	aging, err := r.repo.GetOverdueAging(ctx, studentCardID, overdueAging, now, r.overduePeriod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdue aging: %w", err)
+	}
+
+	today := now.UTC().Format(time.DateOnly)
+	table := &export.Table{
+		Name:  "overdue-" + today,
+		Title: "Overdue books on " + today,
+		Columns: []string{
+			"student_name", "card_id", "phone", "title", "cart_id", "rented_at", "due_date", "days_overdue",
+		},
+	}
+	for _, bucket := range aging {
+		table.Summary = append(table.Summary, export.Field{
+			Label: bucket.Label + " days overdue",
+			Value: fmt.Sprintf("%d books, %d students", bucket.Items, bucket.Students),
+		})
+	}
+	for _, user := range users {
+		for _, item := range user.Items {
+			table.Rows = append(table.Rows, []string{
+				user.StudentName,
+				user.CardId,
+				user.Phone,
+				item.Title,
+				item.CartId,
+				item.RentedAt.UTC().Format(time.RFC3339),
+				item.DueDate.UTC().Format(time.RFC3339),
+				strconv.Itoa(item.DaysOverdue),
+			})
+		}
+	}
+	return table, nil
+}
+
+// ExportRentalReport returns the limit most rented titles as a table
+// summarized by the rental totals.
+func (r *reportService) ExportRentalReport(ctx context.Context, limit int) (*export.Table, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.ExportRentalReport")
+	defer span.End()
+
+	report, err := r.GetRentalReport(ctx, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	today := r.clock.Now().UTC().Format(time.DateOnly)
+	table := &export.Table{
+		Name:  "rentals-" + today,
+		Title: "Rental report on " + today,
+		Summary: []export.Field{
+			{Label: "Total rentals", Value: strconv.Itoa(report.TotalRents)},
+			{Label: "Students who rented", Value: strconv.Itoa(report.TotalStudents)},
+		},
+		Columns: []string{"rank", "title", "rentals"},
+	}
+	for i, book := range report.TopBooks {
+		table.Rows = append(table.Rows, []string{strconv.Itoa(i + 1), book.BookTitle, strconv.Itoa(book.RentedCount)})
+	}
+	return table, nil
+}
+
+func (r *reportService) GetCirculationStats(ctx context.Context) (*dto.CirculationStats, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetCirculationStats")
+	defer span.End()
+
+	stats, err := r.repo.GetCirculationStats(ctx, r.overduePeriod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get circulation stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// RollupCirculation stores the daily circulation counts of the closed days,
+// those before today in UTC, which the analytics then read instead of
+// recounting them. A clock shifted forward does not close days that have not
+// really happened yet.
+func (r *reportService) RollupCirculation(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "ReportService.RollupCirculation")
+	defer span.End()
+
+	now := r.clock.Now()
+	if wall := clock.Wall(r.clock); wall.Before(now) {
+		now = wall
+	}
+	today := now.UTC().Truncate(24 * time.Hour)
+	if err := r.repo.RollupCirculation(ctx, today, r.overduePeriod); err != nil {
+		return fmt.Errorf("failed to roll up circulation: %w", err)
+	}
+
+	return nil
+}
+
+var (
+	analyticsIntervals = []string{dto.IntervalDay, dto.IntervalWeek, dto.IntervalMonth}
+	analyticsGroupings = []string{dto.GroupByMajor, dto.GroupByBook}
+)
+
+func (r *reportService) GetCirculationAnalytics(ctx context.Context, params dto.AnalyticsParams) (*dto.AnalyticsResponse, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetCirculationAnalytics")
+	defer span.End()
+
+	if params.Interval == "" {
+		params.Interval = dto.IntervalDay
+	}
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+	if params.Limit > 100 {
+		params.Limit = 100
+	}
+
+	if err := validateAnalyticsParams(params); err != nil {
+		return nil, err
+	}
+
+	to := r.clock.Now().UTC().Truncate(24 * time.Hour)
+	if params.To != nil {
+		to = params.To.UTC().Truncate(24 * time.Hour)
+	}
+	from := defaultAnalyticsFrom(to, params.Interval)
+	if params.From != nil {
+		from = params.From.UTC().Truncate(24 * time.Hour)
+	}
+	from = bucketOf(from, params.Interval)
+
+	starts := bucketStarts(from, to, params.Interval)
+	if len(starts) > maxAnalyticsBuckets {
+		return nil, apperrors.Validation("invalid_filter", "analytics parameters are invalid", apperrors.FieldError{
+			Field:   "from",
+			Code:    "max",
+			Message: fmt.Sprintf("the range may span at most %d buckets, got %d", maxAnalyticsBuckets, len(starts)),
+		})
+	}
+
+	rows, err := r.repo.GetCirculationSeries(ctx, from, to.AddDate(0, 0, 1), params.Interval, params.GroupBy, params.Limit, r.overduePeriod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get circulation analytics: %w", err)
+	}
+
+	response := &dto.AnalyticsResponse{
+		From:     from.Format(time.DateOnly),
+		To:       to.Format(time.DateOnly),
+		Interval: params.Interval,
+		GroupBy:  params.GroupBy,
+		Buckets:  emptyBuckets(starts),
+	}
+	index := make(map[string]int, len(starts))
+	for i, start := range starts {
+		index[start] = i
+	}
+
+	groups := make(map[string]*dto.AnalyticsGroup)
+	for _, row := range rows {
+		i, ok := index[row.Bucket]
+		if !ok {
+			continue
+		}
+		if row.GroupKey == "" {
+			addCounts(&response.Totals, row.AnalyticsCounts)
+			addCounts(&response.Buckets[i].AnalyticsCounts, row.AnalyticsCounts)
+			continue
+		}
+		group, ok := groups[row.GroupKey]
+		if !ok {
+			group = &dto.AnalyticsGroup{Key: row.GroupKey, Label: row.GroupLabel, Buckets: emptyBuckets(starts)}
+			groups[row.GroupKey] = group
+		}
+		addCounts(&group.Totals, row.AnalyticsCounts)
+		addCounts(&group.Buckets[i].AnalyticsCounts, row.AnalyticsCounts)
+	}
+
+	for _, group := range groups {
+		response.Groups = append(response.Groups, *group)
+	}
+	slices.SortFunc(response.Groups, func(a, b dto.AnalyticsGroup) int {
+		if a.Totals.Checkouts != b.Totals.Checkouts {
+			return int(b.Totals.Checkouts - a.Totals.Checkouts)
+		}
+		return strings.Compare(a.Label, b.Label)
+	})
+
+	return response, nil
+}
+
+func validateAnalyticsParams(params dto.AnalyticsParams) error {
+	var fields []apperrors.FieldError
+
+	if !slices.Contains(analyticsIntervals, params.Interval) {
+		fields = append(fields, apperrors.FieldError{
+			Field:   "interval",
+			Code:    "oneof",
+			Message: "interval must be one of " + strings.Join(analyticsIntervals, ", "),
+		})
+	}
+	if params.GroupBy != "" && !slices.Contains(analyticsGroupings, params.GroupBy) {
+		fields = append(fields, apperrors.FieldError{
+			Field:   "group_by",
+			Code:    "oneof",
+			Message: "group_by must be one of " + strings.Join(analyticsGroupings, ", "),
+		})
+	}
+	if params.From != nil && params.To != nil && params.To.Before(*params.From) {
+		fields = append(fields, apperrors.FieldError{Field: "to", Code: "gtefield", Message: "to must not be before from"})
+	}
+
+	if len(fields) > 0 {
+		return apperrors.Validation("invalid_filter", "analytics parameters are invalid", fields...)
+	}
+	return nil
+}
+
+// defaultAnalyticsFrom covers the last 30 days, 12 weeks or 12 months up to
+// and including to.
+func defaultAnalyticsFrom(to time.Time, interval string) time.Time {
+	switch interval {
+	case dto.IntervalWeek:
+		return to.AddDate(0, 0, -7*11)
+	case dto.IntervalMonth:
+		return to.AddDate(0, -11, 1-to.Day())
+	default:
+		return to.AddDate(0, 0, -29)
+	}
+}
+
+// bucketOf returns the first day of the day, week (starting Monday) or month
+// holding day.
+func bucketOf(day time.Time, interval string) time.Time {
+	switch interval {
+	case dto.IntervalWeek:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case dto.IntervalMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
+
+func bucketStarts(from, to time.Time, interval string) []string {
+	var starts []string
+	for day := from; !day.After(to); {
+		starts = append(starts, day.Format(time.DateOnly))
+		switch interval {
+		case dto.IntervalWeek:
+			day = day.AddDate(0, 0, 7)
+		case dto.IntervalMonth:
+			day = day.AddDate(0, 1, 0)
+		default:
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+	return starts
+}
+
+func emptyBuckets(starts []string) []dto.AnalyticsBucket {
+	buckets := make([]dto.AnalyticsBucket, len(starts))
+	for i, start := range starts {
+		buckets[i].Start = start
+	}
+	return buckets
+}
+
+func addCounts(total *dto.AnalyticsCounts, counts dto.AnalyticsCounts) {
+	total.Checkouts += counts.Checkouts
+	total.Returns += counts.Returns
+	total.NewStudents += counts.NewStudents
+	total.Overdue += counts.Overdue
+}
+
+// collectionDays is the default period of the collection report.
+const collectionDays = 90
+
+// collectionPeriod resolves the inclusive from and to dates of the collection
+// report, by default the last 90 days, into filters.From and the exclusive
+// filters.To.
+func (r *reportService) collectionPeriod(filters *dto.CollectionFilters, from, to *time.Time) error {
+	end := r.clock.Now().UTC().Truncate(24 * time.Hour)
+	if to != nil {
+		end = to.UTC().Truncate(24 * time.Hour)
+	}
+	start := end.AddDate(0, 0, 1-collectionDays)
+	if from != nil {
+		start = from.UTC().Truncate(24 * time.Hour)
+	}
+
+	if end.Before(start) {
+		return apperrors.Validation("invalid_filter", "collection filters are invalid",
+			apperrors.FieldError{Field: "to", Code: "gtefield", Message: "to must not be before from"})
+	}
+
+	filters.From, filters.To = start, end.AddDate(0, 0, 1)
+	return nil
+}
+
+func (r *reportService) GetCollectionReport(ctx context.Context, filters dto.CollectionFilters, from, to *time.Time) (*dto.CollectionReport, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetCollectionReport")
+	defer span.End()
+
+	if filters.Limit <= 0 {
+		filters.Limit = 10
+	}
+	if filters.Limit > 100 {
+		filters.Limit = 100
+	}
+	if filters.Offset < 0 {
+		filters.Offset = 0
+	}
+	if err := r.collectionPeriod(&filters, from, to); err != nil {
+		return nil, err
+	}
+
+	items, summary, total, links, err := r.repo.GetCollectionReport(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection report: %w", err)
+	}
+	roundCollection(items)
+
+	return &dto.CollectionReport{
+		From:       filters.From.Format(time.DateOnly),
+		To:         filters.To.AddDate(0, 0, -1).Format(time.DateOnly),
+		Summary:    summary,
+		Results:    items,
+		Pagination: dto.NewPaginationInfo(filters.Offset, filters.Limit, total, links),
+	}, nil
+}
+
+// ExportCollectionReport returns every book of the collection report matching
+// the filters as a table.
+func (r *reportService) ExportCollectionReport(ctx context.Context, filters dto.CollectionFilters, from, to *time.Time) (*export.Table, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.ExportCollectionReport")
+	defer span.End()
+
+	if err := r.collectionPeriod(&filters, from, to); err != nil {
+		return nil, err
+	}
+
+	items, err := r.repo.ListCollection(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export collection report: %w", err)
+	}
+	roundCollection(items)
+
+	return collectionTable(items, filters.From, filters.To.AddDate(0, 0, -1)), nil
+}
+
+func roundCollection(items []dto.CollectionItem) {
+	for i := range items {
+		items[i].UtilizationPct = math.Round(items[i].UtilizationPct*10) / 10
+		if days := items[i].AvgLoanDays; days != nil {
+			*days = math.Round(*days*10) / 10
+		}
+	}
+}
+
+func collectionTable(items []dto.CollectionItem, from, to time.Time) *export.Table {
+	var summary dto.CollectionSummary
+	for _, item := range items {
+		summary.Titles++
+		summary.Copies += int64(item.Copies)
+		summary.Rentals += item.Rentals
+		if item.ZeroCirculation {
+			summary.ZeroCirculation++
+		}
+		if item.DemandExceedsSupply {
+			summary.DemandExceedsSupply++
+		}
+	}
+
+	table := &export.Table{
+		Name:  fmt.Sprintf("collection-%s-%s", from.Format(time.DateOnly), to.Format(time.DateOnly)),
+		Title: fmt.Sprintf("Collection report %s to %s", from.Format(time.DateOnly), to.Format(time.DateOnly)),
+		Summary: []export.Field{
+			{Label: "Titles", Value: strconv.FormatInt(summary.Titles, 10)},
+			{Label: "Copies", Value: strconv.FormatInt(summary.Copies, 10)},
+			{Label: "Rentals", Value: strconv.FormatInt(summary.Rentals, 10)},
+			{Label: "Zero circulation", Value: strconv.FormatInt(summary.ZeroCirculation, 10)},
+			{Label: "Demand exceeds supply", Value: strconv.FormatInt(summary.DemandExceedsSupply, 10)},
+		},
+		Columns: []string{
+			"book_id", "title", "isbn", "barcode", "copies", "available", "rentals",
+			"avg_loan_days", "utilization_pct", "zero_circulation", "demand_exceeds_supply",
+		},
+	}
+	for _, item := range items {
+		avgLoanDays := ""
+		if item.AvgLoanDays != nil {
+			avgLoanDays = strconv.FormatFloat(*item.AvgLoanDays, 'f', 1, 64)
+		}
+		table.Rows = append(table.Rows, []string{
+			item.BookId,
+			item.Title,
+			stringOrEmpty(item.Isbn),
+			stringOrEmpty(item.Barcode),
+			strconv.Itoa(item.Copies),
+			strconv.Itoa(item.Available),
+			strconv.FormatInt(item.Rentals, 10),
+			avgLoanDays,
+			strconv.FormatFloat(item.UtilizationPct, 'f', 1, 64),
+			strconv.FormatBool(item.ZeroCirculation),
+			strconv.FormatBool(item.DemandExceedsSupply),
+		})
+	}
+	return table
+}
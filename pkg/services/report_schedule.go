@@ -0,0 +1,476 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/delivery"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/export"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+type ReportScheduleService interface {
+	CreateSchedule(ctx context.Context, req dto.ReportScheduleRequest) (*models.ReportSchedule, error)
+	GetSchedule(ctx context.Context, id string) (*models.ReportSchedule, error)
+	ListSchedules(ctx context.Context) ([]*models.ReportSchedule, error)
+	UpdateSchedule(ctx context.Context, id string, req dto.ReportScheduleRequest) (*models.ReportSchedule, error)
+	DeleteSchedule(ctx context.Context, id string) error
+	RunSchedule(ctx context.Context, id string) (*models.ReportRun, error)
+	GetRuns(ctx context.Context, id string, params dto.PaginationParams) (*dto.ReportRunsResponse, error)
+	GetRunFile(ctx context.Context, runID, format string) (*models.ReportFile, error)
+	RunDueSchedules(ctx context.Context) ([]*models.ReportRun, error)
+	PurgeRuns(ctx context.Context) (int64, error)
+}
+
+// ReportDeliveryOptions configure how saved reports are delivered. Without a
+// Mailer or OutputDir the corresponding delivery is unavailable.
+type ReportDeliveryOptions struct {
+	Mailer    delivery.Mailer
+	OutputDir string
+	Retention time.Duration
+}
+
+// rentalReportLimit is the default number of titles in a saved rental report.
+const rentalReportLimit = 10
+
+var reportContentTypes = map[string]string{
+	models.FormatCSV:  "text/csv; charset=utf-8",
+	models.FormatHTML: "text/html; charset=utf-8",
+}
+
+type reportScheduleService struct {
+	repo    repository.ReportScheduleRepository
+	reports ReportService
+	opts    ReportDeliveryOptions
+	clock   clock.Clock
+}
+
+func NewReportScheduleService(repo repository.ReportScheduleRepository, reports ReportService, opts ReportDeliveryOptions, clock clock.Clock) ReportScheduleService {
+	return &reportScheduleService{
+		repo:    repo,
+		reports: reports,
+		opts:    opts,
+		clock:   clock,
+	}
+}
+
+func (s *reportScheduleService) CreateSchedule(ctx context.Context, req dto.ReportScheduleRequest) (*models.ReportSchedule, error) {
+	ctx, span := tracer.Start(ctx, "ReportScheduleService.CreateSchedule")
+	defer span.End()
+
+	if err := s.validate(req); err != nil {
+		return nil, err
+	}
+
+	schedule := &models.ReportSchedule{}
+	s.apply(schedule, req)
+	if err := s.repo.Create(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func (s *reportScheduleService) GetSchedule(ctx context.Context, id string) (*models.ReportSchedule, error) {
+	ctx, span := tracer.Start(ctx, "ReportScheduleService.GetSchedule")
+	defer span.End()
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	return s.repo.GetByID(ctx, uid)
+}
+
+func (s *reportScheduleService) ListSchedules(ctx context.Context) ([]*models.ReportSchedule, error) {
+	ctx, span := tracer.Start(ctx, "ReportScheduleService.ListSchedules")
+	defer span.End()
+
+	return s.repo.GetAll(ctx)
+}
+
+func (s *reportScheduleService) UpdateSchedule(ctx context.Context, id string, req dto.ReportScheduleRequest) (*models.ReportSchedule, error) {
+	ctx, span := tracer.Start(ctx, "ReportScheduleService.UpdateSchedule")
+	defer span.End()
+
+	schedule, err := s.GetSchedule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validate(req); err != nil {
+		return nil, err
+	}
+
+	s.apply(schedule, req)
+	if err := s.repo.Update(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func (s *reportScheduleService) DeleteSchedule(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "ReportScheduleService.DeleteSchedule")
+	defer span.End()
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	return s.repo.Delete(ctx, uid)
+}
+
+// apply copies the request into the schedule and plans its next run.
+func (s *reportScheduleService) apply(schedule *models.ReportSchedule, req dto.ReportScheduleRequest) {
+	schedule.Name = strings.TrimSpace(req.Name)
+	schedule.Report = req.Report
+	schedule.Params = req.Params
+	schedule.Frequency = req.Frequency
+	schedule.Weekday = req.Weekday
+	schedule.DayOfMonth = req.DayOfMonth
+	schedule.Hour = req.Hour
+	schedule.Formats = req.Formats
+	schedule.Delivery = req.Delivery
+	schedule.Recipients = req.Recipients
+	if schedule.Recipients == nil {
+		schedule.Recipients = []string{}
+	}
+	schedule.Enabled = req.Enabled == nil || *req.Enabled
+	schedule.NextRunAt = nextRun(schedule, s.clock.Now())
+}
+
+// validate checks the rules of a schedule request that span fields, and
+// that its delivery is configured.
+func (s *reportScheduleService) validate(req dto.ReportScheduleRequest) error {
+	var fields []apperrors.FieldError
+	require := func(field string, set, applies bool, what string) {
+		switch {
+		case applies && !set:
+			fields = append(fields, apperrors.FieldError{Field: field, Code: "required", Message: field + " is required for " + what})
+		case !applies && set:
+			fields = append(fields, apperrors.FieldError{Field: field, Code: "excluded", Message: field + " only applies to " + what})
+		}
+	}
+
+	require("weekday", req.Weekday != nil, req.Frequency == models.FrequencyWeekly, "weekly schedules")
+	require("day_of_month", req.DayOfMonth != nil, req.Frequency == models.FrequencyMonthly, "monthly schedules")
+	require("recipients", len(req.Recipients) > 0, req.Delivery == models.DeliveryEmail, "email delivery")
+
+	params := req.Params
+	optional := func(field string, set bool, report string) {
+		if set && req.Report != report {
+			fields = append(fields, apperrors.FieldError{Field: "params." + field, Code: "excluded", Message: field + " only applies to the " + report + " report"})
+		}
+	}
+	optional("student_card_id", params.StudentCardId != nil, models.ReportOverdue)
+	optional("limit", params.Limit != nil, models.ReportRental)
+	optional("period_days", params.PeriodDays != nil, models.ReportCollection)
+	optional("zero_circulation", params.ZeroCirculation != nil, models.ReportCollection)
+	optional("demand_exceeds_supply", params.DemandExceedsSupply != nil, models.ReportCollection)
+	between := func(field string, value *int, min, max int) {
+		if value == nil {
+			return
+		}
+		message := fmt.Sprintf("%s must be between %d and %d", field, min, max)
+		if *value < min {
+			fields = append(fields, apperrors.FieldError{Field: "params." + field, Code: "min", Message: message})
+		} else if *value > max {
+			fields = append(fields, apperrors.FieldError{Field: "params." + field, Code: "max", Message: message})
+		}
+	}
+	between("limit", params.Limit, 1, 100)
+	between("period_days", params.PeriodDays, 1, maxAnalyticsBuckets)
+
+	switch {
+	case req.Delivery == models.DeliveryEmail && s.opts.Mailer == nil:
+		fields = append(fields, apperrors.FieldError{Field: "delivery", Code: "unavailable", Message: "email delivery is not configured on this server"})
+	case req.Delivery == models.DeliveryDirectory && s.opts.OutputDir == "":
+		fields = append(fields, apperrors.FieldError{Field: "delivery", Code: "unavailable", Message: "directory delivery is not configured on this server"})
+	}
+
+	if len(fields) > 0 {
+		return apperrors.Validation("validation_failed", "report schedule is invalid", fields...)
+	}
+	return nil
+}
+
+// nextRun returns the first time after after at which the schedule is due.
+func nextRun(schedule *models.ReportSchedule, after time.Time) time.Time {
+	after = after.UTC()
+	at := after.Truncate(24 * time.Hour).Add(time.Duration(schedule.Hour) * time.Hour)
+
+	switch schedule.Frequency {
+	case models.FrequencyWeekly:
+		at = at.AddDate(0, 0, (*schedule.Weekday-int(at.Weekday())+7)%7)
+		if !at.After(after) {
+			at = at.AddDate(0, 0, 7)
+		}
+	case models.FrequencyMonthly:
+		at = time.Date(at.Year(), at.Month(), *schedule.DayOfMonth, schedule.Hour, 0, 0, 0, time.UTC)
+		if !at.After(after) {
+			at = at.AddDate(0, 1, 0)
+		}
+	default:
+		if !at.After(after) {
+			at = at.AddDate(0, 0, 1)
+		}
+	}
+	return at
+}
+
+func (s *reportScheduleService) RunSchedule(ctx context.Context, id string) (*models.ReportRun, error) {
+	ctx, span := tracer.Start(ctx, "ReportScheduleService.RunSchedule")
+	defer span.End()
+
+	schedule, err := s.GetSchedule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.run(ctx, schedule, models.TriggerManual)
+}
+
+// RunDueSchedules runs every enabled schedule that is due and moves it on to
+// its next run. Runs missed while the server was down are made up by a
+// single run. The error reports failures to run at all; a run that failed to
+// render or deliver is returned with its error.
+func (s *reportScheduleService) RunDueSchedules(ctx context.Context) ([]*models.ReportRun, error) {
+	ctx, span := tracer.Start(ctx, "ReportScheduleService.RunDueSchedules")
+	defer span.End()
+
+	now := s.clock.Now()
+	due, err := s.repo.GetDue(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []*models.ReportRun
+	var errs []error
+	for _, schedule := range due {
+		claimed, err := s.repo.Claim(ctx, schedule.Id, schedule.NextRunAt, nextRun(schedule, now), now)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		run, err := s.run(ctx, schedule, models.TriggerSchedule)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("report schedule %s: %w", schedule.Id, err))
+			continue
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, errors.Join(errs...)
+}
+
+// run renders and delivers the report and records the run. The rendered
+// files are kept even when the delivery fails.
+func (s *reportScheduleService) run(ctx context.Context, schedule *models.ReportSchedule, trigger string) (*models.ReportRun, error) {
+	run := &models.ReportRun{
+		ScheduleId: schedule.Id,
+		Trigger:    trigger,
+		Status:     models.RunRunning,
+		StartedAt:  s.clock.Now().UTC(),
+	}
+	if err := s.repo.CreateRun(ctx, run); err != nil {
+		return nil, err
+	}
+
+	table, err := s.table(ctx, schedule)
+	if err == nil {
+		run.Files, err = render(table, schedule.Formats)
+	}
+	if err == nil {
+		err = s.deliver(ctx, schedule, run, table)
+	}
+
+	run.Status = models.RunSucceeded
+	if err != nil {
+		run.Status = models.RunFailed
+		run.Error = err.Error()
+	}
+	finishedAt := s.clock.Now().UTC()
+	run.FinishedAt = &finishedAt
+
+	if err := s.repo.FinishRun(ctx, run); err != nil {
+		return nil, err
+	}
+	setFileURLs(run)
+	return run, nil
+}
+
+// table produces the report of the schedule. A saved collection report
+// covers the period_days full days before the run.
+func (s *reportScheduleService) table(ctx context.Context, schedule *models.ReportSchedule) (*export.Table, error) {
+	params := schedule.Params
+	switch schedule.Report {
+	case models.ReportOverdue:
+		return s.reports.ExportOverdueRentals(ctx, params.StudentCardId)
+	case models.ReportRental:
+		limit := rentalReportLimit
+		if params.Limit != nil {
+			limit = *params.Limit
+		}
+		return s.reports.ExportRentalReport(ctx, limit)
+	case models.ReportCollection:
+		days := collectionDays
+		if params.PeriodDays != nil {
+			days = *params.PeriodDays
+		}
+		to := s.clock.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+		from := to.AddDate(0, 0, 1-days)
+		filters := dto.CollectionFilters{
+			ZeroCirculation:     params.ZeroCirculation,
+			DemandExceedsSupply: params.DemandExceedsSupply,
+		}
+		return s.reports.ExportCollectionReport(ctx, filters, &from, &to)
+	default:
+		return nil, fmt.Errorf("unknown report %q", schedule.Report)
+	}
+}
+
+func render(table *export.Table, formats []string) ([]models.ReportFile, error) {
+	files := make([]models.ReportFile, 0, len(formats))
+	for _, format := range formats {
+		var buf bytes.Buffer
+		var err error
+		switch format {
+		case models.FormatCSV:
+			err = export.WriteCSV(&buf, table)
+		case models.FormatHTML:
+			err = export.WriteHTML(&buf, table)
+		default:
+			err = fmt.Errorf("unknown format %q", format)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to render %s: %w", format, err)
+		}
+
+		files = append(files, models.ReportFile{
+			Format:      format,
+			Name:        table.Name + "." + format,
+			ContentType: reportContentTypes[format],
+			Size:        buf.Len(),
+			Content:     buf.Bytes(),
+		})
+	}
+	return files, nil
+}
+
+// deliver mails the files to the recipients, or writes them to a directory
+// per schedule under the output directory.
+func (s *reportScheduleService) deliver(ctx context.Context, schedule *models.ReportSchedule, run *models.ReportRun, table *export.Table) error {
+	files := make([]delivery.File, len(run.Files))
+	for i, file := range run.Files {
+		files[i] = delivery.File{Name: file.Name, ContentType: file.ContentType, Content: file.Content}
+	}
+
+	switch schedule.Delivery {
+	case models.DeliveryEmail:
+		if s.opts.Mailer == nil {
+			return errors.New("email delivery is not configured")
+		}
+		return s.opts.Mailer.Send(ctx, delivery.Message{
+			To:          schedule.Recipients,
+			Subject:     schedule.Name + ": " + table.Title,
+			Text:        reportText(table),
+			Date:        run.StartedAt,
+			Attachments: files,
+		})
+	case models.DeliveryDirectory:
+		if s.opts.OutputDir == "" {
+			return errors.New("directory delivery is not configured")
+		}
+		return delivery.WriteDir(filepath.Join(s.opts.OutputDir, schedule.Id.String()), files)
+	default:
+		return fmt.Errorf("unknown delivery %q", schedule.Delivery)
+	}
+}
+
+// reportText is the body of a report email: the title, the summary and the
+// number of rows attached.
+func reportText(table *export.Table) string {
+	var b strings.Builder
+	b.WriteString(table.Title + "\n\n")
+	for _, field := range table.Summary {
+		fmt.Fprintf(&b, "%s: %s\n", field.Label, field.Value)
+	}
+	if len(table.Summary) > 0 {
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "%d rows, see the attached files.\n", len(table.Rows))
+	return b.String()
+}
+
+func setFileURLs(run *models.ReportRun) {
+	for i := range run.Files {
+		run.Files[i].URL = fmt.Sprintf("/reports/runs/%s/files/%s", run.Id, run.Files[i].Format)
+	}
+}
+
+func (s *reportScheduleService) GetRuns(ctx context.Context, id string, params dto.PaginationParams) (*dto.ReportRunsResponse, error) {
+	ctx, span := tracer.Start(ctx, "ReportScheduleService.GetRuns")
+	defer span.End()
+
+	schedule, err := s.GetSchedule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+	if params.Limit > 100 {
+		params.Limit = 100
+	}
+	if params.Offset < 0 {
+		params.Offset = 0
+	}
+
+	runs, total, links, err := s.repo.GetRuns(ctx, schedule.Id, params)
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range runs {
+		setFileURLs(run)
+	}
+
+	return &dto.ReportRunsResponse{
+		Results:    runs,
+		Pagination: dto.NewPaginationInfo(params.Offset, params.Limit, total, links),
+	}, nil
+}
+
+func (s *reportScheduleService) GetRunFile(ctx context.Context, runID, format string) (*models.ReportFile, error) {
+	ctx, span := tracer.Start(ctx, "ReportScheduleService.GetRunFile")
+	defer span.End()
+
+	uid, err := uuid.Parse(runID)
+	if err != nil {
+		return nil, apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
+	}
+	return s.repo.GetFile(ctx, uid, format)
+}
+
+// PurgeRuns deletes the runs, and their files, older than the retention.
+func (s *reportScheduleService) PurgeRuns(ctx context.Context) (int64, error) {
+	ctx, span := tracer.Start(ctx, "ReportScheduleService.PurgeRuns")
+	defer span.End()
+
+	if s.opts.Retention <= 0 {
+		return 0, nil
+	}
+	return s.repo.DeleteRunsBefore(ctx, s.clock.Now().Add(-s.opts.Retention))
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/delivery"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/export"
+	"BRSBackend/pkg/models"
+	"BRSBackend/pkg/repository"
+)
+
+func TestNextRun(t *testing.T) {
+	day := func(n int) *int { return &n }
+	at := func(s string) time.Time {
+		tm, _ := time.Parse(time.RFC3339, s)
+		return tm
+	}
+
+	// 2026-10-14 is a Wednesday.
+	tests := []struct {
+		name     string
+		schedule models.ReportSchedule
+		after    string
+		want     string
+	}{
+		{"daily later today", models.ReportSchedule{Frequency: models.FrequencyDaily, Hour: 18}, "2026-10-14T16:30:00Z", "2026-10-14T18:00:00Z"},
+		{"daily at the hour", models.ReportSchedule{Frequency: models.FrequencyDaily, Hour: 16}, "2026-10-14T16:00:00Z", "2026-10-15T16:00:00Z"},
+		{"weekly later this week", models.ReportSchedule{Frequency: models.FrequencyWeekly, Weekday: day(5), Hour: 7}, "2026-10-14T16:30:00Z", "2026-10-16T07:00:00Z"},
+		{"weekly next week", models.ReportSchedule{Frequency: models.FrequencyWeekly, Weekday: day(1), Hour: 7}, "2026-10-14T16:30:00Z", "2026-10-19T07:00:00Z"},
+		{"weekly same day passed", models.ReportSchedule{Frequency: models.FrequencyWeekly, Weekday: day(3), Hour: 7}, "2026-10-14T16:30:00Z", "2026-10-21T07:00:00Z"},
+		{"monthly this month", models.ReportSchedule{Frequency: models.FrequencyMonthly, DayOfMonth: day(28), Hour: 0}, "2026-10-14T16:30:00Z", "2026-10-28T00:00:00Z"},
+		{"monthly next year", models.ReportSchedule{Frequency: models.FrequencyMonthly, DayOfMonth: day(1), Hour: 6}, "2026-12-14T16:30:00Z", "2027-01-01T06:00:00Z"},
+		{"in UTC", models.ReportSchedule{Frequency: models.FrequencyDaily, Hour: 6}, "2026-10-14T03:00:00-05:00", "2026-10-15T06:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		if got := nextRun(&tt.schedule, at(tt.after)); !got.Equal(at(tt.want)) {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+type scheduleRepository struct {
+	repository.ReportScheduleRepository
+	due     []*models.ReportSchedule
+	claimed map[uuid.UUID]time.Time
+	runs    []*models.ReportRun
+}
+
+func (s *scheduleRepository) GetDue(ctx context.Context, now time.Time) ([]*models.ReportSchedule, error) {
+	return s.due, nil
+}
+
+func (s *scheduleRepository) Claim(ctx context.Context, id uuid.UUID, due, next, now time.Time) (bool, error) {
+	if _, ok := s.claimed[id]; ok {
+		return false, nil
+	}
+	s.claimed[id] = next
+	return true, nil
+}
+
+func (s *scheduleRepository) CreateRun(ctx context.Context, run *models.ReportRun) error {
+	run.Id = uuid.New()
+	return nil
+}
+
+func (s *scheduleRepository) FinishRun(ctx context.Context, run *models.ReportRun) error {
+	s.runs = append(s.runs, run)
+	return nil
+}
+
+type fakeMailer struct {
+	sent []delivery.Message
+	err  error
+}
+
+func (f *fakeMailer) Send(ctx context.Context, msg delivery.Message) error {
+	f.sent = append(f.sent, msg)
+	return f.err
+}
+
+func TestRunDueSchedules(t *testing.T) {
+	now := time.Date(2026, 10, 14, 7, 0, 30, 0, time.UTC)
+	table := &export.Table{
+		Name:    "overdue-2026-10-14",
+		Title:   "Overdue books on 2026-10-14",
+		Summary: []export.Field{{Label: "1-7 days", Value: "2"}},
+		Columns: []string{"title"},
+		Rows:    [][]string{{"Dune"}, {"Emma"}},
+	}
+	reports := &MockReportService{
+		ExportOverdueRentalsFunc: func(ctx context.Context, studentCardID *string) (*export.Table, error) {
+			return table, nil
+		},
+	}
+
+	emailed := &models.ReportSchedule{
+		Id: uuid.New(), Name: "Daily overdue", Report: models.ReportOverdue,
+		Frequency: models.FrequencyDaily, Hour: 7, NextRunAt: now.Add(-time.Minute),
+		Formats: []string{models.FormatCSV, models.FormatHTML}, Delivery: models.DeliveryEmail,
+		Recipients: []string{"head@example.com"},
+	}
+	written := &models.ReportSchedule{
+		Id: uuid.New(), Name: "Archive", Report: models.ReportOverdue,
+		Frequency: models.FrequencyDaily, Hour: 7, NextRunAt: now.Add(-72 * time.Hour),
+		Formats: []string{models.FormatCSV}, Delivery: models.DeliveryDirectory,
+	}
+	taken := &models.ReportSchedule{Id: uuid.New(), Report: models.ReportOverdue, NextRunAt: now}
+
+	repo := &scheduleRepository{
+		due:     []*models.ReportSchedule{emailed, written, taken},
+		claimed: map[uuid.UUID]time.Time{taken.Id: now},
+	}
+	mailer := &fakeMailer{}
+	dir := t.TempDir()
+	svc := NewReportScheduleService(repo, reports, ReportDeliveryOptions{Mailer: mailer, OutputDir: dir}, clock.NewFake(now))
+
+	runs, err := svc.RunDueSchedules(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(runs) != 2 {
+		t.Fatalf("expected two runs, the third being claimed elsewhere, got %d", len(runs))
+	}
+
+	// A run missed for days is made up once, and moves on to the next one.
+	if want := time.Date(2026, 10, 15, 7, 0, 0, 0, time.UTC); !repo.claimed[written.Id].Equal(want) {
+		t.Errorf("expected the next run at %s, got %s", want, repo.claimed[written.Id])
+	}
+
+	run := runs[0]
+	if run.Status != models.RunSucceeded || run.Trigger != models.TriggerSchedule || len(run.Files) != 2 {
+		t.Errorf("unexpected run %+v", run)
+	}
+	if want := "/reports/runs/" + run.Id.String() + "/files/html"; run.Files[1].URL != want {
+		t.Errorf("expected file URL %s, got %s", want, run.Files[1].URL)
+	}
+
+	if len(mailer.sent) != 1 {
+		t.Fatalf("expected one email, got %d", len(mailer.sent))
+	}
+	msg := mailer.sent[0]
+	if msg.Subject != "Daily overdue: Overdue books on 2026-10-14" || len(msg.Attachments) != 2 || msg.Attachments[0].Name != "overdue-2026-10-14.csv" {
+		t.Errorf("unexpected email %q with %d attachments", msg.Subject, len(msg.Attachments))
+	}
+	if want := "Overdue books on 2026-10-14\n\n1-7 days: 2\n\n2 rows, see the attached files.\n"; msg.Text != want {
+		t.Errorf("expected text %q, got %q", want, msg.Text)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, written.Id.String(), "overdue-2026-10-14.csv"))
+	if err != nil || string(content) != "title\nDune\nEmma\n" {
+		t.Errorf("expected the CSV in the schedule directory, got %q, %v", content, err)
+	}
+}
+
+func TestRunKeepsFilesWhenDeliveryFails(t *testing.T) {
+	now := time.Date(2026, 10, 14, 7, 0, 0, 0, time.UTC)
+	reports := &MockReportService{
+		ExportRentalReportFunc: func(ctx context.Context, limit int) (*export.Table, error) {
+			if limit != rentalReportLimit {
+				t.Errorf("expected the default limit %d, got %d", rentalReportLimit, limit)
+			}
+			return &export.Table{Name: "rentals-2026-10-14", Columns: []string{"rank"}}, nil
+		},
+	}
+	schedule := &models.ReportSchedule{
+		Id: uuid.New(), Report: models.ReportRental, Frequency: models.FrequencyDaily, NextRunAt: now,
+		Formats: []string{models.FormatCSV}, Delivery: models.DeliveryEmail, Recipients: []string{"head@example.com"},
+	}
+	repo := &scheduleRepository{due: []*models.ReportSchedule{schedule}, claimed: map[uuid.UUID]time.Time{}}
+	mailer := &fakeMailer{err: errors.New("connection refused")}
+	svc := NewReportScheduleService(repo, reports, ReportDeliveryOptions{Mailer: mailer}, clock.NewFake(now))
+
+	runs, err := svc.RunDueSchedules(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	run := runs[0]
+	if run.Status != models.RunFailed || run.Error != "connection refused" || len(run.Files) != 1 || run.FinishedAt == nil {
+		t.Errorf("expected a failed run with its file, got %+v", run)
+	}
+}
+
+func TestValidateReportSchedule(t *testing.T) {
+	day, limit := 3, 0
+	svc := NewReportScheduleService(nil, nil, ReportDeliveryOptions{OutputDir: t.TempDir()}, clock.System{})
+
+	err := svc.(*reportScheduleService).validate(dto.ReportScheduleRequest{
+		Report:     models.ReportCollection,
+		Frequency:  models.FrequencyDaily,
+		DayOfMonth: &day,
+		Params:     models.ReportParams{Limit: &limit},
+		Delivery:   models.DeliveryEmail,
+	})
+
+	appErr, ok := apperrors.As(err)
+	if !ok || appErr.Kind != apperrors.KindValidation {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	want := []string{
+		"day_of_month:excluded",
+		"recipients:required",
+		"params.limit:excluded",
+		"params.limit:min",
+		"delivery:unavailable",
+	}
+	if len(appErr.Fields) != len(want) {
+		t.Fatalf("expected fields %v, got %+v", want, appErr.Fields)
+	}
+	for i, field := range appErr.Fields {
+		if got := field.Field + ":" + field.Code; got != want[i] {
+			t.Errorf("field %d: expected %s, got %s", i, want[i], got)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
+	"BRSBackend/pkg/dto"
+	"BRSBackend/pkg/pagination"
+	"BRSBackend/pkg/repository"
+)
+
+type seriesRepository struct {
+	repository.ReportRepository
+	rows []*dto.AnalyticsRow
+
+	from, to time.Time
+	limit    int
+}
+
+func (s *seriesRepository) GetCirculationSeries(ctx context.Context, from, to time.Time, interval, groupBy string, groupLimit, overduePeriod int) ([]*dto.AnalyticsRow, error) {
+	s.from, s.to, s.limit = from, to, groupLimit
+	return s.rows, nil
+}
+
+func TestGetCirculationAnalytics(t *testing.T) {
+	day := func(s string) *time.Time {
+		d, _ := time.Parse(time.DateOnly, s)
+		return &d
+	}
+
+	t.Run("aligns the range and merges rows", func(t *testing.T) {
+		repo := &seriesRepository{rows: []*dto.AnalyticsRow{
+			{Bucket: "2026-09-28", AnalyticsCounts: dto.AnalyticsCounts{Checkouts: 3, Returns: 1}},
+			{Bucket: "2026-10-12", AnalyticsCounts: dto.AnalyticsCounts{Checkouts: 2, Overdue: 1}},
+			{Bucket: "2026-09-28", GroupKey: "cs", GroupLabel: "CS", AnalyticsCounts: dto.AnalyticsCounts{Checkouts: 1}},
+			{Bucket: "2026-09-28", GroupKey: "art", GroupLabel: "Art", AnalyticsCounts: dto.AnalyticsCounts{Checkouts: 2}},
+			{Bucket: "2026-10-12", GroupKey: "cs", GroupLabel: "CS", AnalyticsCounts: dto.AnalyticsCounts{Checkouts: 2}},
+		}}
+		svc := NewReportService(repo, 14, clock.System{})
+
+		got, err := svc.GetCirculationAnalytics(context.Background(), dto.AnalyticsParams{
+			From:     day("2026-10-01"),
+			To:       day("2026-10-14"),
+			Interval: dto.IntervalWeek,
+			GroupBy:  dto.GroupByMajor,
+			Limit:    500,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.From != "2026-09-28" || got.To != "2026-10-14" {
+			t.Errorf("expected range 2026-09-28 to 2026-10-14, got %s to %s", got.From, got.To)
+		}
+		if !repo.from.Equal(*day("2026-09-28")) || !repo.to.Equal(*day("2026-10-15")) || repo.limit != 100 {
+			t.Errorf("unexpected repository arguments %s, %s, %d", repo.from, repo.to, repo.limit)
+		}
+
+		if len(got.Buckets) != 3 || got.Buckets[1].Start != "2026-10-05" || got.Buckets[1].Checkouts != 0 {
+			t.Errorf("expected three weekly buckets with an empty middle one, got %+v", got.Buckets)
+		}
+		if want := (dto.AnalyticsCounts{Checkouts: 5, Returns: 1, Overdue: 1}); got.Totals != want {
+			t.Errorf("expected totals %+v, got %+v", want, got.Totals)
+		}
+
+		if len(got.Groups) != 2 || got.Groups[0].Key != "cs" || got.Groups[0].Totals.Checkouts != 3 || got.Groups[0].Buckets[2].Checkouts != 2 {
+			t.Errorf("expected CS first with 3 checkouts, got %+v", got.Groups)
+		}
+	})
+
+	t.Run("rejects invalid parameters", func(t *testing.T) {
+		svc := NewReportService(&seriesRepository{}, 14, clock.System{})
+
+		_, err := svc.GetCirculationAnalytics(context.Background(), dto.AnalyticsParams{
+			From:     day("2026-10-14"),
+			To:       day("2026-10-01"),
+			Interval: "year",
+			GroupBy:  "author",
+		})
+		var appErr *apperrors.Error
+		if !errors.As(err, &appErr) || appErr.Code != "invalid_filter" || len(appErr.Fields) != 3 {
+			t.Fatalf("expected invalid_filter with three fields, got %v", err)
+		}
+	})
+
+	t.Run("bounds the number of buckets", func(t *testing.T) {
+		svc := NewReportService(&seriesRepository{}, 14, clock.System{})
+
+		_, err := svc.GetCirculationAnalytics(context.Background(), dto.AnalyticsParams{
+			From: day("2024-01-01"),
+			To:   day("2026-10-14"),
+		})
+		var appErr *apperrors.Error
+		if !errors.As(err, &appErr) || len(appErr.Fields) != 1 || appErr.Fields[0].Field != "from" {
+			t.Fatalf("expected a from field error, got %v", err)
+		}
+	})
+}
+
+func TestDefaultAnalyticsFrom(t *testing.T) {
+	to, _ := time.Parse(time.DateOnly, "2026-10-14")
+
+	tests := []struct {
+		interval string
+		from     string
+		buckets  int
+	}{
+		{dto.IntervalDay, "2026-09-15", 30},
+		{dto.IntervalWeek, "2026-07-27", 12},
+		{dto.IntervalMonth, "2025-11-01", 12},
+	}
+	for _, tt := range tests {
+		from := bucketOf(defaultAnalyticsFrom(to, tt.interval), tt.interval)
+		if got := from.Format(time.DateOnly); got != tt.from {
+			t.Errorf("%s: expected from %s, got %s", tt.interval, tt.from, got)
+		}
+		if n := len(bucketStarts(from, to, tt.interval)); n != tt.buckets {
+			t.Errorf("%s: expected %d buckets, got %d", tt.interval, tt.buckets, n)
+		}
+	}
+}
+
+type rollupRepository struct {
+	repository.ReportRepository
+	through time.Time
+}
+
+func (r *rollupRepository) RollupCirculation(ctx context.Context, through time.Time, overduePeriod int) error {
+	r.through = through
+	return nil
+}
+
+func TestRollupCirculation(t *testing.T) {
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		offset time.Duration
+		want   time.Time
+	}{
+		{"wall clock", 0, today},
+		{"shifted forward", 72 * time.Hour, today},
+		{"shifted back", -48 * time.Hour, today.AddDate(0, 0, -2)},
+	}
+	for _, tt := range tests {
+		repo := &rollupRepository{}
+		svc := NewReportService(repo, 14, clock.Offset{Base: clock.NewFake(now), Offset: tt.offset})
+
+		if err := svc.RollupCirculation(context.Background()); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if !repo.through.Equal(tt.want) {
+			t.Errorf("%s: expected rollup through %s, got %s", tt.name, tt.want, repo.through)
+		}
+	}
+}
+
+type overdueRepository struct {
+	repository.ReportRepository
+	users []dto.OverdueUser
+
+	now time.Time
+}
+
+func (o *overdueRepository) GetOverdueRentals(ctx context.Context, studentCardID *string, params dto.PaginationParams, now time.Time, overduePeriod int) ([]dto.OverdueUser, int64, pagination.Links, error) {
+	o.now = now
+	return o.users, int64(len(o.users)), pagination.Links{}, nil
+}
+
+func (o *overdueRepository) GetOverdueAging(ctx context.Context, studentCardID *string, buckets []dto.AgingBucket, now time.Time, overduePeriod int) ([]dto.AgingBucket, error) {
+	return buckets, nil
+}
+
+func TestGetOverdueRentals(t *testing.T) {
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	rented := func(days float64) time.Time {
+		return now.Add(-time.Duration(days * float64(24*time.Hour)))
+	}
+
+	repo := &overdueRepository{users: []dto.OverdueUser{{
+		StudentId:  "s1",
+		DateRented: rented(30),
+		Items: []dto.OverdueItem{
+			{RentId: "r1", RentedAt: rented(30)},
+			{RentId: "r2", RentedAt: rented(14.25)},
+		},
+	}}}
+	svc := NewReportService(repo, 14, clock.NewFake(now))
+
+	got, err := svc.GetOverdueRentals(context.Background(), nil, dto.PaginationParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !repo.now.Equal(now) {
+		t.Errorf("expected the repository to count from %s, got %s", now, repo.now)
+	}
+
+	user := got.Results[0]
+	if user.DaysOverdue != 16 {
+		t.Errorf("expected the student to be 16 days overdue, got %d", user.DaysOverdue)
+	}
+	if want := rented(16); !user.Items[0].DueDate.Equal(want) || user.Items[0].DaysOverdue != 16 {
+		t.Errorf("expected the first book due %s and 16 days overdue, got %s and %d", want, user.Items[0].DueDate, user.Items[0].DaysOverdue)
+	}
+	if user.Items[1].DaysOverdue != 1 {
+		t.Errorf("expected a book six hours past due to be 1 day overdue, got %d", user.Items[1].DaysOverdue)
+	}
+
+	if len(got.Aging) != 4 || got.Aging[3].Label != "90+" || got.Aging[3].MaxDays != nil {
+		t.Errorf("unexpected aging buckets %+v", got.Aging)
+	}
+	if got.Pagination.Total != 1 || got.Pagination.HasNext {
+		t.Errorf("unexpected pagination %+v", got.Pagination)
+	}
+}
+
+type collectionRepository struct {
+	repository.ReportRepository
+	items []dto.CollectionItem
+
+	filters dto.CollectionFilters
+}
+
+func (c *collectionRepository) ListCollection(ctx context.Context, filters dto.CollectionFilters) ([]dto.CollectionItem, error) {
+	c.filters = filters
+	return c.items, nil
+}
+
+func TestExportCollectionReport(t *testing.T) {
+	now := time.Date(2026, 10, 14, 16, 30, 0, 0, time.UTC)
+	isbn, days := "9780441172719", 6.4444
+	repo := &collectionRepository{items: []dto.CollectionItem{
+		{BookId: "b1", Title: "Dune", Isbn: &isbn, Copies: 3, Available: 1, Rentals: 7, AvgLoanDays: &days, UtilizationPct: 81.25, DemandExceedsSupply: true},
+		{BookId: "b2", Title: "Emma, a novel", Copies: 1, Available: 1, ZeroCirculation: true},
+	}}
+	svc := NewReportService(repo, 14, clock.NewFake(now))
+
+	table, err := svc.ExportCollectionReport(context.Background(), dto.CollectionFilters{}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := time.Date(2026, 7, 17, 0, 0, 0, 0, time.UTC); !repo.filters.From.Equal(want) {
+		t.Errorf("expected the period to start %s, got %s", want, repo.filters.From)
+	}
+	if want := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC); !repo.filters.To.Equal(want) {
+		t.Errorf("expected the period to end before %s, got %s", want, repo.filters.To)
+	}
+	if table.Name != "collection-2026-07-17-2026-10-14" {
+		t.Errorf("unexpected table name %q", table.Name)
+	}
+
+	want := [][]string{
+		{"b1", "Dune", isbn, "", "3", "1", "7", "6.4", "81.3", "false", "true"},
+		{"b2", "Emma, a novel", "", "", "1", "1", "0", "", "0.0", "true", "false"},
+	}
+	for i, row := range table.Rows {
+		if strings.Join(row, "|") != strings.Join(want[i], "|") {
+			t.Errorf("row %d: expected %v, got %v", i, want[i], row)
+		}
+	}
+}
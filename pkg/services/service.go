@@ -1,23 +1,42 @@
 package services
 
 import (
+	"go.opentelemetry.io/otel"
+
+	"BRSBackend/pkg/clock"
 	"BRSBackend/pkg/repository"
 )
 
+var tracer = otel.Tracer("BRSBackend/pkg/services")
+
 type Service struct {
-	Book    BookService
-	Auth    AuthService
-	Student StudentService
-	Rent    RentService
-	Report  ReportService
+	Book     BookService
+	Auth     AuthService
+	Student  StudentService
+	Rent     RentService
+	Report   ReportService
+	Schedule ReportScheduleService
+	Portal   PortalService
+	Kiosk    KioskService
+	Receipt  ReceiptService
+	Label    LabelService
+	Category CategoryService
+	OIDC     OIDCService
+	Health   HealthService
 }
 
-func NewService(repo *repository.Repository, overduePeriod int) *Service {
-	return &Service{
-		Book:    NewBookService(repo.Book),
-		Auth:    NewAuthService(repo.Librarian, repo.Session),
-		Student: NewStudentService(repo.Student),
-		Rent:    NewRentService(repo.Rent, repo.Cart, repo.Book, repo.Student),
-		Report:  NewReportService(repo.Report, overduePeriod),
+func NewService(repo *repository.Repository, overduePeriod int, clock clock.Clock) *Service {
+	svc := &Service{
+		Book:     NewBookService(repo.Book, repo.Category),
+		Auth:     NewAuthService(repo.Librarian, repo.Session, clock),
+		Student:  NewStudentService(repo.Student, repo.Rent, overduePeriod, clock),
+		Rent:     NewRentService(repo.Rent, repo.Cart, repo.Book, repo.Student, repo.Return, clock),
+		Report:   NewReportService(repo.Report, overduePeriod, clock),
+		Label:    NewLabelService(repo.Book, repo.Student),
+		Category: NewCategoryService(repo.Category),
+		Health:   NewHealthService(repo.Health),
 	}
+	svc.Receipt = NewReceiptService(repo.Cart, repo.Student, repo.Rent, overduePeriod, clock)
+	svc.Kiosk = NewKioskService(repo.Kiosk, repo.Student, repo.Rent, svc.Rent, svc.Receipt, overduePeriod, clock)
+	return svc
 }
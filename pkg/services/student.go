@@ -2,10 +2,12 @@ package services
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
+	"BRSBackend/pkg/apperrors"
+	"BRSBackend/pkg/clock"
 	"BRSBackend/pkg/dto"
 	"BRSBackend/pkg/models"
 	"BRSBackend/pkg/repository"
@@ -15,39 +17,65 @@ type StudentService interface {
 	CreateStudent(ctx context.Context, student *models.Student) error
 	GetStudentByID(ctx context.Context, id string) (*models.Student, error)
 	GetStudentByCardNumber(ctx context.Context, number string) (*models.Student, error)
-	GetAllStudents(ctx context.Context, params dto.PaginationParams) (*dto.StudentsResponse, error)
+	GetAllStudents(ctx context.Context, params dto.PaginationParams, filters dto.StudentFilters) (*dto.StudentsResponse, error)
 	DeleteStudent(ctx context.Context, id string) error
+	GetStudentHistory(ctx context.Context, id string, params dto.PaginationParams) (*dto.StudentHistoryResponse, error)
+	GetStudentSummary(ctx context.Context, id string) (*dto.StudentSummary, error)
 }
 
+// mostBorrowedLimit is the number of titles listed in a student summary.
+const mostBorrowedLimit = 5
+
 type studentService struct {
-	repo repository.StudentRepository
+	repo          repository.StudentRepository
+	rentRepo      repository.RentRepository
+	overduePeriod int
+	clock         clock.Clock
 }
 
-func NewStudentService(repo repository.StudentRepository) StudentService {
+func NewStudentService(repo repository.StudentRepository, rentRepo repository.RentRepository, overduePeriod int, clock clock.Clock) StudentService {
 	return &studentService{
-		repo: repo,
+		repo:          repo,
+		rentRepo:      rentRepo,
+		overduePeriod: overduePeriod,
+		clock:         clock,
 	}
 }
 
 func (s *studentService) CreateStudent(ctx context.Context, student *models.Student) error {
+	ctx, span := tracer.Start(ctx, "StudentService.CreateStudent")
+	defer span.End()
+
+	if _, err := s.repo.GetByCardID(ctx, student.CardId); err == nil {
+		return apperrors.Conflict("student_card_exists", "student with card %s already exists", student.CardId)
+	}
+
 	return s.repo.Create(ctx, student)
 }
 
 func (s *studentService) GetStudentByID(ctx context.Context, uid string) (*models.Student, error) {
+	ctx, span := tracer.Start(ctx, "StudentService.GetStudentByID")
+	defer span.End()
 
 	id, err := uuid.Parse(uid)
 	if err != nil {
-		return nil, fmt.Errorf("invalid id format: %w", err)
+		return nil, apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
 	}
 
 	return s.repo.GetByID(ctx, id)
 }
 
 func (s *studentService) GetStudentByCardNumber(ctx context.Context, number string) (*models.Student, error) {
+	ctx, span := tracer.Start(ctx, "StudentService.GetStudentByCardNumber")
+	defer span.End()
+
 	return s.repo.GetByCardID(ctx, number)
 }
 
-func (s *studentService) GetAllStudents(ctx context.Context, params dto.PaginationParams) (*dto.StudentsResponse, error) {
+func (s *studentService) GetAllStudents(ctx context.Context, params dto.PaginationParams, filters dto.StudentFilters) (*dto.StudentsResponse, error) {
+	ctx, span := tracer.Start(ctx, "StudentService.GetAllStudents")
+	defer span.End()
+
 	if params.Limit <= 0 {
 		params.Limit = 10
 	}
@@ -58,33 +86,96 @@ func (s *studentService) GetAllStudents(ctx context.Context, params dto.Paginati
 		params.Offset = 0
 	}
 
-	students, total, err := s.repo.GetAll(ctx, params.Offset, params.Limit)
+	filters.OverdueDays = s.overduePeriod
+
+	students, total, links, err := s.repo.GetAll(ctx, params, filters)
 	if err != nil {
 		return nil, err
 	}
 
-	pagination := dto.PaginationInfo{
-		Offset:      params.Offset,
-		Limit:       params.Limit,
-		Total:       int(total),
-		HasNext:     int64(params.Offset+params.Limit) < total,
-		HasPrevious: params.Offset > 0,
-	}
-
 	response := &dto.StudentsResponse{
 		Results:    students,
-		Pagination: pagination,
+		Pagination: dto.NewPaginationInfo(params.Offset, params.Limit, total, links),
 	}
 
 	return response, nil
 }
 
 func (s *studentService) DeleteStudent(ctx context.Context, uid string) error {
+	ctx, span := tracer.Start(ctx, "StudentService.DeleteStudent")
+	defer span.End()
+
 	id, err := uuid.Parse(uid)
 
 	if err != nil {
-		return fmt.Errorf("invalid uuid format: %w", err)
+		return apperrors.Validation("invalid_id", "invalid id format").Wrap(err)
 	}
 
 	return s.repo.Delete(ctx, id)
 }
+
+func (s *studentService) GetStudentHistory(ctx context.Context, uid string, params dto.PaginationParams) (*dto.StudentHistoryResponse, error) {
+	ctx, span := tracer.Start(ctx, "StudentService.GetStudentHistory")
+	defer span.End()
+
+	student, err := s.GetStudentByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+	if params.Limit > 100 {
+		params.Limit = 100
+	}
+	if params.Offset < 0 {
+		params.Offset = 0
+	}
+
+	rentals, total, links, err := s.rentRepo.GetHistoryByStudent(ctx, student.Id, params)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	for _, rental := range rentals {
+		applyDueDate(rental, s.overduePeriod, now)
+	}
+
+	return &dto.StudentHistoryResponse{
+		Results:    rentals,
+		Pagination: dto.NewPaginationInfo(params.Offset, params.Limit, total, links),
+	}, nil
+}
+
+// applyDueDate sets the due date of a rental and the number of whole days it
+// was (or still is) kept past it.
+func applyDueDate(rental *dto.StudentRental, overduePeriod int, now time.Time) {
+	rental.DueDate = rental.RentedDate.AddDate(0, 0, overduePeriod)
+
+	end := now
+	if rental.ReturnedDate != nil {
+		end = *rental.ReturnedDate
+	}
+	if late := end.Sub(rental.DueDate); late > 0 {
+		rental.OverdueDays = int(late.Hours() / 24)
+	}
+}
+
+func (s *studentService) GetStudentSummary(ctx context.Context, uid string) (*dto.StudentSummary, error) {
+	ctx, span := tracer.Start(ctx, "StudentService.GetStudentSummary")
+	defer span.End()
+
+	student, err := s.GetStudentByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.rentRepo.GetStudentStats(ctx, student.Id, s.overduePeriod, mostBorrowedLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.StudentSummary{Student: student, StudentStats: *stats}, nil
+}
@@ -0,0 +1,89 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const spanKey = "tracing:span"
+
+type gormPlugin struct {
+	tracer trace.Tracer
+}
+
+func GormPlugin() gorm.Plugin {
+	return &gormPlugin{tracer: otel.Tracer(instrumentationName)}
+}
+
+func (p *gormPlugin) Name() string {
+	return "tracing"
+}
+
+func (p *gormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	register := func(operation string, before, after func(string, func(*gorm.DB)) error) error {
+		if err := before("tracing:before_"+operation, p.before(operation)); err != nil {
+			return err
+		}
+		return after("tracing:after_"+operation, p.after)
+	}
+
+	return errors.Join(
+		register("create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register),
+		register("query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register),
+		register("update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register),
+		register("delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register),
+		register("row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register),
+		register("raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register),
+	)
+}
+
+func (p *gormPlugin) before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx := db.Statement.Context
+		if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+			return
+		}
+
+		name := "db." + operation
+		if db.Statement.Table != "" {
+			name += " " + db.Statement.Table
+		}
+		_, span := p.tracer.Start(ctx, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.DBSystemSqlite,
+				semconv.DBOperationName(operation),
+				semconv.DBCollectionName(db.Statement.Table),
+			),
+		)
+		db.InstanceSet(spanKey, span)
+	}
+}
+
+func (p *gormPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(
+		semconv.DBQueryText(db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.RowsAffected),
+	)
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
@@ -2,15 +2,31 @@ package validation
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
+
+	"BRSBackend/pkg/apperrors"
 )
 
-var validate = validator.New()
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return v
+}
 
 type ErrorResponse struct {
 	FailedField string
+	Field       string
 	Tag         string
 	Value       string
 }
@@ -22,6 +38,7 @@ func ValidateStruct(s interface{}) []*ErrorResponse {
 		for _, err := range err.(validator.ValidationErrors) {
 			var element ErrorResponse
 			element.FailedField = err.StructNamespace()
+			element.Field = err.Field()
 			element.Tag = err.Tag()
 			element.Value = err.Param()
 			errors = append(errors, &element)
@@ -37,3 +54,28 @@ func FormatErrors(errors []*ErrorResponse) string {
 	}
 	return strings.Join(errorMsgs, ", ")
 }
+
+func AsError(errors []*ErrorResponse) error {
+	fields := make([]apperrors.FieldError, len(errors))
+	for i, err := range errors {
+		fields[i] = apperrors.FieldError{
+			Field:   err.Field,
+			Code:    err.Tag,
+			Message: fieldMessage(err),
+		}
+	}
+	return apperrors.Validation("validation_failed", "request body failed validation", fields...)
+}
+
+func fieldMessage(err *ErrorResponse) string {
+	switch err.Tag {
+	case "required":
+		return fmt.Sprintf("%s is required", err.Field)
+	case "min":
+		return fmt.Sprintf("%s must be at least %s", err.Field, err.Value)
+	case "max":
+		return fmt.Sprintf("%s must be at most %s", err.Field, err.Value)
+	default:
+		return fmt.Sprintf("%s failed on the '%s' rule", err.Field, err.Tag)
+	}
+}